@@ -0,0 +1,22 @@
+package usecase
+
+import "context"
+
+// AnonymousActor is the actor of the changes made without one in their context.
+const AnonymousActor = "anonymous"
+
+type actorContextKey struct{}
+
+// WithActor returns a copy of ctx carrying the actor, who or what is making the changes
+// done with it, as recorded in the audit log.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx, AnonymousActor when there is none.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorContextKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return AnonymousActor
+}
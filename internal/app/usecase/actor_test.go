@@ -0,0 +1,38 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+)
+
+func TestActorFromContext(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ctx    context.Context
+		result string
+	}{
+		{
+			name:   "should return the anonymous actor when there is none",
+			ctx:    context.TODO(),
+			result: usecase.AnonymousActor,
+		},
+		{
+			name:   "should return the anonymous actor when the actor is empty",
+			ctx:    usecase.WithActor(context.TODO(), ""),
+			result: usecase.AnonymousActor,
+		},
+		{
+			name:   "should return the actor of the context",
+			ctx:    usecase.WithActor(context.TODO(), "alice"),
+			result: "alice",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.result, usecase.ActorFromContext(tc.ctx))
+		})
+	}
+}
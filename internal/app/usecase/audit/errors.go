@@ -0,0 +1,7 @@
+package audit
+
+import "github.com/wellingtonlope/todo-api/internal/app/usecase"
+
+func internalError(msg string, cause error) error {
+	return usecase.NewError(msg, cause, usecase.ErrorTypeInternalError)
+}
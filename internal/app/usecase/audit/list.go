@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	// ListInput filters the audit log. Empty fields do not filter.
+	ListInput struct {
+		TodoID string
+		// Since keeps the records created at or after it
+		Since *time.Time
+	}
+	// ListQuery is what the store filters the audit log by, with the same meaning as ListInput.
+	ListQuery struct {
+		TodoID string
+		Since  *time.Time
+	}
+	ListStore interface {
+		List(context.Context, ListQuery) ([]domain.AuditRecord, error)
+	}
+	List interface {
+		Handle(context.Context, ListInput) ([]RecordOutput, error)
+	}
+	list struct {
+		store ListStore
+	}
+)
+
+func NewList(store ListStore) *list {
+	return &list{store}
+}
+
+// Handle returns the records of the audit log matching the input, the oldest first.
+func (uc *list) Handle(ctx context.Context, input ListInput) ([]RecordOutput, error) {
+	records, err := uc.store.List(ctx, ListQuery(input))
+	if err != nil {
+		return nil, internalError("fail to list the audit log", err)
+	}
+	return RecordOutputsFromDomain(records), nil
+}
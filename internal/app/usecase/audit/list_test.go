@@ -0,0 +1,89 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/audit"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestList_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	diff := json.RawMessage(`{"title":{"before":"a","after":"b"}}`)
+	testCases := []struct {
+		name   string
+		store  *listStoreMock
+		input  audit.ListInput
+		result []audit.RecordOutput
+		err    error
+	}{
+		{
+			name: "should fail when store fails",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), audit.ListQuery{}).Return([]domain.AuditRecord(nil), assert.AnError).Once()
+				return m
+			}(),
+			input:  audit.ListInput{},
+			result: nil,
+			err:    usecase.NewError("fail to list the audit log", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should return an empty list",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), audit.ListQuery{}).Return([]domain.AuditRecord(nil), nil).Once()
+				return m
+			}(),
+			input:  audit.ListInput{},
+			result: []audit.RecordOutput{},
+			err:    nil,
+		},
+		{
+			name: "should list the records of a todo since a date",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), audit.ListQuery{TodoID: "123", Since: &exampleDate}).
+					Return([]domain.AuditRecord{
+						{
+							TodoID:    "123",
+							Actor:     "alice",
+							Operation: domain.AuditOperationUpdate,
+							Diff:      diff,
+							CreatedAt: exampleDate,
+						},
+					}, nil).Once()
+				return m
+			}(),
+			input: audit.ListInput{TodoID: "123", Since: &exampleDate},
+			result: []audit.RecordOutput{
+				{TodoID: "123", Actor: "alice", Operation: "update", Diff: diff, CreatedAt: exampleDate},
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := audit.NewList(tc.store)
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
+
+type listStoreMock struct {
+	mock.Mock
+}
+
+func (m *listStoreMock) List(ctx context.Context, query audit.ListQuery) ([]domain.AuditRecord, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]domain.AuditRecord), args.Error(1)
+}
@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// RecordOutput represents the output structure of an audit record
+type RecordOutput struct {
+	TodoID    string
+	Actor     string
+	Operation string
+	Diff      json.RawMessage
+	CreatedAt time.Time
+}
+
+// RecordOutputFromDomain converts a domain.AuditRecord to RecordOutput
+func RecordOutputFromDomain(record domain.AuditRecord) RecordOutput {
+	return RecordOutput{
+		TodoID:    record.TodoID,
+		Actor:     record.Actor,
+		Operation: string(record.Operation),
+		Diff:      record.Diff,
+		CreatedAt: record.CreatedAt,
+	}
+}
+
+// RecordOutputsFromDomain converts a slice of domain.AuditRecord to []RecordOutput
+func RecordOutputsFromDomain(records []domain.AuditRecord) []RecordOutput {
+	outputs := make([]RecordOutput, 0, len(records))
+	for _, record := range records {
+		outputs = append(outputs, RecordOutputFromDomain(record))
+	}
+	return outputs
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type eventsContextKey struct{}
+
+// raisedEvents are the domain events raised by a unit of work, waiting for it to be committed.
+type raisedEvents struct {
+	events []domain.Event
+}
+
+// Publishing runs work collecting the domain events raised by it with RaiseEvent, and publishes
+// them to publisher once work succeeds. When ctx already collects events, as for a unit of work
+// started inside another one, they are left to the outer one to publish, and dropped when work fails.
+func Publishing(ctx context.Context, publisher EventPublisher, work func(context.Context) error) error {
+	if raised, ok := ctx.Value(eventsContextKey{}).(*raisedEvents); ok {
+		start := len(raised.events)
+		if err := work(ctx); err != nil {
+			raised.events = raised.events[:start]
+			return err
+		}
+		return nil
+	}
+	raised := &raisedEvents{}
+	if err := work(context.WithValue(ctx, eventsContextKey{}, raised)); err != nil {
+		return err
+	}
+	for _, event := range raised.events {
+		publisher.Publish(ctx, event)
+	}
+	return nil
+}
+
+// RaiseEvent adds the event to the ones collected by ctx, published once the unit of work run by
+// Publishing succeeds. Nothing is raised when ctx does not collect events.
+func RaiseEvent(ctx context.Context, event domain.Event) {
+	if raised, ok := ctx.Value(eventsContextKey{}).(*raisedEvents); ok {
+		raised.events = append(raised.events, event)
+	}
+}
@@ -0,0 +1,71 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestPublishing(t *testing.T) {
+	created := domain.Event{Type: domain.EventTodoCreated, TodoID: "1"}
+	deleted := domain.Event{Type: domain.EventTodoDeleted, TodoID: "2"}
+
+	t.Run("should publish the raised events once the work succeeds", func(t *testing.T) {
+		publisher := new(eventPublisherMock)
+		publisher.On("Publish", context.TODO(), created).Return().Once()
+		publisher.On("Publish", context.TODO(), deleted).Return().Once()
+		err := usecase.Publishing(context.TODO(), publisher, func(ctx context.Context) error {
+			usecase.RaiseEvent(ctx, created)
+			usecase.RaiseEvent(ctx, deleted)
+			publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+			return nil
+		})
+		assert.Nil(t, err)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("should publish nothing when the work fails", func(t *testing.T) {
+		publisher := new(eventPublisherMock)
+		err := usecase.Publishing(context.TODO(), publisher, func(ctx context.Context) error {
+			usecase.RaiseEvent(ctx, created)
+			return assert.AnError
+		})
+		assert.Equal(t, assert.AnError, err)
+		publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should leave the events of an inner work to the outer one", func(t *testing.T) {
+		publisher := new(eventPublisherMock)
+		publisher.On("Publish", context.TODO(), deleted).Return().Once()
+		err := usecase.Publishing(context.TODO(), publisher, func(ctx context.Context) error {
+			_ = usecase.Publishing(ctx, publisher, func(ctx context.Context) error {
+				usecase.RaiseEvent(ctx, created)
+				return assert.AnError
+			})
+			_ = usecase.Publishing(ctx, publisher, func(ctx context.Context) error {
+				usecase.RaiseEvent(ctx, deleted)
+				return nil
+			})
+			publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+			return nil
+		})
+		assert.Nil(t, err)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("should raise nothing outside of a published work", func(t *testing.T) {
+		assert.NotPanics(t, func() { usecase.RaiseEvent(context.TODO(), created) })
+	})
+}
+
+type eventPublisherMock struct {
+	mock.Mock
+}
+
+func (m *eventPublisherMock) Publish(ctx context.Context, event domain.Event) {
+	m.Called(ctx, event)
+}
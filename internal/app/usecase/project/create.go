@@ -0,0 +1,44 @@
+package project
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	CreateInput struct {
+		Name        string
+		Description string
+	}
+	CreateStore interface {
+		Create(context.Context, domain.Project) (domain.Project, error)
+	}
+	Create interface {
+		Handle(context.Context, CreateInput) (ProjectOutput, error)
+	}
+	create struct {
+		store CreateStore
+		clock usecase.Clock
+	}
+)
+
+func NewCreate(store CreateStore, clock usecase.Clock) *create {
+	return &create{
+		store: store,
+		clock: clock,
+	}
+}
+
+func (uc *create) Handle(ctx context.Context, input CreateInput) (ProjectOutput, error) {
+	project, err := domain.NewProject(input.Name, input.Description, uc.clock.Now())
+	if err != nil {
+		return ProjectOutput{}, badRequestError(err.Error(), err)
+	}
+	project, err = uc.store.Create(ctx, project)
+	if err != nil {
+		return ProjectOutput{}, internalError("fail to create a project in the repository", err)
+	}
+	return ProjectOutputFromDomain(project), nil
+}
@@ -0,0 +1,85 @@
+package project_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestCreate_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	nameErr := fmt.Errorf("%w: name must have between 1 and 100 characters", domain.ErrProjectInvalidInput)
+	testCases := []struct {
+		name   string
+		store  *createStoreMock
+		input  project.CreateInput
+		result project.ProjectOutput
+		err    error
+	}{
+		{
+			name:   "should fail when input is invalid",
+			store:  new(createStoreMock),
+			input:  project.CreateInput{Name: " "},
+			result: project.ProjectOutput{},
+			err:    usecase.NewError(nameErr.Error(), nameErr, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when repository fails",
+			store: func() *createStoreMock {
+				m := new(createStoreMock)
+				m.On("Create", context.TODO(), domain.Project{Name: "Home", CreatedAt: exampleDate, UpdatedAt: exampleDate}).
+					Return(domain.Project{}, assert.AnError).Once()
+				return m
+			}(),
+			input:  project.CreateInput{Name: "Home"},
+			result: project.ProjectOutput{},
+			err: usecase.NewError("fail to create a project in the repository", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should create a project",
+			store: func() *createStoreMock {
+				m := new(createStoreMock)
+				m.On("Create", context.TODO(), domain.Project{
+					Name: "Home", Description: "chores", CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}).Return(domain.Project{
+					ID: "123", Name: "Home", Description: "chores", CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}, nil).Once()
+				return m
+			}(),
+			input: project.CreateInput{Name: " Home", Description: "chores "},
+			result: project.ProjectOutput{
+				ID: "123", Name: "Home", Description: "chores", CreatedAt: exampleDate, UpdatedAt: exampleDate,
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDate).Once()
+			uc := project.NewCreate(tc.store, clock)
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			clock.AssertExpectations(t)
+		})
+	}
+}
+
+type createStoreMock struct {
+	mock.Mock
+}
+
+func (m *createStoreMock) Create(ctx context.Context, project domain.Project) (domain.Project, error) {
+	args := m.Called(ctx, project)
+	return args.Get(0).(domain.Project), args.Error(1)
+}
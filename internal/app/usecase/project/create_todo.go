@@ -0,0 +1,61 @@
+package project
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	// CreateTodoInput creates a todo in a project.
+	CreateTodoInput struct {
+		ProjectID string
+		Todo      todo.CreateInput
+	}
+	CreateTodo interface {
+		Handle(context.Context, CreateTodoInput) (todo.TodoOutput, error)
+	}
+	createTodo struct {
+		store      GetByIDStore
+		transactor usecase.Transactor
+		create     todo.Create
+		events     usecase.EventPublisher
+	}
+)
+
+func NewCreateTodo(
+	store GetByIDStore, transactor usecase.Transactor, create todo.Create, events usecase.EventPublisher,
+) *createTodo {
+	return &createTodo{
+		store:      store,
+		transactor: transactor,
+		create:     create,
+		events:     events,
+	}
+}
+
+// Handle creates the todo in the same transaction that gets the project, holding the project
+// locked so that it cannot be deleted before the todo joins it. The events of the todo are
+// published once the transaction is committed.
+func (uc *createTodo) Handle(ctx context.Context, input CreateTodoInput) (todo.TodoOutput, error) {
+	var output todo.TodoOutput
+	err := usecase.Publishing(ctx, uc.events, func(ctx context.Context) error {
+		return uc.transactor.Transaction(ctx, func(ctx context.Context) error {
+			project, err := getProject(ctx, uc.store, input.ProjectID)
+			if err != nil {
+				return err
+			}
+			input.Todo.ProjectID = &project.ID
+			output, err = uc.create.Handle(ctx, input.Todo)
+			return err
+		})
+	})
+	if _, ok := err.(usecase.Error); err != nil && !ok {
+		return todo.TodoOutput{}, internalError("fail to create a todo in a project", err)
+	}
+	if err != nil {
+		return todo.TodoOutput{}, err
+	}
+	return output, nil
+}
@@ -0,0 +1,104 @@
+package project_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestCreateTodo_Handle(t *testing.T) {
+	projectID := "p1"
+	commitErr := errors.New("commit failed")
+	testCases := []struct {
+		name       string
+		store      *projectStoreMock
+		transactor *transactorMock
+		create     *createMock
+		input      project.CreateTodoInput
+		result     todo.TodoOutput
+		err        error
+	}{
+		{
+			name: "should fail when project is not found",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", mock.Anything, "p1").Return(domain.Project{}, domain.ErrProjectNotFound).Once()
+				return m
+			}(),
+			transactor: newTransactorMock(),
+			create:     new(createMock),
+			input:      project.CreateTodoInput{ProjectID: "p1", Todo: todo.CreateInput{Title: "example title"}},
+			result:     todo.TodoOutput{},
+			err: usecase.NewError("project not found with id p1", domain.ErrProjectNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should create a todo in the project",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", mock.Anything, "p1").Return(domain.Project{ID: "p1", Name: "Home"}, nil).Once()
+				return m
+			}(),
+			create: func() *createMock {
+				m := new(createMock)
+				m.On("Handle", mock.Anything, todo.CreateInput{Title: "example title", ProjectID: &projectID}).
+					Return(todo.TodoOutput{ID: "1", Title: "example title", ProjectID: &projectID}, nil).Once()
+				return m
+			}(),
+			transactor: newTransactorMock(),
+			input:      project.CreateTodoInput{ProjectID: "p1", Todo: todo.CreateInput{Title: "example title"}},
+			result:     todo.TodoOutput{ID: "1", Title: "example title", ProjectID: &projectID},
+			err:        nil,
+		},
+		{
+			name: "should fail when the transaction cannot be committed",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", mock.Anything, "p1").Return(domain.Project{ID: "p1", Name: "Home"}, nil).Once()
+				return m
+			}(),
+			transactor: func() *transactorMock {
+				m := new(transactorMock)
+				m.On("Transaction", mock.Anything).Return(commitErr).Once()
+				return m
+			}(),
+			create: func() *createMock {
+				m := new(createMock)
+				m.On("Handle", mock.Anything, todo.CreateInput{Title: "example title", ProjectID: &projectID}).
+					Return(todo.TodoOutput{ID: "1", Title: "example title", ProjectID: &projectID}, nil).Once()
+				return m
+			}(),
+			input:  project.CreateTodoInput{ProjectID: "p1", Todo: todo.CreateInput{Title: "example title"}},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to create a todo in a project", commitErr,
+				usecase.ErrorTypeInternalError),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := project.NewCreateTodo(tc.store, tc.transactor, tc.create, newEventPublisherMock())
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.transactor.AssertExpectations(t)
+			tc.create.AssertExpectations(t)
+		})
+	}
+}
+
+type createMock struct {
+	mock.Mock
+}
+
+func (m *createMock) Handle(ctx context.Context, input todo.CreateInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
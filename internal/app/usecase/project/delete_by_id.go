@@ -0,0 +1,138 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// DeletePolicy tells what happens to the todos of a project when it is deleted.
+type DeletePolicy string
+
+const (
+	// DeleteCascade moves the todos to the trash together with the project.
+	DeleteCascade DeletePolicy = "cascade"
+	// DeleteOrphan keeps the todos, removing them from the project.
+	DeleteOrphan DeletePolicy = "orphan"
+	// DeleteRefuse refuses to delete a project that still has todos.
+	DeleteRefuse DeletePolicy = "refuse"
+)
+
+var deletePolicies = []DeletePolicy{DeleteCascade, DeleteOrphan, DeleteRefuse}
+
+// IsValid checks if the policy is a valid DeletePolicy.
+func (p DeletePolicy) IsValid() bool {
+	return slices.Contains(deletePolicies, p)
+}
+
+type (
+	// DeleteByIDInput selects the project to delete. An empty Policy uses the
+	// default policy the usecase was created with.
+	DeleteByIDInput struct {
+		ID     string
+		Policy DeletePolicy
+	}
+	DeleteByIDStore interface {
+		// GetByID returns the project, locked until the end of the transaction
+		GetByID(ctx context.Context, id string) (domain.Project, error)
+		CountTodos(ctx context.Context, projectID string) (int, error)
+		// ListTodoIDs returns the ids of the todos of the project that are not in the trash
+		ListTodoIDs(ctx context.Context, projectID string) ([]string, error)
+		// DeleteByID removes the project, keeping the todos still in it, the ones in the trash,
+		// without a project
+		DeleteByID(ctx context.Context, id string) error
+	}
+	DeleteByID interface {
+		Handle(context.Context, DeleteByIDInput) error
+	}
+	deleteByID struct {
+		store         DeleteByIDStore
+		transactor    usecase.Transactor
+		deleteTodo    todo.DeleteByID
+		moveTodo      todo.Move
+		events        usecase.EventPublisher
+		defaultPolicy DeletePolicy
+	}
+)
+
+func NewDeleteByID(
+	store DeleteByIDStore, transactor usecase.Transactor, deleteTodo todo.DeleteByID, moveTodo todo.Move,
+	events usecase.EventPublisher, defaultPolicy DeletePolicy,
+) *deleteByID {
+	return &deleteByID{
+		store:         store,
+		transactor:    transactor,
+		deleteTodo:    deleteTodo,
+		moveTodo:      moveTodo,
+		events:        events,
+		defaultPolicy: defaultPolicy,
+	}
+}
+
+// Handle deletes the project in a single transaction, holding the project locked so that no todo
+// joins it in between. With cascade its todos are moved to the trash and otherwise they are taken
+// out of the project, each one like a delete or move request, and the events of all of them are
+// published once the project is deleted.
+func (uc *deleteByID) Handle(ctx context.Context, input DeleteByIDInput) error {
+	policy := input.Policy
+	if policy == "" {
+		policy = uc.defaultPolicy
+	}
+	if !policy.IsValid() {
+		return badRequestError("invalid todos policy: must be 'cascade', 'orphan' or 'refuse'", nil)
+	}
+	err := usecase.Publishing(ctx, uc.events, func(ctx context.Context) error {
+		return uc.transactor.Transaction(ctx, func(ctx context.Context) error {
+			return uc.delete(ctx, input.ID, policy)
+		})
+	})
+	if _, ok := err.(usecase.Error); err != nil && !ok {
+		return internalError("fail to delete a project by id", err)
+	}
+	return err
+}
+
+func (uc *deleteByID) delete(ctx context.Context, id string, policy DeletePolicy) error {
+	if _, err := uc.store.GetByID(ctx, id); err != nil {
+		if isNotFound(err) {
+			return notFoundError(id, err)
+		}
+		return internalError("fail to get a project by id", err)
+	}
+	if policy == DeleteRefuse {
+		count, err := uc.store.CountTodos(ctx, id)
+		if err != nil {
+			return internalError("fail to count the todos of a project", err)
+		}
+		if count > 0 {
+			return conflictError(fmt.Sprintf("cannot delete a project with %d todos", count),
+				domain.ErrProjectHasTodos)
+		}
+	} else {
+		todoIDs, err := uc.store.ListTodoIDs(ctx, id)
+		if err != nil {
+			return internalError("fail to list the todos of a project", err)
+		}
+		for _, todoID := range todoIDs {
+			if policy == DeleteCascade {
+				err = uc.deleteTodo.Handle(ctx, todo.DeleteByIDInput{ID: todoID})
+			} else {
+				_, err = uc.moveTodo.Handle(ctx, todo.MoveInput{ID: todoID})
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if err := uc.store.DeleteByID(ctx, id); err != nil {
+		if isNotFound(err) {
+			return notFoundError(id, err)
+		}
+		return internalError("fail to delete a project by id", err)
+	}
+	return nil
+}
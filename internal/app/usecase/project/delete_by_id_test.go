@@ -0,0 +1,281 @@
+package project_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestDeleteByID_Handle(t *testing.T) {
+	existing := domain.Project{ID: "123", Name: "Home"}
+	testCases := []struct {
+		name          string
+		store         *deleteByIDStoreMock
+		deleteTodo    *deleteTodoMock
+		move          *moveMock
+		defaultPolicy project.DeletePolicy
+		input         project.DeleteByIDInput
+		err           error
+	}{
+		{
+			name:          "should fail when policy is invalid",
+			store:         new(deleteByIDStoreMock),
+			deleteTodo:    new(deleteTodoMock),
+			move:          new(moveMock),
+			defaultPolicy: project.DeleteRefuse,
+			input:         project.DeleteByIDInput{ID: "123", Policy: "keep"},
+			err: usecase.NewError("invalid todos policy: must be 'cascade', 'orphan' or 'refuse'", nil,
+				usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when project is not found",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(domain.Project{}, domain.ErrProjectNotFound).Once()
+				return m
+			}(),
+			deleteTodo:    new(deleteTodoMock),
+			move:          new(moveMock),
+			defaultPolicy: project.DeleteOrphan,
+			input:         project.DeleteByIDInput{ID: "123"},
+			err: usecase.NewError("project not found with id 123", domain.ErrProjectNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when getting the project fails",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(domain.Project{}, assert.AnError).Once()
+				return m
+			}(),
+			deleteTodo:    new(deleteTodoMock),
+			move:          new(moveMock),
+			defaultPolicy: project.DeleteOrphan,
+			input:         project.DeleteByIDInput{ID: "123"},
+			err:           usecase.NewError("fail to get a project by id", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should refuse to delete a project with todos",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(existing, nil).Once()
+				m.On("CountTodos", mock.Anything, "123").Return(2, nil).Once()
+				return m
+			}(),
+			deleteTodo:    new(deleteTodoMock),
+			move:          new(moveMock),
+			defaultPolicy: project.DeleteRefuse,
+			input:         project.DeleteByIDInput{ID: "123"},
+			err: usecase.NewError("cannot delete a project with 2 todos", domain.ErrProjectHasTodos,
+				usecase.ErrorTypeConflict),
+		},
+		{
+			name: "should fail when counting the todos fails",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(existing, nil).Once()
+				m.On("CountTodos", mock.Anything, "123").Return(0, assert.AnError).Once()
+				return m
+			}(),
+			deleteTodo:    new(deleteTodoMock),
+			move:          new(moveMock),
+			defaultPolicy: project.DeleteCascade,
+			input:         project.DeleteByIDInput{ID: "123", Policy: project.DeleteRefuse},
+			err: usecase.NewError("fail to count the todos of a project", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should delete an empty project when refusing",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(existing, nil).Once()
+				m.On("CountTodos", mock.Anything, "123").Return(0, nil).Once()
+				m.On("DeleteByID", mock.Anything, "123").Return(nil).Once()
+				return m
+			}(),
+			deleteTodo:    new(deleteTodoMock),
+			move:          new(moveMock),
+			defaultPolicy: project.DeleteRefuse,
+			input:         project.DeleteByIDInput{ID: "123"},
+			err:           nil,
+		},
+		{
+			name: "should fail when listing the todos fails",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(existing, nil).Once()
+				m.On("ListTodoIDs", mock.Anything, "123").Return([]string(nil), assert.AnError).Once()
+				return m
+			}(),
+			deleteTodo:    new(deleteTodoMock),
+			move:          new(moveMock),
+			defaultPolicy: project.DeleteCascade,
+			input:         project.DeleteByIDInput{ID: "123"},
+			err: usecase.NewError("fail to list the todos of a project", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should fail when a todo cannot be deleted",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(existing, nil).Once()
+				m.On("ListTodoIDs", mock.Anything, "123").Return([]string{"1"}, nil).Once()
+				return m
+			}(),
+			deleteTodo: func() *deleteTodoMock {
+				m := new(deleteTodoMock)
+				m.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "1"}).Return(usecase.AnError).Once()
+				return m
+			}(),
+			move:          new(moveMock),
+			defaultPolicy: project.DeleteCascade,
+			input:         project.DeleteByIDInput{ID: "123"},
+			err:           usecase.AnError,
+		},
+		{
+			name: "should fail when store fails",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(existing, nil).Once()
+				m.On("ListTodoIDs", mock.Anything, "123").Return([]string{}, nil).Once()
+				m.On("DeleteByID", mock.Anything, "123").Return(assert.AnError).Once()
+				return m
+			}(),
+			deleteTodo:    new(deleteTodoMock),
+			move:          new(moveMock),
+			defaultPolicy: project.DeleteCascade,
+			input:         project.DeleteByIDInput{ID: "123"},
+			err: usecase.NewError("fail to delete a project by id", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should delete a project moving its todos to the trash",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(existing, nil).Once()
+				m.On("ListTodoIDs", mock.Anything, "123").Return([]string{"1", "2"}, nil).Once()
+				m.On("DeleteByID", mock.Anything, "123").Return(nil).Once()
+				return m
+			}(),
+			deleteTodo: func() *deleteTodoMock {
+				m := new(deleteTodoMock)
+				m.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "1"}).Return(nil).Once()
+				m.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "2"}).Return(nil).Once()
+				return m
+			}(),
+			move:          new(moveMock),
+			defaultPolicy: project.DeleteRefuse,
+			input:         project.DeleteByIDInput{ID: "123", Policy: project.DeleteCascade},
+			err:           nil,
+		},
+		{
+			name: "should delete a project taking its todos out of it",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(existing, nil).Once()
+				m.On("ListTodoIDs", mock.Anything, "123").Return([]string{"1"}, nil).Once()
+				m.On("DeleteByID", mock.Anything, "123").Return(nil).Once()
+				return m
+			}(),
+			deleteTodo: new(deleteTodoMock),
+			move: func() *moveMock {
+				m := new(moveMock)
+				m.On("Handle", mock.Anything, todo.MoveInput{ID: "1"}).
+					Return(todo.TodoOutput{ID: "1", Version: 2}, nil).Once()
+				return m
+			}(),
+			defaultPolicy: project.DeleteRefuse,
+			input:         project.DeleteByIDInput{ID: "123", Policy: project.DeleteOrphan},
+			err:           nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := project.NewDeleteByID(tc.store, newTransactorMock(), tc.deleteTodo, tc.move,
+				newEventPublisherMock(), tc.defaultPolicy)
+			err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.deleteTodo.AssertExpectations(t)
+			tc.move.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteByID_Handle_Events(t *testing.T) {
+	deleted := domain.Event{Type: domain.EventTodoDeleted, TodoID: "1"}
+	newDeleteTodo := func() *deleteTodoMock {
+		m := new(deleteTodoMock)
+		m.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "1"}).Return(nil).
+			Run(func(args mock.Arguments) {
+				usecase.RaiseEvent(args.Get(0).(context.Context), deleted)
+			}).Once()
+		return m
+	}
+
+	t.Run("should publish the events of the todos once the project is deleted", func(t *testing.T) {
+		store := new(deleteByIDStoreMock)
+		store.On("GetByID", mock.Anything, "123").Return(domain.Project{ID: "123"}, nil).Once()
+		store.On("ListTodoIDs", mock.Anything, "123").Return([]string{"1"}, nil).Once()
+		store.On("DeleteByID", mock.Anything, "123").Return(nil).Once()
+		publisher := new(eventPublisherMock)
+		publisher.On("Publish", context.TODO(), deleted).Return().Once()
+		uc := project.NewDeleteByID(store, newTransactorMock(), newDeleteTodo(), new(moveMock), publisher,
+			project.DeleteCascade)
+		err := uc.Handle(context.TODO(), project.DeleteByIDInput{ID: "123"})
+		assert.Nil(t, err)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("should publish nothing when the project cannot be deleted", func(t *testing.T) {
+		store := new(deleteByIDStoreMock)
+		store.On("GetByID", mock.Anything, "123").Return(domain.Project{ID: "123"}, nil).Once()
+		store.On("ListTodoIDs", mock.Anything, "123").Return([]string{"1"}, nil).Once()
+		store.On("DeleteByID", mock.Anything, "123").Return(assert.AnError).Once()
+		publisher := new(eventPublisherMock)
+		uc := project.NewDeleteByID(store, newTransactorMock(), newDeleteTodo(), new(moveMock), publisher,
+			project.DeleteCascade)
+		err := uc.Handle(context.TODO(), project.DeleteByIDInput{ID: "123"})
+		assert.NotNil(t, err)
+		publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+}
+
+type deleteByIDStoreMock struct {
+	mock.Mock
+}
+
+func (m *deleteByIDStoreMock) GetByID(ctx context.Context, id string) (domain.Project, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Project), args.Error(1)
+}
+
+func (m *deleteByIDStoreMock) CountTodos(ctx context.Context, projectID string) (int, error) {
+	args := m.Called(ctx, projectID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *deleteByIDStoreMock) ListTodoIDs(ctx context.Context, projectID string) ([]string, error) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *deleteByIDStoreMock) DeleteByID(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type deleteTodoMock struct {
+	mock.Mock
+}
+
+func (m *deleteTodoMock) Handle(ctx context.Context, input todo.DeleteByIDInput) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
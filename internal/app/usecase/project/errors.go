@@ -0,0 +1,33 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func notFoundError(id string, cause error) error {
+	return usecase.NewError(
+		fmt.Sprintf("project not found with id %s", id),
+		cause,
+		usecase.ErrorTypeNotFound,
+	)
+}
+
+func conflictError(msg string, cause error) error {
+	return usecase.NewError(msg, cause, usecase.ErrorTypeConflict)
+}
+
+func internalError(msg string, cause error) error {
+	return usecase.NewError(msg, cause, usecase.ErrorTypeInternalError)
+}
+
+func badRequestError(msg string, cause error) error {
+	return usecase.NewError(msg, cause, usecase.ErrorTypeBadRequest)
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, domain.ErrProjectNotFound)
+}
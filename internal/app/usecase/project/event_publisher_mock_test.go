@@ -0,0 +1,23 @@
+package project_test
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type eventPublisherMock struct {
+	mock.Mock
+}
+
+// newEventPublisherMock returns an event publisher that accepts every event it is given.
+func newEventPublisherMock() *eventPublisherMock {
+	m := new(eventPublisherMock)
+	m.On("Publish", mock.Anything, mock.Anything).Return().Maybe()
+	return m
+}
+
+func (m *eventPublisherMock) Publish(ctx context.Context, event domain.Event) {
+	m.Called(ctx, event)
+}
@@ -0,0 +1,43 @@
+package project
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	GetByIDStore interface {
+		GetByID(context.Context, string) (domain.Project, error)
+	}
+	GetByID interface {
+		Handle(ctx context.Context, id string) (ProjectOutput, error)
+	}
+	getByID struct {
+		store GetByIDStore
+	}
+)
+
+func NewGetByID(store GetByIDStore) *getByID {
+	return &getByID{store}
+}
+
+func (uc *getByID) Handle(ctx context.Context, id string) (ProjectOutput, error) {
+	project, err := getProject(ctx, uc.store, id)
+	if err != nil {
+		return ProjectOutput{}, err
+	}
+	return ProjectOutputFromDomain(project), nil
+}
+
+// getProject gets the project with the id, returning usecase errors.
+func getProject(ctx context.Context, store GetByIDStore, id string) (domain.Project, error) {
+	project, err := store.GetByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			return domain.Project{}, notFoundError(id, err)
+		}
+		return domain.Project{}, internalError("fail to get a project by id", err)
+	}
+	return project, nil
+}
@@ -0,0 +1,69 @@
+package project_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestGetByID_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name   string
+		store  *projectStoreMock
+		id     string
+		result project.ProjectOutput
+		err    error
+	}{
+		{
+			name: "should fail when project is not found",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", context.TODO(), "123").Return(domain.Project{}, domain.ErrProjectNotFound).Once()
+				return m
+			}(),
+			id:     "123",
+			result: project.ProjectOutput{},
+			err: usecase.NewError("project not found with id 123", domain.ErrProjectNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when store fails",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", context.TODO(), "123").Return(domain.Project{}, assert.AnError).Once()
+				return m
+			}(),
+			id:     "123",
+			result: project.ProjectOutput{},
+			err:    usecase.NewError("fail to get a project by id", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should get a project by id",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", context.TODO(), "123").Return(domain.Project{
+					ID: "123", Name: "Home", CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}, nil).Once()
+				return m
+			}(),
+			id:     "123",
+			result: project.ProjectOutput{ID: "123", Name: "Home", CreatedAt: exampleDate, UpdatedAt: exampleDate},
+			err:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := project.NewGetByID(tc.store)
+			result, err := uc.Handle(context.TODO(), tc.id)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
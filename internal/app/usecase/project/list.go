@@ -0,0 +1,32 @@
+package project
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	ListStore interface {
+		List(context.Context) ([]domain.Project, error)
+	}
+	List interface {
+		Handle(context.Context) ([]ProjectOutput, error)
+	}
+	list struct {
+		store ListStore
+	}
+)
+
+func NewList(store ListStore) *list {
+	return &list{store}
+}
+
+// Handle returns every project ordered by name.
+func (uc *list) Handle(ctx context.Context) ([]ProjectOutput, error) {
+	projects, err := uc.store.List(ctx)
+	if err != nil {
+		return nil, internalError("fail to list projects", err)
+	}
+	return ProjectOutputsFromDomain(projects), nil
+}
@@ -0,0 +1,78 @@
+package project_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestList_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name   string
+		store  *listStoreMock
+		result []project.ProjectOutput
+		err    error
+	}{
+		{
+			name: "should fail when store fails",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO()).Return([]domain.Project(nil), assert.AnError).Once()
+				return m
+			}(),
+			result: nil,
+			err:    usecase.NewError("fail to list projects", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should return an empty list",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO()).Return([]domain.Project(nil), nil).Once()
+				return m
+			}(),
+			result: []project.ProjectOutput{},
+			err:    nil,
+		},
+		{
+			name: "should list projects",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO()).Return([]domain.Project{
+					{ID: "1", Name: "Home", CreatedAt: exampleDate, UpdatedAt: exampleDate},
+					{ID: "2", Name: "Work", CreatedAt: exampleDate, UpdatedAt: exampleDate},
+				}, nil).Once()
+				return m
+			}(),
+			result: []project.ProjectOutput{
+				{ID: "1", Name: "Home", CreatedAt: exampleDate, UpdatedAt: exampleDate},
+				{ID: "2", Name: "Work", CreatedAt: exampleDate, UpdatedAt: exampleDate},
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := project.NewList(tc.store)
+			result, err := uc.Handle(context.TODO())
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
+
+type listStoreMock struct {
+	mock.Mock
+}
+
+func (m *listStoreMock) List(ctx context.Context) ([]domain.Project, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Project), args.Error(1)
+}
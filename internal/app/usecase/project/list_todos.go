@@ -0,0 +1,39 @@
+package project
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	// ListTodosInput lists the todos of a project, the filter of List being
+	// narrowed to the project.
+	ListTodosInput struct {
+		ProjectID string
+		List      todo.ListInput
+	}
+	ListTodos interface {
+		Handle(context.Context, ListTodosInput) (todo.ListOutput, error)
+	}
+	listTodos struct {
+		store GetByIDStore
+		list  todo.List
+	}
+)
+
+func NewListTodos(store GetByIDStore, list todo.List) *listTodos {
+	return &listTodos{
+		store: store,
+		list:  list,
+	}
+}
+
+func (uc *listTodos) Handle(ctx context.Context, input ListTodosInput) (todo.ListOutput, error) {
+	project, err := getProject(ctx, uc.store, input.ProjectID)
+	if err != nil {
+		return todo.ListOutput{}, err
+	}
+	input.List.Filter.ProjectID = &project.ID
+	return uc.list.Handle(ctx, input.List)
+}
@@ -0,0 +1,83 @@
+package project_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestListTodos_Handle(t *testing.T) {
+	projectID := "p1"
+	status := domain.TodoStatusPending
+	testCases := []struct {
+		name   string
+		store  *projectStoreMock
+		list   *listMock
+		input  project.ListTodosInput
+		result todo.ListOutput
+		err    error
+	}{
+		{
+			name: "should fail when project is not found",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", context.TODO(), "p1").Return(domain.Project{}, domain.ErrProjectNotFound).Once()
+				return m
+			}(),
+			list:   new(listMock),
+			input:  project.ListTodosInput{ProjectID: "p1"},
+			result: todo.ListOutput{},
+			err: usecase.NewError("project not found with id p1", domain.ErrProjectNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should list the todos of the project",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", context.TODO(), "p1").Return(domain.Project{ID: "p1", Name: "Home"}, nil).Once()
+				return m
+			}(),
+			list: func() *listMock {
+				m := new(listMock)
+				m.On("Handle", context.TODO(), todo.ListInput{
+					Filter: todo.ListFilter{Status: &status, ProjectID: &projectID},
+					Limit:  10,
+				}).Return(todo.ListOutput{
+					Todos: []todo.TodoOutput{{ID: "1", ProjectID: &projectID}},
+				}, nil).Once()
+				return m
+			}(),
+			input: project.ListTodosInput{
+				ProjectID: "p1",
+				List:      todo.ListInput{Filter: todo.ListFilter{Status: &status}, Limit: 10},
+			},
+			result: todo.ListOutput{Todos: []todo.TodoOutput{{ID: "1", ProjectID: &projectID}}},
+			err:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := project.NewListTodos(tc.store, tc.list)
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.list.AssertExpectations(t)
+		})
+	}
+}
+
+type listMock struct {
+	mock.Mock
+}
+
+func (m *listMock) Handle(ctx context.Context, input todo.ListInput) (todo.ListOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.ListOutput), args.Error(1)
+}
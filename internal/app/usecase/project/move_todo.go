@@ -0,0 +1,33 @@
+package project
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	// MoveTodoInput moves a todo to the project with ProjectID, or out of its
+	// project when ProjectID is nil.
+	MoveTodoInput struct {
+		TodoID    string
+		ProjectID *string
+	}
+	MoveTodo interface {
+		Handle(context.Context, MoveTodoInput) (todo.TodoOutput, error)
+	}
+	moveTodo struct {
+		move todo.Move
+	}
+)
+
+func NewMoveTodo(move todo.Move) *moveTodo {
+	return &moveTodo{
+		move: move,
+	}
+}
+
+// Handle moves the todo like the todo usecase does, which checks the project in the same unit of work.
+func (uc *moveTodo) Handle(ctx context.Context, input MoveTodoInput) (todo.TodoOutput, error) {
+	return uc.move.Handle(ctx, todo.MoveInput{ID: input.TodoID, ProjectID: input.ProjectID})
+}
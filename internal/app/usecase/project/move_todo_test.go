@@ -0,0 +1,69 @@
+package project_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestMoveTodo_Handle(t *testing.T) {
+	projectID := "p1"
+	testCases := []struct {
+		name   string
+		move   *moveMock
+		input  project.MoveTodoInput
+		result todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should fail when the todo cannot be moved",
+			move: func() *moveMock {
+				m := new(moveMock)
+				m.On("Handle", context.TODO(), todo.MoveInput{ID: "123", ProjectID: &projectID}).
+					Return(todo.TodoOutput{}, usecase.NewError("project not found with id p1",
+						domain.ErrProjectNotFound, usecase.ErrorTypeNotFound)).Once()
+				return m
+			}(),
+			input:  project.MoveTodoInput{TodoID: "123", ProjectID: &projectID},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("project not found with id p1", domain.ErrProjectNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should move a todo to a project",
+			move: func() *moveMock {
+				m := new(moveMock)
+				m.On("Handle", context.TODO(), todo.MoveInput{ID: "123", ProjectID: &projectID}).
+					Return(todo.TodoOutput{ID: "123", ProjectID: &projectID, Version: 2}, nil).Once()
+				return m
+			}(),
+			input:  project.MoveTodoInput{TodoID: "123", ProjectID: &projectID},
+			result: todo.TodoOutput{ID: "123", ProjectID: &projectID, Version: 2},
+			err:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := project.NewMoveTodo(tc.move)
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.move.AssertExpectations(t)
+		})
+	}
+}
+
+type moveMock struct {
+	mock.Mock
+}
+
+func (m *moveMock) Handle(ctx context.Context, input todo.MoveInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
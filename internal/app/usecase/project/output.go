@@ -0,0 +1,36 @@
+package project
+
+import (
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// ProjectOutput represents the output structure for project operations
+type ProjectOutput struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ProjectOutputFromDomain converts a domain.Project to ProjectOutput
+func ProjectOutputFromDomain(project domain.Project) ProjectOutput {
+	return ProjectOutput{
+		ID:          project.ID,
+		Name:        project.Name,
+		Description: project.Description,
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   project.UpdatedAt,
+	}
+}
+
+// ProjectOutputsFromDomain converts a slice of domain.Project to []ProjectOutput
+func ProjectOutputsFromDomain(projects []domain.Project) []ProjectOutput {
+	outputs := make([]ProjectOutput, 0, len(projects))
+	for _, project := range projects {
+		outputs = append(outputs, ProjectOutputFromDomain(project))
+	}
+	return outputs
+}
@@ -0,0 +1,22 @@
+package project_test
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type projectStoreMock struct {
+	mock.Mock
+}
+
+func (m *projectStoreMock) GetByID(ctx context.Context, id string) (domain.Project, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Project), args.Error(1)
+}
+
+func (m *projectStoreMock) Update(ctx context.Context, project domain.Project) (domain.Project, error) {
+	args := m.Called(ctx, project)
+	return args.Get(0).(domain.Project), args.Error(1)
+}
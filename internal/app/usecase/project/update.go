@@ -0,0 +1,53 @@
+package project
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	UpdateInput struct {
+		ID          string
+		Name        string
+		Description string
+	}
+	UpdateStore interface {
+		GetByIDStore
+		Update(context.Context, domain.Project) (domain.Project, error)
+	}
+	Update interface {
+		Handle(context.Context, UpdateInput) (ProjectOutput, error)
+	}
+	update struct {
+		store UpdateStore
+		clock usecase.Clock
+	}
+)
+
+func NewUpdate(store UpdateStore, clock usecase.Clock) *update {
+	return &update{
+		store: store,
+		clock: clock,
+	}
+}
+
+func (uc *update) Handle(ctx context.Context, input UpdateInput) (ProjectOutput, error) {
+	project, err := getProject(ctx, uc.store, input.ID)
+	if err != nil {
+		return ProjectOutput{}, err
+	}
+	project, err = project.Update(input.Name, input.Description, uc.clock.Now())
+	if err != nil {
+		return ProjectOutput{}, badRequestError(err.Error(), err)
+	}
+	project, err = uc.store.Update(ctx, project)
+	if err != nil {
+		if isNotFound(err) {
+			return ProjectOutput{}, notFoundError(input.ID, err)
+		}
+		return ProjectOutput{}, internalError("fail to update a project in the repository", err)
+	}
+	return ProjectOutputFromDomain(project), nil
+}
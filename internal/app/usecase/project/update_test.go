@@ -0,0 +1,111 @@
+package project_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestUpdate_Handle(t *testing.T) {
+	created, _ := time.Parse(time.DateOnly, "2024-01-01")
+	updated := created.Add(time.Hour)
+	existing := domain.Project{ID: "123", Name: "Home", CreatedAt: created, UpdatedAt: created}
+	nameErr := fmt.Errorf("%w: name must have between 1 and 100 characters", domain.ErrProjectInvalidInput)
+	testCases := []struct {
+		name   string
+		store  *projectStoreMock
+		clock  *clockMock
+		input  project.UpdateInput
+		result project.ProjectOutput
+		err    error
+	}{
+		{
+			name: "should fail when project is not found",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", context.TODO(), "123").Return(domain.Project{}, domain.ErrProjectNotFound).Once()
+				return m
+			}(),
+			clock:  newClockMock(),
+			input:  project.UpdateInput{ID: "123", Name: "Work"},
+			result: project.ProjectOutput{},
+			err: usecase.NewError("project not found with id 123", domain.ErrProjectNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when input is invalid",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", context.TODO(), "123").Return(existing, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(updated).Once()
+				return m
+			}(),
+			input:  project.UpdateInput{ID: "123"},
+			result: project.ProjectOutput{},
+			err:    usecase.NewError(nameErr.Error(), nameErr, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when store fails to update",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", context.TODO(), "123").Return(existing, nil).Once()
+				m.On("Update", context.TODO(), domain.Project{
+					ID: "123", Name: "Work", CreatedAt: created, UpdatedAt: updated,
+				}).Return(domain.Project{}, assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(updated).Once()
+				return m
+			}(),
+			input:  project.UpdateInput{ID: "123", Name: "Work"},
+			result: project.ProjectOutput{},
+			err: usecase.NewError("fail to update a project in the repository", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should update a project",
+			store: func() *projectStoreMock {
+				m := new(projectStoreMock)
+				m.On("GetByID", context.TODO(), "123").Return(existing, nil).Once()
+				m.On("Update", context.TODO(), domain.Project{
+					ID: "123", Name: "Work", Description: "office", CreatedAt: created, UpdatedAt: updated,
+				}).Return(domain.Project{
+					ID: "123", Name: "Work", Description: "office", CreatedAt: created, UpdatedAt: updated,
+				}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(updated).Once()
+				return m
+			}(),
+			input: project.UpdateInput{ID: "123", Name: "Work", Description: "office"},
+			result: project.ProjectOutput{
+				ID: "123", Name: "Work", Description: "office", CreatedAt: created, UpdatedAt: updated,
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := project.NewUpdate(tc.store, tc.clock)
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	AddItemInput struct {
+		TodoID string
+		Title  string
+	}
+	AddItemStore = TodoUpdater
+	AddItem      interface {
+		Handle(context.Context, AddItemInput) (TodoOutput, error)
+	}
+	addItem struct {
+		store      AddItemStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewAddItem(
+	store AddItemStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *addItem {
+	return &addItem{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+func (uc *addItem) Handle(ctx context.Context, input AddItemInput) (TodoOutput, error) {
+	return changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events, domain.AuditOperationUpdate,
+		input.TodoID, nil,
+		func(todo domain.Todo) (domain.Todo, error) {
+			todo, err := todo.AddItem(input.Title, uc.clock.Now())
+			if err != nil {
+				return domain.Todo{}, badRequestError(err.Error(), err)
+			}
+			return todo, nil
+		})
+}
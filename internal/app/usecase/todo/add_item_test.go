@@ -0,0 +1,121 @@
+package todo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestAddItem_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	exampleTodo := domain.Todo{
+		ID:        "123",
+		Title:     "paint the room",
+		Status:    domain.TodoStatusPending,
+		Items:     []domain.ChecklistItem{{ID: "1", Title: "buy paint", Done: true}},
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDate,
+	}
+	changedTodo := domain.Todo{
+		ID:        "123",
+		Title:     "paint the room",
+		Status:    domain.TodoStatusPending,
+		Items:     []domain.ChecklistItem{{ID: "1", Title: "buy paint", Done: true}, {Title: "paint"}},
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDateUpdated,
+	}
+	savedTodo := changedTodo
+	savedTodo.Items = []domain.ChecklistItem{{ID: "1", Title: "buy paint", Done: true}, {ID: "2", Title: "paint"}}
+	testCases := []struct {
+		name   string
+		store  *todoUpdaterMock
+		clock  *clockMock
+		input  todo.AddItemInput
+		result todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should fail when todo not found",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			clock:  newClockMock(),
+			input:  todo.AddItemInput{TodoID: "123", Title: "paint"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo not found with id 123",
+				domain.ErrTodoNotFound, usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when title is invalid",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			input:  todo.AddItemInput{TodoID: "123", Title: " "},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo invalid input: item title must have between 1 and 200 characters",
+				fmt.Errorf("%w: item title must have between 1 and 200 characters", domain.ErrTodoInvalidInput),
+				usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when update fails",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				m.On("Update", mock.Anything, changedTodo).Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			input:  todo.AddItemInput{TodoID: "123", Title: "paint"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to update a todo in the store", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should add an item",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				m.On("Update", mock.Anything, changedTodo).Return(savedTodo, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			input:  todo.AddItemInput{TodoID: "123", Title: "paint"},
+			result: todo.TodoOutputFromDomain(savedTodo),
+			err:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewAddItem(tc.store, newTransactorMock(), tc.clock, newAuditStoreMock(), newEventPublisherMock())
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	ArchiveInput struct {
+		ID string
+		// Versions, when given, are the only versions the todo may still have
+		Versions []int
+	}
+	ArchiveStore = TodoUpdater
+	Archive      interface {
+		Handle(context.Context, ArchiveInput) (TodoOutput, error)
+	}
+	archive struct {
+		store      ArchiveStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewArchive(
+	store ArchiveStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *archive {
+	return &archive{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+func (uc *archive) Handle(ctx context.Context, input ArchiveInput) (TodoOutput, error) {
+	return changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events, domain.AuditOperationArchive,
+		input.ID, input.Versions,
+		func(todo domain.Todo) (domain.Todo, error) {
+			return todo.Archive(uc.clock.Now()), nil
+		})
+}
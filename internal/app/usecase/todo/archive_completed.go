@@ -0,0 +1,77 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// AutoArchiveAfter is how long a todo stays completed before it is archived. Zero disables it.
+type AutoArchiveAfter time.Duration
+
+type (
+	ArchiveCompletedStore interface {
+		// ListCompletedBefore returns the completed todos that are not archived and were completed
+		// before completedBefore
+		ListCompletedBefore(ctx context.Context, completedBefore time.Time) ([]domain.Todo, error)
+		TodoUpdater
+	}
+	ArchiveCompleted interface {
+		Handle(context.Context) (int, error)
+	}
+	archiveCompleted struct {
+		store      ArchiveCompletedStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+		after      AutoArchiveAfter
+	}
+)
+
+func NewArchiveCompleted(
+	store ArchiveCompletedStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher, after AutoArchiveAfter,
+) *archiveCompleted {
+	return &archiveCompleted{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+		after:      after,
+	}
+}
+
+// Handle archives the todos completed longer ago than the configured time, each one like an
+// archive request, and returns how many were archived. A completed todo without a CompletedAt is
+// taken as completed when it was last updated. A todo changed or deleted since it was listed is
+// left for the next run.
+func (uc *archiveCompleted) Handle(ctx context.Context) (int, error) {
+	if uc.after <= 0 {
+		return 0, nil
+	}
+	now := uc.clock.Now()
+	todos, err := uc.store.ListCompletedBefore(ctx, now.Add(-time.Duration(uc.after)))
+	if err != nil {
+		return 0, internalError("fail to list the completed todos to archive", err)
+	}
+	archived := 0
+	for _, todo := range todos {
+		_, err := changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events, domain.AuditOperationArchive,
+			todo.ID, []int{todo.Version},
+			func(todo domain.Todo) (domain.Todo, error) {
+				return todo.Archive(now), nil
+			})
+		if isChangedSince(err) {
+			continue
+		}
+		if err != nil {
+			return archived, err
+		}
+		archived++
+	}
+	return archived, nil
+}
@@ -0,0 +1,133 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestArchiveCompleted_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-31")
+	completedBefore, _ := time.Parse(time.DateOnly, "2024-01-01")
+	after := todo.AutoArchiveAfter(30 * 24 * time.Hour)
+	completed := domain.Todo{ID: "1", Status: domain.TodoStatusCompleted, Version: 2}
+	changed := domain.Todo{ID: "2", Status: domain.TodoStatusCompleted, Version: 5}
+	archived := completed
+	archived.ArchivedAt = &exampleDate
+	archived.UpdatedAt = exampleDate
+	testCases := []struct {
+		name   string
+		store  *archiveCompletedStoreMock
+		clock  *clockMock
+		audit  *auditStoreMock
+		after  todo.AutoArchiveAfter
+		result int
+		err    error
+	}{
+		{
+			name:   "should do nothing when auto archive is disabled",
+			store:  new(archiveCompletedStoreMock),
+			clock:  newClockMock(),
+			audit:  new(auditStoreMock),
+			after:  0,
+			result: 0,
+			err:    nil,
+		},
+		{
+			name: "should fail when store fails",
+			store: func() *archiveCompletedStoreMock {
+				m := new(archiveCompletedStoreMock)
+				m.On("ListCompletedBefore", context.TODO(), completedBefore).
+					Return([]domain.Todo(nil), assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit:  new(auditStoreMock),
+			after:  after,
+			result: 0,
+			err: usecase.NewError("fail to list the completed todos to archive", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should fail when a todo cannot be archived",
+			store: func() *archiveCompletedStoreMock {
+				m := new(archiveCompletedStoreMock)
+				m.On("ListCompletedBefore", context.TODO(), completedBefore).
+					Return([]domain.Todo{completed}, nil).Once()
+				m.On("GetByID", mock.Anything, "1").Return(completed, nil).Once()
+				m.On("Update", mock.Anything, archived).Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit:  new(auditStoreMock),
+			after:  after,
+			result: 0,
+			err: usecase.NewError("fail to update a todo in the store", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should archive the todos completed longer ago than the configured time",
+			store: func() *archiveCompletedStoreMock {
+				m := new(archiveCompletedStoreMock)
+				m.On("ListCompletedBefore", context.TODO(), completedBefore).
+					Return([]domain.Todo{completed, changed}, nil).Once()
+				m.On("GetByID", mock.Anything, "1").Return(completed, nil).Once()
+				m.On("Update", mock.Anything, archived).Return(archived, nil).Once()
+				reopened := changed
+				reopened.Version = 6
+				m.On("GetByID", mock.Anything, "2").Return(reopened, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.TodoID == "1" && record.Operation == domain.AuditOperationArchive
+				})).Return(nil).Once()
+				return m
+			}(),
+			after:  after,
+			result: 1,
+			err:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewArchiveCompleted(tc.store, newTransactorMock(), tc.clock, tc.audit,
+				newEventPublisherMock(), tc.after)
+			result, err := uc.Handle(context.TODO())
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+			tc.audit.AssertExpectations(t)
+		})
+	}
+}
+
+type archiveCompletedStoreMock struct {
+	todoUpdaterMock
+}
+
+func (m *archiveCompletedStoreMock) ListCompletedBefore(ctx context.Context, completedBefore time.Time) ([]domain.Todo, error) {
+	args := m.Called(ctx, completedBefore)
+	return args.Get(0).([]domain.Todo), args.Error(1)
+}
@@ -0,0 +1,163 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestArchive_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	version := 2
+	testCases := []struct {
+		name   string
+		store  *todoUpdaterMock
+		clock  *clockMock
+		input  todo.ArchiveInput
+		result todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should fail when todo not found",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			clock:  newClockMock(),
+			input:  todo.ArchiveInput{ID: "123"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo not found with id 123",
+				domain.ErrTodoNotFound, usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when the todo is not at the expected version",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Version: 3}, nil).Once()
+				return m
+			}(),
+			clock:  newClockMock(),
+			input:  todo.ArchiveInput{ID: "123", Versions: []int{version}},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo has changed: expected version 2, current version is 3",
+				domain.ErrTodoVersionConflict, usecase.ErrorTypePreconditionFailed),
+		},
+		{
+			name: "should fail when update fails",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", UpdatedAt: exampleDate}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:         "123",
+					UpdatedAt:  exampleDateUpdated,
+					ArchivedAt: &exampleDateUpdated,
+				}).Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			input:  todo.ArchiveInput{ID: "123"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to update a todo in the store", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should archive a todo",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{
+						ID:        "123",
+						Title:     "example title",
+						Status:    domain.TodoStatusCompleted,
+						CreatedAt: exampleDate,
+						UpdatedAt: exampleDate,
+						Version:   2,
+					}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:         "123",
+					Title:      "example title",
+					Status:     domain.TodoStatusCompleted,
+					CreatedAt:  exampleDate,
+					UpdatedAt:  exampleDateUpdated,
+					ArchivedAt: &exampleDateUpdated,
+					Version:    2,
+				}).Return(domain.Todo{
+					ID:         "123",
+					Title:      "example title",
+					Status:     domain.TodoStatusCompleted,
+					CreatedAt:  exampleDate,
+					UpdatedAt:  exampleDateUpdated,
+					ArchivedAt: &exampleDateUpdated,
+					Version:    3,
+				}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			input: todo.ArchiveInput{ID: "123", Versions: []int{version}},
+			result: todo.TodoOutput{
+				ID:         "123",
+				Title:      "example title",
+				Status:     "completed",
+				CreatedAt:  exampleDate,
+				UpdatedAt:  exampleDateUpdated,
+				ArchivedAt: &exampleDateUpdated,
+				Version:    3,
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewArchive(tc.store, newTransactorMock(), tc.clock, newAuditStoreMock(), newEventPublisherMock())
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestArchive_Handle_Events(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	stored := domain.Todo{ID: "123", Title: "example title", Status: domain.TodoStatusPending, Version: 1}
+	archived := stored.Archive(exampleDate)
+	saved := archived
+	saved.Version = 2
+	store := new(todoUpdaterMock)
+	store.On("GetByID", mock.Anything, "123").Return(stored, nil).Once()
+	store.On("Update", mock.Anything, archived).Return(saved, nil).Once()
+	clock := newClockMock()
+	clock.On("Now").Return(exampleDate).Once()
+	publisher := new(eventPublisherMock)
+	publisher.On("Publish", context.TODO(), domain.Event{
+		Type:       domain.EventTodoUpdated,
+		TodoID:     "123",
+		Todo:       saved,
+		Actor:      usecase.AnonymousActor,
+		OccurredAt: exampleDate,
+	}).Return().Once()
+	uc := todo.NewArchive(store, newTransactorMock(), clock, newAuditStoreMock(), publisher)
+	_, err := uc.Handle(context.TODO(), todo.ArchiveInput{ID: "123"})
+	assert.Nil(t, err)
+	publisher.AssertExpectations(t)
+}
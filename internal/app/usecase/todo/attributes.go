@@ -0,0 +1,16 @@
+package todo
+
+import "github.com/wellingtonlope/todo-api/internal/domain"
+
+// withAttributes sets the optional attributes shared by the create and update inputs.
+func withAttributes(todo domain.Todo, priority domain.TodoPriority, tags []string, recurrence string) (domain.Todo, error) {
+	todo, err := todo.WithPriority(priority)
+	if err != nil {
+		return domain.Todo{}, err
+	}
+	todo, err = todo.WithTags(tags)
+	if err != nil {
+		return domain.Todo{}, err
+	}
+	return todo.WithRecurrence(recurrence)
+}
@@ -0,0 +1,120 @@
+package todo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// AuditStore appends the records of the changes of todos to the audit log. Records appended
+// inside a unit of work are only kept when it is committed, together with the change.
+type AuditStore interface {
+	Record(context.Context, domain.AuditRecord) error
+}
+
+// auditDocument is the shape of a todo compared by the diffs of the audit log.
+type auditDocument struct {
+	todoDocument
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+type auditFieldChange struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// recordAudit appends the change of a todo from before to after to the audit log, made at date
+// by the actor of ctx, and raises its domain event. before is nil for a created todo and after
+// is nil for a deleted one.
+func recordAudit(
+	ctx context.Context, store AuditStore, operation domain.AuditOperation, before, after *domain.Todo, date time.Time,
+) error {
+	diff, err := auditDiff(before, after)
+	if err != nil {
+		return internalError("fail to compute the changes of a todo for the audit log", err)
+	}
+	todoID := ""
+	if after != nil {
+		todoID = after.ID
+	} else if before != nil {
+		todoID = before.ID
+	}
+	err = store.Record(ctx, domain.AuditRecord{
+		TodoID:    todoID,
+		Actor:     usecase.ActorFromContext(ctx),
+		Operation: operation,
+		Diff:      diff,
+		CreatedAt: date,
+	})
+	if err != nil {
+		return internalError("fail to record a todo change in the audit log", err)
+	}
+	raiseEvent(ctx, operation, before, after, date)
+	return nil
+}
+
+// auditDiff returns a JSON object with the fields whose value is not the same before and after,
+// each one with both values. A field missing on one side, like an unset due date, is null there.
+func auditDiff(before, after *domain.Todo) (json.RawMessage, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+	diff := map[string]auditFieldChange{}
+	for _, fields := range []map[string]json.RawMessage{beforeFields, afterFields} {
+		for name := range fields {
+			change := auditFieldChange{Before: auditValue(beforeFields, name), After: auditValue(afterFields, name)}
+			if !bytes.Equal(change.Before, change.After) {
+				diff[name] = change
+			}
+		}
+	}
+	return json.Marshal(diff)
+}
+
+// auditFields returns the JSON value of each field of the todo, none for a nil todo.
+func auditFields(todo *domain.Todo) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if todo == nil {
+		return fields, nil
+	}
+	document, err := json.Marshal(auditDocument{
+		todoDocument: todoDocumentFromDomain(*todo),
+		CompletedAt:  todo.CompletedAt,
+		ArchivedAt:   todo.ArchivedAt,
+		DeletedAt:    todo.DeletedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fields, json.Unmarshal(document, &fields)
+}
+
+func auditValue(fields map[string]json.RawMessage, name string) json.RawMessage {
+	if value, ok := fields[name]; ok {
+		return value
+	}
+	return json.RawMessage("null")
+}
+
+// transitionOperation is the operation of the audit log a move to the status is recorded as.
+func transitionOperation(status domain.TodoStatus) domain.AuditOperation {
+	switch status {
+	case domain.TodoStatusCompleted:
+		return domain.AuditOperationComplete
+	case domain.TodoStatusPending:
+		return domain.AuditOperationPending
+	default:
+		return domain.AuditOperationTransition
+	}
+}
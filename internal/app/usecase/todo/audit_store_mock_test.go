@@ -0,0 +1,24 @@
+package todo_test
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type auditStoreMock struct {
+	mock.Mock
+}
+
+// newAuditStoreMock returns an audit store that records every change it is given.
+func newAuditStoreMock() *auditStoreMock {
+	m := new(auditStoreMock)
+	m.On("Record", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return m
+}
+
+func (m *auditStoreMock) Record(ctx context.Context, record domain.AuditRecord) error {
+	args := m.Called(ctx, record)
+	return args.Error(0)
+}
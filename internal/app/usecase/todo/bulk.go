@@ -0,0 +1,180 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+)
+
+// MaxBulkOperations is the largest number of operations accepted in a single bulk request.
+const MaxBulkOperations = 100
+
+// BulkAction is the kind of change made by an operation of a bulk request.
+type BulkAction string
+
+const (
+	BulkActionCreate   BulkAction = "create"
+	BulkActionUpdate   BulkAction = "update"
+	BulkActionComplete BulkAction = "complete"
+	BulkActionPending  BulkAction = "pending"
+	BulkActionDelete   BulkAction = "delete"
+)
+
+// IsValid checks if the action is one of the known actions.
+func (a BulkAction) IsValid() bool {
+	return slices.Contains([]BulkAction{
+		BulkActionCreate, BulkActionUpdate, BulkActionComplete, BulkActionPending, BulkActionDelete,
+	}, a)
+}
+
+type (
+	// BulkOperation is a single change of a bulk request. Only the input of its action is used.
+	BulkOperation struct {
+		Action        BulkAction
+		Create        CreateInput
+		Update        UpdateInput
+		Complete      CompleteInput
+		MarkAsPending MarkAsPendingInput
+		Delete        DeleteByIDInput
+	}
+	BulkInput struct {
+		Operations []BulkOperation
+		// Atomic runs every operation in a single transaction, rolled back on the first failure
+		Atomic bool
+	}
+	// BulkResult is the outcome of an operation. Todo is nil when the operation failed or deleted it.
+	BulkResult struct {
+		Action BulkAction
+		Todo   *TodoOutput
+		Err    error
+	}
+	BulkOutput struct {
+		Results []BulkResult
+	}
+	Bulk interface {
+		Handle(context.Context, BulkInput) (BulkOutput, error)
+	}
+	bulk struct {
+		create        Create
+		update        Update
+		complete      Complete
+		markAsPending MarkAsPending
+		deleteByID    DeleteByID
+		transactor    usecase.Transactor
+		events        usecase.EventPublisher
+	}
+)
+
+func NewBulk(
+	create Create,
+	update Update,
+	complete Complete,
+	markAsPending MarkAsPending,
+	deleteByID DeleteByID,
+	transactor usecase.Transactor,
+	events usecase.EventPublisher,
+) *bulk {
+	return &bulk{
+		create:        create,
+		update:        update,
+		complete:      complete,
+		markAsPending: markAsPending,
+		deleteByID:    deleteByID,
+		transactor:    transactor,
+		events:        events,
+	}
+}
+
+func (uc *bulk) Handle(ctx context.Context, input BulkInput) (BulkOutput, error) {
+	if err := validateBulk(input.Operations); err != nil {
+		return BulkOutput{}, err
+	}
+	results := make([]BulkResult, len(input.Operations))
+	if !input.Atomic {
+		for i, op := range input.Operations {
+			results[i] = uc.run(ctx, op)
+		}
+		return BulkOutput{Results: results}, nil
+	}
+
+	// The events of the operations are only published once all of them are committed
+	failed := -1
+	err := usecase.Publishing(ctx, uc.events, func(ctx context.Context) error {
+		return uc.transactor.Transaction(ctx, func(ctx context.Context) error {
+			for i, op := range input.Operations {
+				results[i] = uc.run(ctx, op)
+				if results[i].Err != nil {
+					failed = i
+					return results[i].Err
+				}
+			}
+			return nil
+		})
+	})
+	if failed >= 0 {
+		return BulkOutput{Results: abortedResults(input.Operations, results, failed)}, nil
+	}
+	if err != nil {
+		return BulkOutput{}, internalError("fail to commit the bulk operations", err)
+	}
+	return BulkOutput{Results: results}, nil
+}
+
+// run executes the operation with the usecase of its action.
+func (uc *bulk) run(ctx context.Context, op BulkOperation) BulkResult {
+	var output TodoOutput
+	var err error
+	switch op.Action {
+	case BulkActionCreate:
+		output, err = uc.create.Handle(ctx, op.Create)
+	case BulkActionUpdate:
+		output, err = uc.update.Handle(ctx, op.Update)
+	case BulkActionComplete:
+		output, err = uc.complete.Handle(ctx, op.Complete)
+	case BulkActionPending:
+		output, err = uc.markAsPending.Handle(ctx, op.MarkAsPending)
+	case BulkActionDelete:
+		return BulkResult{Action: op.Action, Err: uc.deleteByID.Handle(ctx, op.Delete)}
+	}
+	if err != nil {
+		return BulkResult{Action: op.Action, Err: err}
+	}
+	return BulkResult{Action: op.Action, Todo: &output}
+}
+
+func validateBulk(ops []BulkOperation) error {
+	if len(ops) == 0 {
+		return badRequestError("operations must not be empty", nil)
+	}
+	if len(ops) > MaxBulkOperations {
+		return badRequestError(fmt.Sprintf("too many operations: at most %d are accepted", MaxBulkOperations), nil)
+	}
+	for i, op := range ops {
+		if !op.Action.IsValid() {
+			return badRequestError(fmt.Sprintf(
+				"invalid op of operation %d: must be 'create', 'update', 'complete', 'pending' or 'delete'", i), nil)
+		}
+	}
+	return nil
+}
+
+// abortedResults reports the operations of a rolled back atomic request: the one that failed keeps
+// its error and every other one fails because of it, whether it was undone or never run.
+func abortedResults(ops []BulkOperation, results []BulkResult, failed int) []BulkResult {
+	aborted := make([]BulkResult, len(ops))
+	for i, op := range ops {
+		switch {
+		case i < failed:
+			aborted[i] = BulkResult{Action: op.Action, Err: usecase.NewError(
+				fmt.Sprintf("rolled back: operation %d failed", failed), nil, usecase.ErrorTypeFailedDependency)}
+		case i == failed:
+			aborted[i] = results[i]
+		default:
+			aborted[i] = BulkResult{Action: op.Action, Err: usecase.NewError(
+				fmt.Sprintf("not run: operation %d failed", failed), nil, usecase.ErrorTypeFailedDependency)}
+		}
+	}
+	return aborted
+}
@@ -0,0 +1,232 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+func TestBulk_Handle(t *testing.T) {
+	created := todo.TodoOutput{ID: "1", Title: "new", Status: "pending"}
+	completed := todo.TodoOutput{ID: "2", Title: "done", Status: "completed"}
+	notFound := usecase.NewError("todo not found with id 3", nil, usecase.ErrorTypeNotFound)
+	operations := []todo.BulkOperation{
+		{Action: todo.BulkActionCreate, Create: todo.CreateInput{Title: "new"}},
+		{Action: todo.BulkActionComplete, Complete: todo.CompleteInput{ID: "2"}},
+		{Action: todo.BulkActionDelete, Delete: todo.DeleteByIDInput{ID: "3"}},
+		{Action: todo.BulkActionPending, MarkAsPending: todo.MarkAsPendingInput{ID: "4"}},
+	}
+	testCases := []struct {
+		name       string
+		create     *createMock
+		complete   *completeMock
+		deleteByID *deleteByIDMock
+		transactor *transactorMock
+		input      todo.BulkInput
+		result     todo.BulkOutput
+		err        error
+	}{
+		{
+			name:       "should fail when there are no operations",
+			create:     new(createMock),
+			complete:   new(completeMock),
+			deleteByID: new(deleteByIDMock),
+			transactor: new(transactorMock),
+			input:      todo.BulkInput{},
+			result:     todo.BulkOutput{},
+			err:        usecase.NewError("operations must not be empty", nil, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:       "should fail when there are too many operations",
+			create:     new(createMock),
+			complete:   new(completeMock),
+			deleteByID: new(deleteByIDMock),
+			transactor: new(transactorMock),
+			input:      todo.BulkInput{Operations: make([]todo.BulkOperation, todo.MaxBulkOperations+1)},
+			result:     todo.BulkOutput{},
+			err: usecase.NewError("too many operations: at most 100 are accepted", nil,
+				usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:       "should fail when an operation has an unknown op",
+			create:     new(createMock),
+			complete:   new(completeMock),
+			deleteByID: new(deleteByIDMock),
+			transactor: new(transactorMock),
+			input: todo.BulkInput{Operations: []todo.BulkOperation{
+				{Action: todo.BulkActionCreate},
+				{Action: todo.BulkAction("archive")},
+			}},
+			result: todo.BulkOutput{},
+			err: usecase.NewError(
+				"invalid op of operation 1: must be 'create', 'update', 'complete', 'pending' or 'delete'",
+				nil, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should run every operation and report each result",
+			create: func() *createMock {
+				m := new(createMock)
+				m.On("Handle", context.TODO(), todo.CreateInput{Title: "new"}).Return(created, nil).Once()
+				return m
+			}(),
+			complete: func() *completeMock {
+				m := new(completeMock)
+				m.On("Handle", context.TODO(), todo.CompleteInput{ID: "2"}).Return(completed, nil).Once()
+				return m
+			}(),
+			deleteByID: func() *deleteByIDMock {
+				m := new(deleteByIDMock)
+				m.On("Handle", context.TODO(), todo.DeleteByIDInput{ID: "3"}).Return(notFound).Once()
+				return m
+			}(),
+			transactor: new(transactorMock),
+			input:      todo.BulkInput{Operations: operations[:3]},
+			result: todo.BulkOutput{Results: []todo.BulkResult{
+				{Action: todo.BulkActionCreate, Todo: &created},
+				{Action: todo.BulkActionComplete, Todo: &completed},
+				{Action: todo.BulkActionDelete, Err: notFound},
+			}},
+			err: nil,
+		},
+		{
+			name: "should roll back an atomic request on the first failure",
+			create: func() *createMock {
+				m := new(createMock)
+				m.On("Handle", mock.Anything, todo.CreateInput{Title: "new"}).Return(created, nil).Once()
+				return m
+			}(),
+			complete: func() *completeMock {
+				m := new(completeMock)
+				m.On("Handle", mock.Anything, todo.CompleteInput{ID: "2"}).Return(completed, nil).Once()
+				return m
+			}(),
+			deleteByID: func() *deleteByIDMock {
+				m := new(deleteByIDMock)
+				m.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "3"}).Return(notFound).Once()
+				return m
+			}(),
+			transactor: func() *transactorMock {
+				m := new(transactorMock)
+				m.On("Transaction", mock.Anything).Return(nil).Once()
+				return m
+			}(),
+			input: todo.BulkInput{Operations: operations, Atomic: true},
+			result: todo.BulkOutput{Results: []todo.BulkResult{
+				{Action: todo.BulkActionCreate, Err: usecase.NewError("rolled back: operation 2 failed", nil,
+					usecase.ErrorTypeFailedDependency)},
+				{Action: todo.BulkActionComplete, Err: usecase.NewError("rolled back: operation 2 failed", nil,
+					usecase.ErrorTypeFailedDependency)},
+				{Action: todo.BulkActionDelete, Err: notFound},
+				{Action: todo.BulkActionPending, Err: usecase.NewError("not run: operation 2 failed", nil,
+					usecase.ErrorTypeFailedDependency)},
+			}},
+			err: nil,
+		},
+		{
+			name: "should commit an atomic request when every operation succeeds",
+			create: func() *createMock {
+				m := new(createMock)
+				m.On("Handle", mock.Anything, todo.CreateInput{Title: "new"}).Return(created, nil).Once()
+				return m
+			}(),
+			complete: func() *completeMock {
+				m := new(completeMock)
+				m.On("Handle", mock.Anything, todo.CompleteInput{ID: "2"}).Return(completed, nil).Once()
+				return m
+			}(),
+			deleteByID: new(deleteByIDMock),
+			transactor: func() *transactorMock {
+				m := new(transactorMock)
+				m.On("Transaction", mock.Anything).Return(nil).Once()
+				return m
+			}(),
+			input: todo.BulkInput{Operations: operations[:2], Atomic: true},
+			result: todo.BulkOutput{Results: []todo.BulkResult{
+				{Action: todo.BulkActionCreate, Todo: &created},
+				{Action: todo.BulkActionComplete, Todo: &completed},
+			}},
+			err: nil,
+		},
+		{
+			name: "should fail when the atomic request cannot be committed",
+			create: func() *createMock {
+				m := new(createMock)
+				m.On("Handle", mock.Anything, todo.CreateInput{Title: "new"}).Return(created, nil).Once()
+				return m
+			}(),
+			complete:   new(completeMock),
+			deleteByID: new(deleteByIDMock),
+			transactor: func() *transactorMock {
+				m := new(transactorMock)
+				m.On("Transaction", mock.Anything).Return(assert.AnError).Once()
+				return m
+			}(),
+			input:  todo.BulkInput{Operations: operations[:1], Atomic: true},
+			result: todo.BulkOutput{},
+			err: usecase.NewError("fail to commit the bulk operations", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewBulk(tc.create, new(updateMock), tc.complete, new(markAsPendingMock), tc.deleteByID,
+				tc.transactor, newEventPublisherMock())
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.create.AssertExpectations(t)
+			tc.complete.AssertExpectations(t)
+			tc.deleteByID.AssertExpectations(t)
+			tc.transactor.AssertExpectations(t)
+		})
+	}
+}
+
+type createMock struct {
+	mock.Mock
+}
+
+func (m *createMock) Handle(ctx context.Context, input todo.CreateInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type updateMock struct {
+	mock.Mock
+}
+
+func (m *updateMock) Handle(ctx context.Context, input todo.UpdateInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type completeMock struct {
+	mock.Mock
+}
+
+func (m *completeMock) Handle(ctx context.Context, input todo.CompleteInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type markAsPendingMock struct {
+	mock.Mock
+}
+
+func (m *markAsPendingMock) Handle(ctx context.Context, input todo.MarkAsPendingInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type deleteByIDMock struct {
+	mock.Mock
+}
+
+func (m *deleteByIDMock) Handle(ctx context.Context, input todo.DeleteByIDInput) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
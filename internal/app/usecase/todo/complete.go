@@ -3,45 +3,34 @@ package todo
 import (
 	"context"
 
-	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
 type (
 	CompleteInput struct {
-		ID string
+		ID        string
+		OpenItems OpenItemsPolicy
+		// Versions, when given, are the only versions the todo may still have
+		Versions []int
 	}
-	CompleteStore = TodoUpdater
-	Complete      interface {
+	Complete interface {
 		Handle(context.Context, CompleteInput) (TodoOutput, error)
 	}
+	// complete is an alias of the transition to completed.
 	complete struct {
-		store CompleteStore
-		clock usecase.Clock
+		transition Transition
 	}
 )
 
-func NewComplete(store CompleteStore, clock usecase.Clock) *complete {
-	return &complete{
-		store: store,
-		clock: clock,
-	}
+func NewComplete(transition Transition) *complete {
+	return &complete{transition: transition}
 }
 
 func (uc *complete) Handle(ctx context.Context, input CompleteInput) (TodoOutput, error) {
-	todo, err := uc.store.GetByID(ctx, input.ID)
-	if err != nil {
-		if isNotFound(err) {
-			return TodoOutput{}, notFoundError(input.ID, err)
-		}
-		return TodoOutput{}, internalError("fail to get a todo by id", err)
-	}
-	todo = todo.MarkAsCompleted(uc.clock.Now())
-	todo, err = uc.store.Update(ctx, todo)
-	if err != nil {
-		if isNotFound(err) {
-			return TodoOutput{}, notFoundError(input.ID, err)
-		}
-		return TodoOutput{}, internalError("fail to update a todo in the store", err)
-	}
-	return TodoOutputFromDomain(todo), nil
+	return uc.transition.Handle(ctx, TransitionInput{
+		ID:        input.ID,
+		Status:    domain.TodoStatusCompleted,
+		OpenItems: input.OpenItems,
+		Versions:  input.Versions,
+	})
 }
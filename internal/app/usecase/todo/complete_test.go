@@ -3,7 +3,6 @@ package todo_test
 import (
 	"context"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -13,163 +12,59 @@ import (
 )
 
 func TestComplete_Handle(t *testing.T) {
-	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
-	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	version := 2
 	testCases := []struct {
-		name          string
-		completeStore *completeStoreMock
-		clock         *clockMock
-		ctx           context.Context
-		input         todo.CompleteInput
-		result        todo.TodoOutput
-		err           error
+		name       string
+		transition *transitionMock
+		input      todo.CompleteInput
+		result     todo.TodoOutput
+		err        error
 	}{
 		{
-			name: "should fail when todo not found",
-			completeStore: func() *completeStoreMock {
-				m := new(completeStoreMock)
-				m.On("GetByID", context.TODO(), "123").
-					Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+			name: "should fail when the transition fails",
+			transition: func() *transitionMock {
+				m := new(transitionMock)
+				m.On("Handle", context.TODO(), todo.TransitionInput{ID: "123", Status: domain.TodoStatusCompleted}).
+					Return(todo.TodoOutput{}, usecase.AnError).Once()
 				return m
 			}(),
-			clock: func() *clockMock {
-				m := newClockMock()
-				return m
-			}(),
-			ctx: context.TODO(),
-			input: todo.CompleteInput{
-				ID: "123",
-			},
-			result: todo.TodoOutput{},
-			err: usecase.NewError("todo not found with id 123",
-				domain.ErrTodoNotFound, usecase.ErrorTypeNotFound),
-		},
-		{
-			name: "should fail when repository fails",
-			completeStore: func() *completeStoreMock {
-				m := new(completeStoreMock)
-				m.On("GetByID", context.TODO(), "123").
-					Return(domain.Todo{}, assert.AnError).Once()
-				return m
-			}(),
-			clock: func() *clockMock {
-				m := newClockMock()
-				return m
-			}(),
-			ctx: context.TODO(),
-			input: todo.CompleteInput{
-				ID: "123",
-			},
-			result: todo.TodoOutput{},
-			err: usecase.NewError("fail to get a todo by id",
-				assert.AnError, usecase.ErrorTypeInternalError),
-		},
-		{
-			name: "should fail when update fails",
-			completeStore: func() *completeStoreMock {
-				m := new(completeStoreMock)
-				m.On("GetByID", context.TODO(), "123").
-					Return(domain.Todo{
-						ID:          "123",
-						Title:       "example title",
-						Description: "example description",
-						Status:      domain.TodoStatusPending,
-						CreatedAt:   exampleDate,
-						UpdatedAt:   exampleDate,
-					}, nil).Once()
-				m.On("Update", context.TODO(), domain.Todo{
-					ID:          "123",
-					Title:       "example title",
-					Description: "example description",
-					Status:      domain.TodoStatusCompleted,
-					CreatedAt:   exampleDate,
-					UpdatedAt:   exampleDateUpdated,
-				}).Return(domain.Todo{}, assert.AnError).Once()
-				return m
-			}(),
-			clock: func() *clockMock {
-				m := newClockMock()
-				m.On("Now").Return(exampleDateUpdated).Once()
-				return m
-			}(),
-			ctx: context.TODO(),
-			input: todo.CompleteInput{
-				ID: "123",
-			},
+			input:  todo.CompleteInput{ID: "123"},
 			result: todo.TodoOutput{},
-			err: usecase.NewError("fail to update a todo in the store", assert.AnError,
-				usecase.ErrorTypeInternalError),
+			err:    usecase.AnError,
 		},
 		{
-			name: "should complete a todo",
-			completeStore: func() *completeStoreMock {
-				m := new(completeStoreMock)
-				m.On("GetByID", context.TODO(), "123").
-					Return(domain.Todo{
-						ID:          "123",
-						Title:       "example title",
-						Description: "example description",
-						Status:      domain.TodoStatusPending,
-						CreatedAt:   exampleDate,
-						UpdatedAt:   exampleDate,
-					}, nil).Once()
-				m.On("Update", context.TODO(), domain.Todo{
-					ID:          "123",
-					Title:       "example title",
-					Description: "example description",
-					Status:      domain.TodoStatusCompleted,
-					CreatedAt:   exampleDate,
-					UpdatedAt:   exampleDateUpdated,
-				}).Return(domain.Todo{
-					ID:          "123",
-					Title:       "example title",
-					Description: "example description",
-					Status:      domain.TodoStatusCompleted,
-					CreatedAt:   exampleDate,
-					UpdatedAt:   exampleDateUpdated,
-				}, nil).Once()
-				return m
-			}(),
-			clock: func() *clockMock {
-				m := newClockMock()
-				m.On("Now").Return(exampleDateUpdated).Once()
+			name: "should complete a todo with a transition to completed",
+			transition: func() *transitionMock {
+				m := new(transitionMock)
+				m.On("Handle", context.TODO(), todo.TransitionInput{
+					ID:        "123",
+					Status:    domain.TodoStatusCompleted,
+					OpenItems: todo.OpenItemsCascade,
+					Versions:  []int{version},
+				}).Return(todo.TodoOutput{ID: "123", Status: "completed"}, nil).Once()
 				return m
 			}(),
-			ctx: context.TODO(),
-			input: todo.CompleteInput{
-				ID: "123",
-			},
-			result: todo.TodoOutput{
-				ID:          "123",
-				Title:       "example title",
-				Description: "example description",
-				Status:      "completed",
-				CreatedAt:   exampleDate,
-				UpdatedAt:   exampleDateUpdated,
-			},
-			err: nil,
+			input:  todo.CompleteInput{ID: "123", OpenItems: todo.OpenItemsCascade, Versions: []int{version}},
+			result: todo.TodoOutput{ID: "123", Status: "completed"},
+			err:    nil,
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			uc := todo.NewComplete(tc.completeStore, tc.clock)
-			result, err := uc.Handle(tc.ctx, tc.input)
+			uc := todo.NewComplete(tc.transition)
+			result, err := uc.Handle(context.TODO(), tc.input)
 			assert.Equal(t, tc.result, result)
 			assert.Equal(t, tc.err, err)
+			tc.transition.AssertExpectations(t)
 		})
 	}
 }
 
-type completeStoreMock struct {
+type transitionMock struct {
 	mock.Mock
 }
 
-func (m *completeStoreMock) GetByID(ctx context.Context, id string) (domain.Todo, error) {
-	args := m.Called(ctx, id)
-	return args.Get(0).(domain.Todo), args.Error(1)
-}
-
-func (m *completeStoreMock) Update(ctx context.Context, todo domain.Todo) (domain.Todo, error) {
-	args := m.Called(ctx, todo)
-	return args.Get(0).(domain.Todo), args.Error(1)
+func (m *transitionMock) Handle(ctx context.Context, input todo.TransitionInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
 }
@@ -12,6 +12,10 @@ type (
 	CreateInput struct {
 		Title       string
 		Description string
+		Priority    domain.TodoPriority
+		Tags        []string
+		Recurrence  string
+		ProjectID   *string
 		DueDate     *time.Time
 	}
 	CreateStore interface {
@@ -21,27 +25,46 @@ type (
 		Handle(context.Context, CreateInput) (TodoOutput, error)
 	}
 	create struct {
-		store CreateStore
-		clock usecase.Clock
+		store      CreateStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
 	}
 )
 
-func NewCreate(store CreateStore, clock usecase.Clock) *create {
+func NewCreate(
+	store CreateStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *create {
 	return &create{
-		store: store,
-		clock: clock,
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
 	}
 }
 
 func (uc *create) Handle(ctx context.Context, input CreateInput) (TodoOutput, error) {
 	todo, err := domain.NewTodo(input.Title, input.Description, uc.clock.Now(), input.DueDate)
-	if err != nil {
-		return TodoOutput{}, usecase.NewError(err.Error(), err, usecase.ErrorTypeBadRequest)
+	if err == nil {
+		todo, err = withAttributes(todo, input.Priority, input.Tags, input.Recurrence)
+		todo.ProjectID = input.ProjectID
 	}
-	todo, err = uc.store.Create(ctx, todo)
 	if err != nil {
-		return TodoOutput{}, usecase.NewError("fail to create a todo in the repository", err,
-			usecase.ErrorTypeInternalError)
+		return TodoOutput{}, usecase.NewError(err.Error(), err, usecase.ErrorTypeBadRequest)
 	}
-	return TodoOutputFromDomain(todo), nil
+	// The todo and its record in the audit log are created in a single unit of work
+	return inPublishedTransaction(ctx, uc.transactor, uc.events, func(ctx context.Context) (TodoOutput, error) {
+		todo, err := uc.store.Create(ctx, todo)
+		if err != nil {
+			return TodoOutput{}, usecase.NewError("fail to create a todo in the repository", err,
+				usecase.ErrorTypeInternalError)
+		}
+		if err := recordAudit(ctx, uc.audit, domain.AuditOperationCreate, nil, &todo, todo.CreatedAt); err != nil {
+			return TodoOutput{}, err
+		}
+		return TodoOutputFromDomain(todo), nil
+	})
 }
@@ -2,6 +2,7 @@ package todo_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -19,6 +20,7 @@ func TestCreate_Handle(t *testing.T) {
 		name        string
 		createStore *createStoreMock
 		clock       *clockMock
+		audit       *auditStoreMock
 		ctx         context.Context
 		input       todo.CreateInput
 		result      todo.TodoOutput
@@ -32,7 +34,8 @@ func TestCreate_Handle(t *testing.T) {
 				m.On("Now").Return(exampleDate).Once()
 				return m
 			}(),
-			ctx: context.TODO(),
+			audit: new(auditStoreMock),
+			ctx:   context.TODO(),
 			input: todo.CreateInput{
 				Title:       "",
 				Description: "example description",
@@ -41,14 +44,52 @@ func TestCreate_Handle(t *testing.T) {
 			err: usecase.NewError(fmt.Errorf("%w: title", domain.ErrTodoInvalidInput).Error(),
 				fmt.Errorf("%w: title", domain.ErrTodoInvalidInput), usecase.ErrorTypeBadRequest),
 		},
+		{
+			name:        "should fail when priority is invalid",
+			createStore: new(createStoreMock),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: new(auditStoreMock),
+			ctx:   context.TODO(),
+			input: todo.CreateInput{
+				Title:    "example title",
+				Priority: domain.TodoPriority("invalid"),
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError(fmt.Errorf("%w: priority", domain.ErrTodoInvalidInput).Error(),
+				fmt.Errorf("%w: priority", domain.ErrTodoInvalidInput), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:        "should fail when recurrence is invalid",
+			createStore: new(createStoreMock),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: new(auditStoreMock),
+			ctx:   context.TODO(),
+			input: todo.CreateInput{
+				Title:      "example title",
+				Recurrence: "FREQ=YEARLY",
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo invalid input: recurrence FREQ must be DAILY, WEEKLY or MONTHLY",
+				fmt.Errorf("%w: recurrence FREQ must be DAILY, WEEKLY or MONTHLY", domain.ErrTodoInvalidInput),
+				usecase.ErrorTypeBadRequest),
+		},
 		{
 			name: "should fail when repository fails",
 			createStore: func() *createStoreMock {
 				m := new(createStoreMock)
-				m.On("Create", context.TODO(), domain.Todo{
+				m.On("Create", mock.Anything, domain.Todo{
 					Title:       "example title",
 					Description: "example description",
 					Status:      domain.TodoStatusPending,
+					Priority:    domain.TodoPriorityNone,
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
 				}).Return(domain.Todo{}, assert.AnError).Once()
@@ -59,7 +100,8 @@ func TestCreate_Handle(t *testing.T) {
 				m.On("Now").Return(exampleDate).Once()
 				return m
 			}(),
-			ctx: context.TODO(),
+			audit: new(auditStoreMock),
+			ctx:   context.TODO(),
 			input: todo.CreateInput{
 				Title:       "example title",
 				Description: "example description",
@@ -68,14 +110,42 @@ func TestCreate_Handle(t *testing.T) {
 			err: usecase.NewError("fail to create a todo in the repository", assert.AnError,
 				usecase.ErrorTypeInternalError),
 		},
+		{
+			name: "should fail when the audit log fails",
+			createStore: func() *createStoreMock {
+				m := new(createStoreMock)
+				m.On("Create", mock.Anything, mock.Anything).Return(domain.Todo{ID: "123"}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.Anything).Return(assert.AnError).Once()
+				return m
+			}(),
+			ctx: context.TODO(),
+			input: todo.CreateInput{
+				Title: "example title",
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to record a todo change in the audit log", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
 		{
 			name: "should create a todo",
 			createStore: func() *createStoreMock {
 				m := new(createStoreMock)
-				m.On("Create", context.TODO(), domain.Todo{
+				m.On("Create", mock.Anything, domain.Todo{
 					Title:       "example title",
 					Description: "example description",
 					Status:      domain.TodoStatusPending,
+					Priority:    domain.TodoPriorityHigh,
+					Tags:        []string{"home", "work"},
+					Recurrence:  &domain.Recurrence{Frequency: domain.RecurrenceWeekly, Interval: 1, Count: 4},
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
 				}).Return(domain.Todo{
@@ -83,6 +153,9 @@ func TestCreate_Handle(t *testing.T) {
 					Title:       "example title",
 					Description: "example description",
 					Status:      domain.TodoStatusPending,
+					Priority:    domain.TodoPriorityHigh,
+					Tags:        []string{"home", "work"},
+					Recurrence:  &domain.Recurrence{Frequency: domain.RecurrenceWeekly, Interval: 1, Count: 4},
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
 				}, nil).Once()
@@ -93,16 +166,40 @@ func TestCreate_Handle(t *testing.T) {
 				m.On("Now").Return(exampleDate).Once()
 				return m
 			}(),
-			ctx: context.TODO(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, domain.AuditRecord{
+					TodoID:    "123",
+					Actor:     "alice",
+					Operation: domain.AuditOperationCreate,
+					Diff: json.RawMessage(`{"created_at":{"before":null,"after":"2024-01-01T00:00:00Z"},` +
+						`"description":{"before":null,"after":"example description"},` +
+						`"id":{"before":null,"after":"123"},"items":{"before":null,"after":[]},` +
+						`"priority":{"before":null,"after":"high"},` +
+						`"recurrence":{"before":null,"after":"FREQ=WEEKLY;COUNT=4"},` +
+						`"status":{"before":null,"after":"pending"},"tags":{"before":null,"after":["home","work"]},` +
+						`"title":{"before":null,"after":"example title"},` +
+						`"updated_at":{"before":null,"after":"2024-01-01T00:00:00Z"}}`),
+					CreatedAt: exampleDate,
+				}).Return(nil).Once()
+				return m
+			}(),
+			ctx: usecase.WithActor(context.TODO(), "alice"),
 			input: todo.CreateInput{
 				Title:       "example title",
 				Description: "example description",
+				Priority:    domain.TodoPriorityHigh,
+				Tags:        []string{"Work", "home"},
+				Recurrence:  "freq=weekly;count=4",
 			},
 			result: todo.TodoOutput{
 				ID:          "123",
 				Title:       "example title",
 				Description: "example description",
 				Status:      "pending",
+				Priority:    "high",
+				Tags:        []string{"home", "work"},
+				Recurrence:  "FREQ=WEEKLY;COUNT=4",
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
 			},
@@ -111,16 +208,57 @@ func TestCreate_Handle(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			uc := todo.NewCreate(tc.createStore, tc.clock)
+			uc := todo.NewCreate(tc.createStore, newTransactorMock(), tc.clock, tc.audit, newEventPublisherMock())
 			result, err := uc.Handle(tc.ctx, tc.input)
 			assert.Equal(t, tc.result, result)
 			assert.Equal(t, tc.err, err)
 			tc.createStore.AssertExpectations(t)
 			tc.clock.AssertExpectations(t)
+			tc.audit.AssertExpectations(t)
 		})
 	}
 }
 
+func TestCreate_Handle_Events(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	t.Run("should publish a created event once the todo is committed", func(t *testing.T) {
+		created := domain.Todo{ID: "123", Title: "example title", Status: domain.TodoStatusPending,
+			CreatedAt: exampleDate, UpdatedAt: exampleDate}
+		store := new(createStoreMock)
+		store.On("Create", mock.Anything, mock.Anything).Return(created, nil).Once()
+		clock := newClockMock()
+		clock.On("Now").Return(exampleDate).Once()
+		ctx := usecase.WithActor(context.TODO(), "alice")
+		publisher := new(eventPublisherMock)
+		publisher.On("Publish", ctx, domain.Event{
+			Type:       domain.EventTodoCreated,
+			TodoID:     "123",
+			Todo:       created,
+			Actor:      "alice",
+			OccurredAt: exampleDate,
+		}).Return().Once()
+		uc := todo.NewCreate(store, newTransactorMock(), clock, newAuditStoreMock(), publisher)
+		_, err := uc.Handle(ctx, todo.CreateInput{Title: "example title"})
+		assert.Nil(t, err)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("should publish nothing when the todo is not committed", func(t *testing.T) {
+		store := new(createStoreMock)
+		store.On("Create", mock.Anything, mock.Anything).Return(domain.Todo{ID: "123"}, nil).Once()
+		clock := newClockMock()
+		clock.On("Now").Return(exampleDate).Once()
+		transactor := new(transactorMock)
+		transactor.On("Transaction", mock.Anything).Return(assert.AnError).Once()
+		publisher := new(eventPublisherMock)
+		uc := todo.NewCreate(store, transactor, clock, newAuditStoreMock(), publisher)
+		_, err := uc.Handle(context.TODO(), todo.CreateInput{Title: "example title"})
+		assert.Equal(t, usecase.NewError("fail to commit a todo change", assert.AnError,
+			usecase.ErrorTypeInternalError), err)
+		publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+}
+
 type createStoreMock struct {
 	mock.Mock
 }
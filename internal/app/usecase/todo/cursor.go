@@ -0,0 +1,72 @@
+package todo
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+var (
+	errInvalidCursor  = errors.New("invalid cursor")
+	errCursorMismatch = errors.New("cursor does not match the requested sort")
+)
+
+// ListCursor points at the last todo of a page; the next page starts right after it.
+// It holds every sortable key of that todo so the store can resume any ordering.
+type ListCursor struct {
+	ID        string              `json:"i"`
+	Title     string              `json:"t,omitempty"`
+	Priority  domain.TodoPriority `json:"p,omitempty"`
+	DueDate   *time.Time          `json:"d,omitempty"`
+	CreatedAt time.Time           `json:"c"`
+	UpdatedAt time.Time           `json:"u"`
+}
+
+// cursorToken is the serialized form of a cursor, bound to the sort it was produced for.
+type cursorToken struct {
+	Field     ListSortField `json:"f"`
+	Direction SortDirection `json:"o"`
+	Cursor    ListCursor    `json:"k"`
+}
+
+// cursorFromDomain builds the cursor that points at the given todo.
+func cursorFromDomain(todo domain.Todo) ListCursor {
+	return ListCursor{
+		ID:        todo.ID,
+		Title:     todo.Title,
+		Priority:  todo.Priority,
+		DueDate:   todo.DueDate,
+		CreatedAt: todo.CreatedAt,
+		UpdatedAt: todo.UpdatedAt,
+	}
+}
+
+// encodeCursor serializes the cursor into an opaque URL-safe token.
+func encodeCursor(cursor ListCursor, sort ListSort) string {
+	data, _ := json.Marshal(cursorToken{
+		Field:     sort.Field,
+		Direction: sort.Direction,
+		Cursor:    cursor,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor parses a token produced by encodeCursor and checks it was
+// produced for the given sort.
+func decodeCursor(token string, sort ListSort) (ListCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ListCursor{}, errInvalidCursor
+	}
+	var decoded cursorToken
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Cursor.ID == "" {
+		return ListCursor{}, errInvalidCursor
+	}
+	if decoded.Field != sort.Field || decoded.Direction != sort.Direction {
+		return ListCursor{}, errCursorMismatch
+	}
+	return decoded.Cursor, nil
+}
@@ -2,31 +2,79 @@ package todo
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
 type (
+	DeleteByIDInput struct {
+		ID string
+		// Versions, when given, are the only versions the todo may still have
+		Versions []int
+		// Permanent deletes the todo for good, even from the trash, instead of moving it there
+		Permanent bool
+	}
+	// DeleteByIDStore deletes todos, returning them as they were before. When versions are given
+	// the todo is only deleted at one of them, domain.ErrTodoVersionConflict being returned otherwise.
 	DeleteByIDStore interface {
-		DeleteByID(context.Context, string) error
+		// Trash moves a todo to the trash, as deleted at date
+		Trash(ctx context.Context, id string, versions []int, date time.Time) (domain.Todo, error)
+		// DeleteByID removes a todo, whether it is in the trash or not
+		DeleteByID(ctx context.Context, id string, versions []int) (domain.Todo, error)
 	}
 	DeleteByID interface {
-		Handle(ctx context.Context, id string) error
+		Handle(context.Context, DeleteByIDInput) error
 	}
 	deleteByID struct {
-		store DeleteByIDStore
+		store      DeleteByIDStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
 	}
 )
 
-func NewDeleteByID(store DeleteByIDStore) *deleteByID {
-	return &deleteByID{store}
+func NewDeleteByID(
+	store DeleteByIDStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *deleteByID {
+	return &deleteByID{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+	}
 }
 
-func (uc *deleteByID) Handle(ctx context.Context, id string) error {
-	err := uc.store.DeleteByID(ctx, id)
-	if err != nil {
-		if isNotFound(err) {
-			return notFoundError(id, err)
+// Handle deletes the todo and records it in the audit log in a single unit of work.
+func (uc *deleteByID) Handle(ctx context.Context, input DeleteByIDInput) error {
+	now := uc.clock.Now()
+	_, err := inPublishedTransaction(ctx, uc.transactor, uc.events, func(ctx context.Context) (TodoOutput, error) {
+		var todo domain.Todo
+		var err error
+		if input.Permanent {
+			todo, err = uc.store.DeleteByID(ctx, input.ID, input.Versions)
+		} else {
+			todo, err = uc.store.Trash(ctx, input.ID, input.Versions, now)
+		}
+		if err != nil {
+			return TodoOutput{}, deleteError(input, err)
 		}
-		return internalError("fail to delete a todo by id", err)
+		return TodoOutput{}, recordAudit(ctx, uc.audit, domain.AuditOperationDelete, &todo, nil, now)
+	})
+	return err
+}
+
+func deleteError(input DeleteByIDInput, err error) error {
+	if isNotFound(err) {
+		return notFoundError(input.ID, err)
+	}
+	if isVersionConflict(err) {
+		return preconditionFailedError(fmt.Sprintf("todo has changed: expected version %s", formatVersions(input.Versions)), err)
 	}
-	return nil
+	return internalError("fail to delete a todo by id", err)
 }
@@ -2,63 +2,238 @@ package todo_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
 func TestDeleteByID_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	version := 2
 	testCases := []struct {
 		name  string
 		store *deleteByIDStoreMock
+		clock *clockMock
+		audit *auditStoreMock
 		ctx   context.Context
-		id    string
+		input todo.DeleteByIDInput
 		err   error
 	}{
 		{
 			name: "should fail when store fails",
 			store: func() *deleteByIDStoreMock {
 				m := new(deleteByIDStoreMock)
-				m.On("DeleteByID", context.TODO(), "123").
-					Return(assert.AnError).Once()
+				m.On("Trash", mock.Anything, "123", ([]int)(nil), exampleDate).
+					Return(domain.Todo{}, assert.AnError).Once()
 				return m
 			}(),
-			ctx: context.TODO(),
-			id:  "123",
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: new(auditStoreMock),
+			ctx:   context.TODO(),
+			input: todo.DeleteByIDInput{ID: "123"},
 			err: usecase.NewError("fail to delete a todo by id", assert.AnError,
 				usecase.ErrorTypeInternalError),
 		},
 		{
-			name: "should delete trade by id",
+			name: "should fail when todo is not found",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("Trash", mock.Anything, "123", ([]int)(nil), exampleDate).
+					Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: new(auditStoreMock),
+			ctx:   context.TODO(),
+			input: todo.DeleteByIDInput{ID: "123"},
+			err: usecase.NewError("todo not found with id 123", domain.ErrTodoNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when todo is at another version",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("Trash", mock.Anything, "123", []int{version}, exampleDate).
+					Return(domain.Todo{}, domain.ErrTodoVersionConflict).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: new(auditStoreMock),
+			ctx:   context.TODO(),
+			input: todo.DeleteByIDInput{ID: "123", Versions: []int{version}},
+			err: usecase.NewError("todo has changed: expected version 2", domain.ErrTodoVersionConflict,
+				usecase.ErrorTypePreconditionFailed),
+		},
+		{
+			name: "should fail when the audit log fails",
 			store: func() *deleteByIDStoreMock {
 				m := new(deleteByIDStoreMock)
-				m.On("DeleteByID", context.TODO(), "123").
-					Return(nil).Once()
+				m.On("Trash", mock.Anything, "123", ([]int)(nil), exampleDate).
+					Return(domain.Todo{ID: "123"}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.Anything).Return(assert.AnError).Once()
 				return m
 			}(),
-			ctx: context.TODO(),
-			id:  "123",
-			err: nil,
+			ctx:   context.TODO(),
+			input: todo.DeleteByIDInput{ID: "123"},
+			err: usecase.NewError("fail to record a todo change in the audit log", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should move the todo to the trash",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("Trash", mock.Anything, "123", []int{version}, exampleDate).
+					Return(domain.Todo{ID: "123", Title: "example title", Status: domain.TodoStatusPending}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, domain.AuditRecord{
+					TodoID:    "123",
+					Actor:     "alice",
+					Operation: domain.AuditOperationDelete,
+					Diff: json.RawMessage(`{"created_at":{"before":"0001-01-01T00:00:00Z","after":null},` +
+						`"description":{"before":"","after":null},"id":{"before":"123","after":null},` +
+						`"items":{"before":[],"after":null},"priority":{"before":"","after":null},` +
+						`"status":{"before":"pending","after":null},"tags":{"before":[],"after":null},` +
+						`"title":{"before":"example title","after":null},` +
+						`"updated_at":{"before":"0001-01-01T00:00:00Z","after":null}}`),
+					CreatedAt: exampleDate,
+				}).Return(nil).Once()
+				return m
+			}(),
+			ctx:   usecase.WithActor(context.TODO(), "alice"),
+			input: todo.DeleteByIDInput{ID: "123", Versions: []int{version}},
+			err:   nil,
+		},
+		{
+			name: "should fail when the permanent delete fails",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("DeleteByID", mock.Anything, "123", ([]int)(nil)).
+					Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: new(auditStoreMock),
+			ctx:   context.TODO(),
+			input: todo.DeleteByIDInput{ID: "123", Permanent: true},
+			err: usecase.NewError("todo not found with id 123", domain.ErrTodoNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should delete the todo for good",
+			store: func() *deleteByIDStoreMock {
+				m := new(deleteByIDStoreMock)
+				m.On("DeleteByID", mock.Anything, "123", []int{version}).
+					Return(domain.Todo{ID: "123", Title: "example title", Status: domain.TodoStatusPending}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, domain.AuditRecord{
+					TodoID:    "123",
+					Actor:     "anonymous",
+					Operation: domain.AuditOperationDelete,
+					Diff: json.RawMessage(`{"created_at":{"before":"0001-01-01T00:00:00Z","after":null},` +
+						`"description":{"before":"","after":null},"id":{"before":"123","after":null},` +
+						`"items":{"before":[],"after":null},"priority":{"before":"","after":null},` +
+						`"status":{"before":"pending","after":null},"tags":{"before":[],"after":null},` +
+						`"title":{"before":"example title","after":null},` +
+						`"updated_at":{"before":"0001-01-01T00:00:00Z","after":null}}`),
+					CreatedAt: exampleDate,
+				}).Return(nil).Once()
+				return m
+			}(),
+			ctx:   context.TODO(),
+			input: todo.DeleteByIDInput{ID: "123", Versions: []int{version}, Permanent: true},
+			err:   nil,
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			uc := todo.NewDeleteByID(tc.store)
-			err := uc.Handle(tc.ctx, tc.id)
+			uc := todo.NewDeleteByID(tc.store, newTransactorMock(), tc.clock, tc.audit, newEventPublisherMock())
+			err := uc.Handle(tc.ctx, tc.input)
 			assert.Equal(t, tc.err, err)
 			tc.store.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+			tc.audit.AssertExpectations(t)
 		})
 	}
 }
 
+func TestDeleteByID_Handle_Events(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	deleted := domain.Todo{ID: "123", Title: "example title", Status: domain.TodoStatusPending}
+	store := new(deleteByIDStoreMock)
+	store.On("Trash", mock.Anything, "123", ([]int)(nil), exampleDate).Return(deleted, nil).Once()
+	clock := newClockMock()
+	clock.On("Now").Return(exampleDate).Once()
+	publisher := new(eventPublisherMock)
+	publisher.On("Publish", context.TODO(), domain.Event{
+		Type:       domain.EventTodoDeleted,
+		TodoID:     "123",
+		Todo:       deleted,
+		Actor:      usecase.AnonymousActor,
+		OccurredAt: exampleDate,
+	}).Return().Once()
+	uc := todo.NewDeleteByID(store, newTransactorMock(), clock, newAuditStoreMock(), publisher)
+	err := uc.Handle(context.TODO(), todo.DeleteByIDInput{ID: "123"})
+	assert.Nil(t, err)
+	publisher.AssertExpectations(t)
+}
+
 type deleteByIDStoreMock struct {
 	mock.Mock
 }
 
-func (m *deleteByIDStoreMock) DeleteByID(ctx context.Context, id string) error {
-	args := m.Called(ctx, id)
-	return args.Error(0)
+func (m *deleteByIDStoreMock) Trash(ctx context.Context, id string, versions []int, date time.Time) (domain.Todo, error) {
+	args := m.Called(ctx, id, versions, date)
+	return args.Get(0).(domain.Todo), args.Error(1)
+}
+
+func (m *deleteByIDStoreMock) DeleteByID(ctx context.Context, id string, versions []int) (domain.Todo, error) {
+	args := m.Called(ctx, id, versions)
+	return args.Get(0).(domain.Todo), args.Error(1)
 }
@@ -16,6 +16,30 @@ func notFoundError(id string, cause error) error {
 	)
 }
 
+func itemNotFoundError(id string, cause error) error {
+	return usecase.NewError(
+		fmt.Sprintf("checklist item not found with id %s", id),
+		cause,
+		usecase.ErrorTypeNotFound,
+	)
+}
+
+func projectNotFoundError(id string, cause error) error {
+	return usecase.NewError(
+		fmt.Sprintf("project not found with id %s", id),
+		cause,
+		usecase.ErrorTypeNotFound,
+	)
+}
+
+func conflictError(msg string, cause error) error {
+	return usecase.NewError(msg, cause, usecase.ErrorTypeConflict)
+}
+
+func preconditionFailedError(msg string, cause error) error {
+	return usecase.NewError(msg, cause, usecase.ErrorTypePreconditionFailed)
+}
+
 func internalError(msg string, cause error) error {
 	return usecase.NewError(msg, cause, usecase.ErrorTypeInternalError)
 }
@@ -27,3 +51,14 @@ func badRequestError(msg string, cause error) error {
 func isNotFound(err error) bool {
 	return errors.Is(err, domain.ErrTodoNotFound)
 }
+
+func isVersionConflict(err error) bool {
+	return errors.Is(err, domain.ErrTodoVersionConflict)
+}
+
+// isChangedSince tells whether a change made at a version failed because the todo is no longer
+// there or at that version.
+func isChangedSince(err error) bool {
+	errUC, ok := err.(usecase.Error)
+	return ok && (errUC.Type == usecase.ErrorTypeNotFound || errUC.Type == usecase.ErrorTypePreconditionFailed)
+}
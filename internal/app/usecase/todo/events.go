@@ -0,0 +1,58 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// inPublishedTransaction is inTransaction publishing the domain events raised by work once it is committed.
+func inPublishedTransaction(
+	ctx context.Context,
+	transactor usecase.Transactor,
+	publisher usecase.EventPublisher,
+	work func(context.Context) (TodoOutput, error),
+) (TodoOutput, error) {
+	var output TodoOutput
+	err := usecase.Publishing(ctx, publisher, func(ctx context.Context) error {
+		var err error
+		output, err = inTransaction(ctx, transactor, work)
+		return err
+	})
+	return output, err
+}
+
+// raiseEvent raises the domain event of the change of a todo from before to after, made at date by
+// the actor of ctx. before is nil for a created todo and after is nil for a deleted one.
+func raiseEvent(ctx context.Context, operation domain.AuditOperation, before, after *domain.Todo, date time.Time) {
+	todo := after
+	if todo == nil {
+		todo = before
+	}
+	usecase.RaiseEvent(ctx, domain.Event{
+		Type:       eventType(operation, before, after),
+		TodoID:     todo.ID,
+		Todo:       *todo,
+		Actor:      usecase.ActorFromContext(ctx),
+		OccurredAt: date,
+	})
+}
+
+// eventType is the type of the domain event of a change of a todo recorded as operation.
+func eventType(operation domain.AuditOperation, before, after *domain.Todo) domain.EventType {
+	switch {
+	case before == nil:
+		return domain.EventTodoCreated
+	case after == nil:
+		return domain.EventTodoDeleted
+	case after.Status == domain.TodoStatusCompleted &&
+		(operation == domain.AuditOperationComplete || before.Status != domain.TodoStatusCompleted):
+		return domain.EventTodoCompleted
+	case before.Status == domain.TodoStatusCompleted && after.Status != domain.TodoStatusCompleted:
+		return domain.EventTodoReopened
+	default:
+		return domain.EventTodoUpdated
+	}
+}
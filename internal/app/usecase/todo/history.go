@@ -0,0 +1,55 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	// StatusChangeOutput is a change of status of a todo. From is empty for the status
+	// the todo was created with.
+	StatusChangeOutput struct {
+		From      string
+		To        string
+		ChangedAt time.Time
+	}
+	HistoryStore interface {
+		GetByIDStore
+		// StatusHistory returns the status changes of the todo, the oldest first
+		StatusHistory(ctx context.Context, todoID string) ([]domain.StatusChange, error)
+	}
+	History interface {
+		Handle(ctx context.Context, id string) ([]StatusChangeOutput, error)
+	}
+	history struct {
+		store HistoryStore
+	}
+)
+
+func NewHistory(store HistoryStore) *history {
+	return &history{store}
+}
+
+func (uc *history) Handle(ctx context.Context, id string) ([]StatusChangeOutput, error) {
+	if _, err := uc.store.GetByID(ctx, id); err != nil {
+		if isNotFound(err) {
+			return []StatusChangeOutput{}, notFoundError(id, err)
+		}
+		return []StatusChangeOutput{}, internalError("fail to get a todo by id", err)
+	}
+	changes, err := uc.store.StatusHistory(ctx, id)
+	if err != nil {
+		return []StatusChangeOutput{}, internalError("fail to get the status history of a todo", err)
+	}
+	outputs := make([]StatusChangeOutput, len(changes))
+	for i, change := range changes {
+		outputs[i] = StatusChangeOutput{
+			From:      string(change.From),
+			To:        string(change.To),
+			ChangedAt: change.ChangedAt,
+		}
+	}
+	return outputs, nil
+}
@@ -0,0 +1,102 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestHistory_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	testCases := []struct {
+		name   string
+		store  *historyStoreMock
+		result []todo.StatusChangeOutput
+		err    error
+	}{
+		{
+			name: "should fail when todo not found",
+			store: func() *historyStoreMock {
+				m := new(historyStoreMock)
+				m.On("GetByID", context.TODO(), "123").
+					Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			result: []todo.StatusChangeOutput{},
+			err: usecase.NewError("todo not found with id 123",
+				domain.ErrTodoNotFound, usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when the todo cannot be loaded",
+			store: func() *historyStoreMock {
+				m := new(historyStoreMock)
+				m.On("GetByID", context.TODO(), "123").
+					Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			result: []todo.StatusChangeOutput{},
+			err: usecase.NewError("fail to get a todo by id",
+				assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should fail when the history cannot be loaded",
+			store: func() *historyStoreMock {
+				m := new(historyStoreMock)
+				m.On("GetByID", context.TODO(), "123").Return(domain.Todo{ID: "123"}, nil).Once()
+				m.On("StatusHistory", context.TODO(), "123").
+					Return([]domain.StatusChange{}, assert.AnError).Once()
+				return m
+			}(),
+			result: []todo.StatusChangeOutput{},
+			err: usecase.NewError("fail to get the status history of a todo",
+				assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should return the status changes of the todo",
+			store: func() *historyStoreMock {
+				m := new(historyStoreMock)
+				m.On("GetByID", context.TODO(), "123").Return(domain.Todo{ID: "123"}, nil).Once()
+				m.On("StatusHistory", context.TODO(), "123").Return([]domain.StatusChange{
+					{TodoID: "123", To: domain.TodoStatusPending, ChangedAt: exampleDate},
+					{TodoID: "123", From: domain.TodoStatusPending, To: domain.TodoStatusCompleted, ChangedAt: exampleDateUpdated},
+				}, nil).Once()
+				return m
+			}(),
+			result: []todo.StatusChangeOutput{
+				{To: "pending", ChangedAt: exampleDate},
+				{From: "pending", To: "completed", ChangedAt: exampleDateUpdated},
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewHistory(tc.store)
+			result, err := uc.Handle(context.TODO(), "123")
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
+
+type historyStoreMock struct {
+	mock.Mock
+}
+
+func (m *historyStoreMock) GetByID(ctx context.Context, id string) (domain.Todo, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Todo), args.Error(1)
+}
+
+func (m *historyStoreMock) StatusHistory(ctx context.Context, todoID string) ([]domain.StatusChange, error) {
+	args := m.Called(ctx, todoID)
+	return args.Get(0).([]domain.StatusChange), args.Error(1)
+}
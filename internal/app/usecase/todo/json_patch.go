@@ -0,0 +1,203 @@
+package todo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/pkg/jsonpatch"
+)
+
+// todoDocument is the JSON document the paths of a JSON Patch point into,
+// the same shape as the todo returned by the HTTP API.
+type todoDocument struct {
+	ID          string                  `json:"id"`
+	Title       string                  `json:"title"`
+	Description string                  `json:"description"`
+	Status      string                  `json:"status"`
+	Priority    string                  `json:"priority"`
+	Tags        []string                `json:"tags"`
+	Items       []checklistItemDocument `json:"items"`
+	Recurrence  string                  `json:"recurrence,omitempty"`
+	ProjectID   *string                 `json:"project_id,omitempty"`
+	DueDate     *time.Time              `json:"due_date,omitempty"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+	Version     int                     `json:"version,omitempty"`
+}
+
+type checklistItemDocument struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Done  bool   `json:"done"`
+}
+
+// readOnlyFields are the document fields a JSON Patch may test but not change.
+var readOnlyFields = []string{"id", "items", "project_id", "created_at", "updated_at", "version"}
+
+type (
+	// JSONPatchInput applies a JSON Patch (RFC 6902) to the todo document.
+	JSONPatchInput struct {
+		ID         string
+		Operations []jsonpatch.Operation
+		// Versions, when given, are the only versions the todo may still have
+		Versions []int
+	}
+	JSONPatchStore = TodoUpdater
+	JSONPatch      interface {
+		Handle(context.Context, JSONPatchInput) (TodoOutput, error)
+	}
+	jsonPatch struct {
+		store      JSONPatchStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		workflow   domain.Workflow
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewJSONPatch(
+	store JSONPatchStore, transactor usecase.Transactor, clock usecase.Clock, workflow domain.Workflow,
+	audit AuditStore, events usecase.EventPublisher,
+) *jsonPatch {
+	return &jsonPatch{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		workflow:   workflow,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+// Handle applies the patch and saves the todo with a single update. The title, description,
+// status, priority, tags, recurrence and due date can be changed, and are validated as in
+// Update; the other fields are read only. The status must be a transition the workflow allows,
+// and changing it does not apply the open items policy nor create the next occurrence of a
+// recurring todo, as the transition endpoint does.
+func (uc *jsonPatch) Handle(ctx context.Context, input JSONPatchInput) (TodoOutput, error) {
+	return changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events, domain.AuditOperationUpdate,
+		input.ID, input.Versions,
+		func(todo domain.Todo) (domain.Todo, error) {
+			patched, err := applyJSONPatch(todo, input.Operations, uc.clock.Now(), uc.workflow)
+			if err != nil {
+				if errors.Is(err, jsonpatch.ErrTestFailed) || errors.Is(err, domain.ErrTodoInvalidTransition) {
+					return domain.Todo{}, conflictError(err.Error(), err)
+				}
+				return domain.Todo{}, badRequestError(err.Error(), err)
+			}
+			return patched, nil
+		})
+}
+
+func applyJSONPatch(
+	todo domain.Todo, operations []jsonpatch.Operation, now time.Time, workflow domain.Workflow,
+) (domain.Todo, error) {
+	original, err := json.Marshal(todoDocumentFromDomain(todo))
+	if err != nil {
+		return domain.Todo{}, err
+	}
+	result, err := jsonpatch.Apply(original, operations)
+	if err != nil {
+		return domain.Todo{}, err
+	}
+	if err := checkReadOnlyFields(original, result); err != nil {
+		return domain.Todo{}, err
+	}
+	var doc todoDocument
+	if err := json.Unmarshal(result, &doc); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return domain.Todo{}, fmt.Errorf("%w: \"/%s\" must be a %s",
+				jsonpatch.ErrInvalidOperation, typeErr.Field, typeErr.Type)
+		}
+		return domain.Todo{}, fmt.Errorf("%w: the patched todo is not valid", jsonpatch.ErrInvalidOperation)
+	}
+
+	// A due date left as it was is not validated again, as it may have passed since it was set
+	dueDate := todo.DueDate
+	dueDateChanged := !equalTimes(doc.DueDate, todo.DueDate)
+	newDueDate := doc.DueDate
+	if !dueDateChanged {
+		newDueDate = nil
+	}
+	patched, err := todo.Update(doc.Title, doc.Description, now, newDueDate)
+	if err != nil {
+		return domain.Todo{}, err
+	}
+	if !dueDateChanged {
+		patched.DueDate = dueDate
+	}
+	patched, err = withAttributes(patched, domain.TodoPriority(doc.Priority), doc.Tags, doc.Recurrence)
+	if err != nil {
+		return domain.Todo{}, err
+	}
+	return patched.Transition(workflow, domain.TodoStatus(doc.Status), now)
+}
+
+// checkReadOnlyFields fails when the patch changed a read only field or added an unknown one.
+func checkReadOnlyFields(original, result []byte) error {
+	var before, after map[string]any
+	if err := json.Unmarshal(original, &before); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(result, &after); err != nil {
+		return fmt.Errorf("%w: the patched todo must be an object", jsonpatch.ErrInvalidOperation)
+	}
+	known := map[string]bool{}
+	for _, field := range reflect.VisibleFields(reflect.TypeFor[todoDocument]()) {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		known[name] = true
+	}
+	for name := range after {
+		if !known[name] {
+			return fmt.Errorf("%w: \"/%s\" is not a todo field", jsonpatch.ErrInvalidOperation, name)
+		}
+	}
+	for _, name := range readOnlyFields {
+		if !reflect.DeepEqual(before[name], after[name]) {
+			return fmt.Errorf("%w: \"/%s\" is read only", jsonpatch.ErrInvalidOperation, name)
+		}
+	}
+	return nil
+}
+
+func todoDocumentFromDomain(todo domain.Todo) todoDocument {
+	tags := todo.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	items := make([]checklistItemDocument, 0, len(todo.Items))
+	for _, item := range todo.Items {
+		items = append(items, checklistItemDocument{ID: item.ID, Title: item.Title, Done: item.Done})
+	}
+	return todoDocument{
+		ID:          todo.ID,
+		Title:       todo.Title,
+		Description: todo.Description,
+		Status:      string(todo.Status),
+		Priority:    string(todo.Priority),
+		Tags:        tags,
+		Items:       items,
+		Recurrence:  recurrenceOutputFromDomain(todo.Recurrence),
+		ProjectID:   todo.ProjectID,
+		DueDate:     todo.DueDate,
+		CreatedAt:   todo.CreatedAt,
+		UpdatedAt:   todo.UpdatedAt,
+		Version:     todo.Version,
+	}
+}
+
+func equalTimes(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
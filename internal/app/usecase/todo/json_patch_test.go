@@ -0,0 +1,152 @@
+package todo_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/pkg/jsonpatch"
+)
+
+func TestJSONPatch_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-10")
+	pastDueDate, _ := time.Parse(time.DateOnly, "2024-01-05")
+	exampleTodo := domain.Todo{
+		ID:        "123",
+		Title:     "water the plants",
+		Status:    domain.TodoStatusPending,
+		Priority:  domain.TodoPriorityNone,
+		Tags:      []string{"home"},
+		Items:     []domain.ChecklistItem{{ID: "1", Title: "balcony"}},
+		DueDate:   &pastDueDate,
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDate,
+		Version:   2,
+	}
+	operations := func(patch string) []jsonpatch.Operation {
+		var ops []jsonpatch.Operation
+		if err := json.Unmarshal([]byte(patch), &ops); err != nil {
+			t.Fatal(err)
+		}
+		return ops
+	}
+	getOnly := func() *todoUpdaterMock {
+		m := new(todoUpdaterMock)
+		m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+		return m
+	}
+	testCases := []struct {
+		name   string
+		store  *todoUpdaterMock
+		patch  string
+		result todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should complete the todo when it is still pending",
+			store: func() *todoUpdaterMock {
+				result := exampleTodo
+				result.Status = domain.TodoStatusCompleted
+				result.Tags = []string{"home", "work"}
+				result.UpdatedAt = exampleDateUpdated
+				result.CompletedAt = &exampleDateUpdated
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				m.On("Update", mock.Anything, result).Return(result, nil).Once()
+				return m
+			}(),
+			patch: `[{"op":"test","path":"/status","value":"pending"},{"op":"replace","path":"/status","value":"completed"},{"op":"add","path":"/tags/-","value":"Work"}]`,
+			result: func() todo.TodoOutput {
+				result := exampleTodo
+				result.Status = domain.TodoStatusCompleted
+				result.Tags = []string{"home", "work"}
+				result.UpdatedAt = exampleDateUpdated
+				result.CompletedAt = &exampleDateUpdated
+				return todo.TodoOutputFromDomain(result)
+			}(),
+			err: nil,
+		},
+		{
+			name: "should remove the due date and add a recurrence",
+			store: func() *todoUpdaterMock {
+				result := exampleTodo
+				result.DueDate = nil
+				result.Recurrence = &domain.Recurrence{Frequency: domain.RecurrenceDaily, Interval: 1}
+				result.UpdatedAt = exampleDateUpdated
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				m.On("Update", mock.Anything, result).Return(result, nil).Once()
+				return m
+			}(),
+			patch: `[{"op":"remove","path":"/due_date"},{"op":"add","path":"/recurrence","value":"FREQ=DAILY"}]`,
+			result: func() todo.TodoOutput {
+				result := exampleTodo
+				result.DueDate = nil
+				result.Recurrence = &domain.Recurrence{Frequency: domain.RecurrenceDaily, Interval: 1}
+				result.UpdatedAt = exampleDateUpdated
+				return todo.TodoOutputFromDomain(result)
+			}(),
+			err: nil,
+		},
+		{
+			name:   "should fail with a conflict when a test does not hold",
+			store:  getOnly(),
+			patch:  `[{"op":"test","path":"/status","value":"completed"},{"op":"replace","path":"/status","value":"pending"}]`,
+			result: todo.TodoOutput{},
+			err: usecase.NewError(`JSON patch test failed: value at "/status" is not "completed"`,
+				fmt.Errorf(`%w: value at "/status" is not "completed"`, jsonpatch.ErrTestFailed), usecase.ErrorTypeConflict),
+		},
+		{
+			name:   "should fail when a read only field is changed",
+			store:  getOnly(),
+			patch:  `[{"op":"replace","path":"/items/0/done","value":true}]`,
+			result: todo.TodoOutput{},
+			err: usecase.NewError(`invalid JSON patch operation: "/items" is read only`,
+				fmt.Errorf(`%w: "/items" is read only`, jsonpatch.ErrInvalidOperation), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when an unknown field is added",
+			store:  getOnly(),
+			patch:  `[{"op":"add","path":"/color","value":"red"}]`,
+			result: todo.TodoOutput{},
+			err: usecase.NewError(`invalid JSON patch operation: "/color" is not a todo field`,
+				fmt.Errorf(`%w: "/color" is not a todo field`, jsonpatch.ErrInvalidOperation), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when a field has the wrong type",
+			store:  getOnly(),
+			patch:  `[{"op":"replace","path":"/title","value":5}]`,
+			result: todo.TodoOutput{},
+			err: usecase.NewError(`invalid JSON patch operation: "/title" must be a string`,
+				fmt.Errorf(`%w: "/title" must be a string`, jsonpatch.ErrInvalidOperation), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when the patched todo is not valid",
+			store:  getOnly(),
+			patch:  `[{"op":"replace","path":"/status","value":"done"}]`,
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo invalid input: status must be 'pending' or 'completed'",
+				fmt.Errorf("%w: status must be 'pending' or 'completed'", domain.ErrTodoInvalidInput), usecase.ErrorTypeBadRequest),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDateUpdated).Maybe()
+			uc := todo.NewJSONPatch(tc.store, newTransactorMock(), clock, domain.DefaultWorkflow(), newAuditStoreMock(),
+				newEventPublisherMock())
+			result, err := uc.Handle(context.TODO(), todo.JSONPatchInput{ID: "123", Operations: operations(tc.patch)})
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
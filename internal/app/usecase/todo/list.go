@@ -2,35 +2,126 @@ package todo
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/wellingtonlope/todo-api/internal/app/usecase"
 	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
+const (
+	// DefaultListLimit is the page size used when a cursor is given without a limit.
+	DefaultListLimit = 20
+	// MaxListLimit is the largest page size a client can request.
+	MaxListLimit = 100
+)
+
 type (
+	// ListQuery is what the store receives to select a page of todos.
+	// A zero Limit means no limit and a nil After means the first page.
+	// Now is the reference instant of the Overdue filter and is only set when it is used.
+	ListQuery struct {
+		Filter ListFilter
+		Now    time.Time
+		Sort   ListSort
+		Limit  int
+		After  *ListCursor
+	}
 	ListStore interface {
-		List(context.Context, *domain.TodoStatus) ([]domain.Todo, error)
+		List(context.Context, ListQuery) ([]domain.Todo, error)
 	}
 	List interface {
-		Handle(context.Context, ListInput) ([]TodoOutput, error)
+		Handle(context.Context, ListInput) (ListOutput, error)
 	}
 	ListInput struct {
-		Status *domain.TodoStatus
+		Filter ListFilter
+		Sort   ListSort
+		Limit  int
+		Cursor string
+	}
+	ListOutput struct {
+		Todos      []TodoOutput
+		NextCursor string
 	}
 	list struct {
-		store ListStore
+		store    ListStore
+		clock    usecase.Clock
+		workflow domain.Workflow
 	}
 )
 
-func NewList(store ListStore) *list {
-	return &list{store}
+func NewList(store ListStore, clock usecase.Clock, workflow domain.Workflow) *list {
+	return &list{
+		store:    store,
+		clock:    clock,
+		workflow: workflow,
+	}
 }
 
-func (uc *list) Handle(ctx context.Context, input ListInput) ([]TodoOutput, error) {
-	todos, err := uc.store.List(ctx, input.Status)
+func (uc *list) Handle(ctx context.Context, input ListInput) (ListOutput, error) {
+	query, err := listQueryFromInput(input, uc.workflow)
 	if err != nil {
-		return []TodoOutput{}, usecase.NewError("fail to list todos",
+		return ListOutput{}, err
+	}
+	if query.Filter.Overdue {
+		query.Now = uc.clock.Now()
+	}
+	todos, err := uc.store.List(ctx, query)
+	if err != nil {
+		return ListOutput{}, usecase.NewError("fail to list todos",
 			err, usecase.ErrorTypeInternalError)
 	}
-	return TodoOutputsFromDomain(todos), nil
+	var nextCursor string
+	if query.Limit > 0 && len(todos) >= query.Limit {
+		todos = todos[:query.Limit-1]
+		nextCursor = encodeCursor(cursorFromDomain(todos[len(todos)-1]), query.Sort)
+	}
+	return ListOutput{
+		Todos:      TodoOutputsFromDomain(todos),
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// listQueryFromInput validates the filter, sort and pagination input and builds the store query.
+// The store is asked for one extra todo so the usecase knows whether a next page exists.
+func listQueryFromInput(input ListInput, workflow domain.Workflow) (ListQuery, error) {
+	if err := validateStatusFilter(input.Filter.Status, workflow); err != nil {
+		return ListQuery{}, err
+	}
+	filter, err := input.Filter.normalized()
+	if err != nil {
+		return ListQuery{}, badRequestError(err.Error(), err)
+	}
+	sort, err := input.Sort.withDefaults()
+	if err != nil {
+		return ListQuery{}, badRequestError(err.Error(), err)
+	}
+	query := ListQuery{Filter: filter, Sort: sort}
+	if input.Limit < 0 || input.Limit > MaxListLimit {
+		return ListQuery{}, badRequestError(
+			fmt.Sprintf("limit must be between 1 and %d", MaxListLimit), nil)
+	}
+	limit := input.Limit
+	if input.Cursor != "" {
+		cursor, err := decodeCursor(input.Cursor, sort)
+		if err != nil {
+			return ListQuery{}, badRequestError(err.Error(), err)
+		}
+		query.After = &cursor
+		if limit == 0 {
+			limit = DefaultListLimit
+		}
+	}
+	if limit > 0 {
+		query.Limit = limit + 1
+	}
+	return query, nil
+}
+
+// validateStatusFilter checks the status filter, when given, is a status of the workflow.
+func validateStatusFilter(status *domain.TodoStatus, workflow domain.Workflow) error {
+	if status != nil && !workflow.HasStatus(*status) {
+		return badRequestError(fmt.Sprintf("invalid status: must be %s", workflow.DescribeStatuses()), nil)
+	}
+	return nil
 }
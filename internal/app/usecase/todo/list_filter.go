@@ -0,0 +1,81 @@
+package todo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// ListFilter narrows the todos returned by a list. Zero-valued fields are ignored.
+//
+// Date bounds are exclusive except UpdatedSince, which includes todos updated
+// exactly at the given instant. Overdue selects the todos that are not completed
+// and whose due date has already passed. Tags selects the todos with any or all
+// of the tags, depending on TagMode. ProjectID selects the todos of a project.
+// Archived todos are left out unless IncludeArchived is set.
+type ListFilter struct {
+	Status       *domain.TodoStatus
+	Priority     *domain.TodoPriority
+	Tags         []string
+	TagMode      TagMatchMode
+	ProjectID    *string
+	DueBefore    *time.Time
+	DueAfter     *time.Time
+	Overdue      bool
+	NoDueDate    bool
+	CreatedAfter *time.Time
+	UpdatedSince *time.Time
+
+	IncludeArchived bool
+}
+
+// TagMatchMode tells how the tags of a ListFilter are matched. The empty mode is TagMatchAny.
+type TagMatchMode string
+
+const (
+	// TagMatchAny selects the todos with at least one of the tags.
+	TagMatchAny TagMatchMode = "any"
+	// TagMatchAll selects the todos with every one of the tags.
+	TagMatchAll TagMatchMode = "all"
+)
+
+// IsValid checks if the mode is a valid TagMatchMode.
+func (m TagMatchMode) IsValid() bool {
+	return m == TagMatchAny || m == TagMatchAll
+}
+
+// normalized checks the filter does not combine contradicting conditions and
+// returns it with normalized tags.
+func (f ListFilter) normalized() (ListFilter, error) {
+	hasDueBounds := f.DueBefore != nil || f.DueAfter != nil || f.Overdue
+	if f.NoDueDate && hasDueBounds {
+		return ListFilter{}, errors.New("no_due_date cannot be combined with due_before, due_after or overdue")
+	}
+	if f.DueBefore != nil && f.DueAfter != nil && !f.DueAfter.Before(*f.DueBefore) {
+		return ListFilter{}, errors.New("due_after must be before due_before")
+	}
+	if f.TagMode != "" && !f.TagMode.IsValid() {
+		return ListFilter{}, errors.New("invalid tag_mode: must be 'any' or 'all'")
+	}
+	if f.Priority != nil && !f.Priority.IsValid() {
+		priorities := make([]string, 0, len(domain.TodoPriorities))
+		for _, p := range domain.TodoPriorities {
+			priorities = append(priorities, string(p))
+		}
+		return ListFilter{}, fmt.Errorf("invalid priority: must be one of %s", strings.Join(priorities, ", "))
+	}
+	tags := make([]string, 0, len(f.Tags))
+	for _, tag := range f.Tags {
+		normalized, err := domain.NormalizeTag(tag)
+		if err != nil {
+			return ListFilter{}, fmt.Errorf("invalid tag %q: must have 1 to %d letters, digits, hyphens or underscores",
+				tag, domain.MaxTagLength)
+		}
+		tags = append(tags, normalized)
+	}
+	f.Tags, _ = domain.NormalizeTags(tags)
+	return f, nil
+}
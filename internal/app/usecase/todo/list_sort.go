@@ -0,0 +1,84 @@
+package todo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ListSortField is the todo field a list is ordered by.
+type ListSortField string
+
+const (
+	// ListSortByDueDate orders by due date; todos without a due date always come last.
+	ListSortByDueDate ListSortField = "due_date"
+	// ListSortByCreatedAt orders by creation date.
+	ListSortByCreatedAt ListSortField = "created_at"
+	// ListSortByUpdatedAt orders by last update date.
+	ListSortByUpdatedAt ListSortField = "updated_at"
+	// ListSortByTitle orders by title.
+	ListSortByTitle ListSortField = "title"
+	// ListSortByPriority orders by priority rank, from none to urgent.
+	ListSortByPriority ListSortField = "priority"
+)
+
+var validSortFields = []ListSortField{
+	ListSortByDueDate,
+	ListSortByCreatedAt,
+	ListSortByUpdatedAt,
+	ListSortByTitle,
+	ListSortByPriority,
+}
+
+// IsValid checks if the field is a valid ListSortField.
+func (f ListSortField) IsValid() bool {
+	return slices.Contains(validSortFields, f)
+}
+
+// SortDirection is the direction of a list ordering.
+type SortDirection string
+
+const (
+	// SortAscending orders from the smallest to the largest value.
+	SortAscending SortDirection = "asc"
+	// SortDescending orders from the largest to the smallest value.
+	SortDescending SortDirection = "desc"
+)
+
+// IsValid checks if the direction is a valid SortDirection.
+func (d SortDirection) IsValid() bool {
+	return d == SortAscending || d == SortDescending
+}
+
+// ListSort defines the ordering of a list. Ties are always broken by ID
+// in the same direction, so the ordering is total and stable across pages.
+type ListSort struct {
+	Field     ListSortField
+	Direction SortDirection
+}
+
+// DefaultListSort is used when the client does not choose an ordering.
+var DefaultListSort = ListSort{Field: ListSortByCreatedAt, Direction: SortAscending}
+
+// withDefaults fills the empty parts of the sort with the default ordering
+// and checks the result is valid.
+func (s ListSort) withDefaults() (ListSort, error) {
+	if s.Field == "" {
+		s.Field = DefaultListSort.Field
+	}
+	if s.Direction == "" {
+		s.Direction = DefaultListSort.Direction
+	}
+	if !s.Field.IsValid() {
+		fields := make([]string, 0, len(validSortFields))
+		for _, f := range validSortFields {
+			fields = append(fields, string(f))
+		}
+		return ListSort{}, fmt.Errorf("invalid sort: must be one of %s", strings.Join(fields, ", "))
+	}
+	if !s.Direction.IsValid() {
+		return ListSort{}, errors.New("invalid order: must be 'asc' or 'desc'")
+	}
+	return s, nil
+}
@@ -0,0 +1,43 @@
+package todo
+
+import (
+	"cmp"
+	"context"
+	"slices"
+)
+
+type (
+	// TagUsage is a tag with the number of todos labelled with it.
+	TagUsage struct {
+		Name  string
+		Count int
+	}
+	ListTagsStore interface {
+		ListTags(context.Context) ([]TagUsage, error)
+	}
+	ListTags interface {
+		Handle(context.Context) ([]TagUsage, error)
+	}
+	listTags struct {
+		store ListTagsStore
+	}
+)
+
+func NewListTags(store ListTagsStore) *listTags {
+	return &listTags{store}
+}
+
+// Handle returns the tags in use, the most used first and ties ordered by name.
+func (uc *listTags) Handle(ctx context.Context) ([]TagUsage, error) {
+	tags, err := uc.store.ListTags(ctx)
+	if err != nil {
+		return []TagUsage{}, internalError("fail to list tags", err)
+	}
+	slices.SortFunc(tags, func(a, b TagUsage) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return tags, nil
+}
@@ -0,0 +1,69 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+func TestListTags_Handle(t *testing.T) {
+	testCases := []struct {
+		name   string
+		store  *listTagsStoreMock
+		result []todo.TagUsage
+		err    error
+	}{
+		{
+			name: "should fail when store fails",
+			store: func() *listTagsStoreMock {
+				m := new(listTagsStoreMock)
+				m.On("ListTags", context.TODO()).Return([]todo.TagUsage(nil), assert.AnError).Once()
+				return m
+			}(),
+			result: []todo.TagUsage{},
+			err:    usecase.NewError("fail to list tags", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should list the most used tags first and ties by name",
+			store: func() *listTagsStoreMock {
+				m := new(listTagsStoreMock)
+				m.On("ListTags", context.TODO()).Return([]todo.TagUsage{
+					{Name: "work", Count: 2},
+					{Name: "blocked", Count: 1},
+					{Name: "home", Count: 3},
+					{Name: "errand", Count: 1},
+				}, nil).Once()
+				return m
+			}(),
+			result: []todo.TagUsage{
+				{Name: "home", Count: 3},
+				{Name: "work", Count: 2},
+				{Name: "blocked", Count: 1},
+				{Name: "errand", Count: 1},
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewListTags(tc.store)
+			result, err := uc.Handle(context.TODO())
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
+
+type listTagsStoreMock struct {
+	mock.Mock
+}
+
+func (m *listTagsStoreMock) ListTags(ctx context.Context) ([]todo.TagUsage, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]todo.TagUsage), args.Error(1)
+}
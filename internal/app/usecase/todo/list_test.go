@@ -2,6 +2,7 @@ package todo_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -14,14 +15,24 @@ import (
 
 func TestList_Handle(t *testing.T) {
 	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
 	pendingStatus := domain.TodoStatusPending
 	completedStatus := domain.TodoStatusCompleted
+	invalidStatus := domain.TodoStatus("done")
+	invalidPriority := domain.TodoPriority("critical")
+	titleDesc := todo.ListSort{Field: todo.ListSortByTitle, Direction: todo.SortDescending}
+	firstCursorTodo := todo.ListCursor{ID: "1", Title: "first", CreatedAt: exampleDate, UpdatedAt: exampleDate}
+	// base64url of {"f":"created_at","o":"asc","k":{"i":"1","t":"first","c":"2024-01-01T00:00:00Z","u":"2024-01-01T00:00:00Z"}}
+	firstCursor := "eyJmIjoiY3JlYXRlZF9hdCIsIm8iOiJhc2MiLCJrIjp7ImkiOiIxIiwidCI6ImZpcnN0IiwiYyI6IjIwMjQtMDEtMDFUMDA6MDA6MDBaIiwidSI6IjIwMjQtMDEtMDFUMDA6MDA6MDBaIn19"
+	// base64url of the same cursor produced for the title descending sort
+	firstTitleDescCursor := "eyJmIjoidGl0bGUiLCJvIjoiZGVzYyIsImsiOnsiaSI6IjEiLCJ0IjoiZmlyc3QiLCJjIjoiMjAyNC0wMS0wMVQwMDowMDowMFoiLCJ1IjoiMjAyNC0wMS0wMVQwMDowMDowMFoifX0"
 
 	testCases := []struct {
 		name   string
 		store  *listStoreMock
+		clock  *clockMock
 		input  todo.ListInput
-		result []todo.TodoOutput
+		result todo.ListOutput
 		err    error
 	}{
 		{
@@ -32,8 +43,9 @@ func TestList_Handle(t *testing.T) {
 					Return([]domain.Todo{}, assert.AnError).Once()
 				return m
 			}(),
+			clock:  newClockMock(),
 			input:  todo.ListInput{},
-			result: []todo.TodoOutput{},
+			result: todo.ListOutput{},
 			err: usecase.NewError("fail to list todos",
 				assert.AnError, usecase.ErrorTypeInternalError),
 		},
@@ -53,8 +65,9 @@ func TestList_Handle(t *testing.T) {
 					}, nil).Once()
 				return m
 			}(),
+			clock: newClockMock(),
 			input: todo.ListInput{},
-			result: []todo.TodoOutput{
+			result: todo.ListOutput{Todos: []todo.TodoOutput{
 				{
 					ID:          "123",
 					Title:       "title",
@@ -62,14 +75,14 @@ func TestList_Handle(t *testing.T) {
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
 				},
-			},
+			}},
 			err: nil,
 		},
 		{
 			name: "should list todos filtered by pending status",
 			store: func() *listStoreMock {
 				m := new(listStoreMock)
-				m.On("List", context.TODO(), &pendingStatus).
+				m.On("List", context.TODO(), todo.ListQuery{Filter: todo.ListFilter{Status: &pendingStatus}, Sort: todo.DefaultListSort}).
 					Return([]domain.Todo{
 						{
 							ID:        "123",
@@ -81,8 +94,9 @@ func TestList_Handle(t *testing.T) {
 					}, nil).Once()
 				return m
 			}(),
-			input: todo.ListInput{Status: &pendingStatus},
-			result: []todo.TodoOutput{
+			clock: newClockMock(),
+			input: todo.ListInput{Filter: todo.ListFilter{Status: &pendingStatus}},
+			result: todo.ListOutput{Todos: []todo.TodoOutput{
 				{
 					ID:        "123",
 					Title:     "pending todo",
@@ -90,14 +104,14 @@ func TestList_Handle(t *testing.T) {
 					CreatedAt: exampleDate,
 					UpdatedAt: exampleDate,
 				},
-			},
+			}},
 			err: nil,
 		},
 		{
 			name: "should list todos filtered by completed status",
 			store: func() *listStoreMock {
 				m := new(listStoreMock)
-				m.On("List", context.TODO(), &completedStatus).
+				m.On("List", context.TODO(), todo.ListQuery{Filter: todo.ListFilter{Status: &completedStatus}, Sort: todo.DefaultListSort}).
 					Return([]domain.Todo{
 						{
 							ID:        "456",
@@ -109,8 +123,9 @@ func TestList_Handle(t *testing.T) {
 					}, nil).Once()
 				return m
 			}(),
-			input: todo.ListInput{Status: &completedStatus},
-			result: []todo.TodoOutput{
+			clock: newClockMock(),
+			input: todo.ListInput{Filter: todo.ListFilter{Status: &completedStatus}},
+			result: todo.ListOutput{Todos: []todo.TodoOutput{
 				{
 					ID:        "456",
 					Title:     "completed todo",
@@ -118,17 +133,269 @@ func TestList_Handle(t *testing.T) {
 					CreatedAt: exampleDate,
 					UpdatedAt: exampleDate,
 				},
+			}},
+			err: nil,
+		},
+		{
+			name: "should return a page with the next cursor when more todos exist",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), todo.ListQuery{Sort: todo.DefaultListSort, Limit: 2}).
+					Return([]domain.Todo{
+						{ID: "1", Title: "first", CreatedAt: exampleDate, UpdatedAt: exampleDate},
+						{ID: "2", Title: "second", CreatedAt: exampleDate},
+					}, nil).Once()
+				return m
+			}(),
+			clock: newClockMock(),
+			input: todo.ListInput{Limit: 1},
+			result: todo.ListOutput{
+				Todos: []todo.TodoOutput{
+					{ID: "1", Title: "first", CreatedAt: exampleDate, UpdatedAt: exampleDate},
+				},
+				NextCursor: firstCursor,
+			},
+			err: nil,
+		},
+		{
+			name: "should return the last page without a next cursor",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), todo.ListQuery{
+					Sort:  todo.DefaultListSort,
+					Limit: 3,
+					After: &firstCursorTodo,
+				}).Return([]domain.Todo{
+					{ID: "2", Title: "second", CreatedAt: exampleDate, UpdatedAt: exampleDate},
+				}, nil).Once()
+				return m
+			}(),
+			clock: newClockMock(),
+			input: todo.ListInput{Limit: 2, Cursor: firstCursor},
+			result: todo.ListOutput{
+				Todos: []todo.TodoOutput{
+					{ID: "2", Title: "second", CreatedAt: exampleDate, UpdatedAt: exampleDate},
+				},
 			},
 			err: nil,
 		},
+		{
+			name: "should use the default limit when only a cursor is given",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), todo.ListQuery{
+					Sort:  todo.DefaultListSort,
+					Limit: todo.DefaultListLimit + 1,
+					After: &firstCursorTodo,
+				}).Return([]domain.Todo{}, nil).Once()
+				return m
+			}(),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Cursor: firstCursor},
+			result: todo.ListOutput{Todos: []todo.TodoOutput{}},
+			err:    nil,
+		},
+		{
+			name:   "should fail when limit is greater than the maximum",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Limit: todo.MaxListLimit + 1},
+			result: todo.ListOutput{},
+			err: usecase.NewError("limit must be between 1 and 100",
+				nil, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should list todos with the requested sort",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), todo.ListQuery{
+					Sort:  titleDesc,
+					Limit: 2,
+					After: &firstCursorTodo,
+				}).Return([]domain.Todo{}, nil).Once()
+				return m
+			}(),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Sort: titleDesc, Limit: 1, Cursor: firstTitleDescCursor},
+			result: todo.ListOutput{Todos: []todo.TodoOutput{}},
+			err:    nil,
+		},
+		{
+			name: "should default the direction to ascending",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), todo.ListQuery{
+					Sort: todo.ListSort{Field: todo.ListSortByDueDate, Direction: todo.SortAscending},
+				}).Return([]domain.Todo{}, nil).Once()
+				return m
+			}(),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Sort: todo.ListSort{Field: todo.ListSortByDueDate}},
+			result: todo.ListOutput{Todos: []todo.TodoOutput{}},
+			err:    nil,
+		},
+		{
+			name:   "should fail when the status is not in the workflow",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Filter: todo.ListFilter{Status: &invalidStatus}},
+			result: todo.ListOutput{},
+			err: usecase.NewError("invalid status: must be 'pending' or 'completed'",
+				nil, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when sort field is invalid",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Sort: todo.ListSort{Field: "status"}},
+			result: todo.ListOutput{},
+			err: usecase.NewError("invalid sort: must be one of due_date, created_at, updated_at, title, priority",
+				errors.New("invalid sort: must be one of due_date, created_at, updated_at, title, priority"),
+				usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when sort direction is invalid",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Sort: todo.ListSort{Direction: "up"}},
+			result: todo.ListOutput{},
+			err: usecase.NewError("invalid order: must be 'asc' or 'desc'",
+				errors.New("invalid order: must be 'asc' or 'desc'"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when cursor was produced for another sort",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Sort: titleDesc, Cursor: firstCursor},
+			result: todo.ListOutput{},
+			err: usecase.NewError("cursor does not match the requested sort",
+				errors.New("cursor does not match the requested sort"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should pass the date filters to the store",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), todo.ListQuery{
+					Filter: todo.ListFilter{
+						DueBefore:    &exampleDateUpdated,
+						DueAfter:     &exampleDate,
+						CreatedAfter: &exampleDate,
+						UpdatedSince: &exampleDate,
+					},
+					Sort: todo.DefaultListSort,
+				}).Return([]domain.Todo{}, nil).Once()
+				return m
+			}(),
+			clock: newClockMock(),
+			input: todo.ListInput{Filter: todo.ListFilter{
+				DueBefore:    &exampleDateUpdated,
+				DueAfter:     &exampleDate,
+				CreatedAfter: &exampleDate,
+				UpdatedSince: &exampleDate,
+			}},
+			result: todo.ListOutput{Todos: []todo.TodoOutput{}},
+			err:    nil,
+		},
+		{
+			name: "should use the clock as reference for overdue todos",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), todo.ListQuery{
+					Filter: todo.ListFilter{Overdue: true},
+					Now:    exampleDateUpdated,
+					Sort:   todo.DefaultListSort,
+				}).Return([]domain.Todo{}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			input:  todo.ListInput{Filter: todo.ListFilter{Overdue: true}},
+			result: todo.ListOutput{Todos: []todo.TodoOutput{}},
+			err:    nil,
+		},
+		{
+			name: "should pass the normalized tags to the store",
+			store: func() *listStoreMock {
+				m := new(listStoreMock)
+				m.On("List", context.TODO(), todo.ListQuery{
+					Filter: todo.ListFilter{Tags: []string{"home", "work"}, TagMode: todo.TagMatchAll},
+					Sort:   todo.DefaultListSort,
+				}).Return([]domain.Todo{}, nil).Once()
+				return m
+			}(),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Filter: todo.ListFilter{Tags: []string{" Work", "home", "work"}, TagMode: todo.TagMatchAll}},
+			result: todo.ListOutput{Todos: []todo.TodoOutput{}},
+			err:    nil,
+		},
+		{
+			name:   "should fail when a tag is invalid",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Filter: todo.ListFilter{Tags: []string{"to#do"}}},
+			result: todo.ListOutput{},
+			err: usecase.NewError(`invalid tag "to#do": must have 1 to 32 letters, digits, hyphens or underscores`,
+				errors.New(`invalid tag "to#do": must have 1 to 32 letters, digits, hyphens or underscores`),
+				usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when tag mode is invalid",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Filter: todo.ListFilter{Tags: []string{"work"}, TagMode: "some"}},
+			result: todo.ListOutput{},
+			err: usecase.NewError("invalid tag_mode: must be 'any' or 'all'",
+				errors.New("invalid tag_mode: must be 'any' or 'all'"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when priority is invalid",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Filter: todo.ListFilter{Priority: &invalidPriority}},
+			result: todo.ListOutput{},
+			err: usecase.NewError("invalid priority: must be one of none, low, medium, high, urgent",
+				errors.New("invalid priority: must be one of none, low, medium, high, urgent"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when no due date is combined with due date bounds",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Filter: todo.ListFilter{NoDueDate: true, Overdue: true}},
+			result: todo.ListOutput{},
+			err: usecase.NewError("no_due_date cannot be combined with due_before, due_after or overdue",
+				errors.New("no_due_date cannot be combined with due_before, due_after or overdue"),
+				usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when due after is not before due before",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Filter: todo.ListFilter{DueBefore: &exampleDate, DueAfter: &exampleDateUpdated}},
+			result: todo.ListOutput{},
+			err: usecase.NewError("due_after must be before due_before",
+				errors.New("due_after must be before due_before"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when cursor is malformed",
+			store:  new(listStoreMock),
+			clock:  newClockMock(),
+			input:  todo.ListInput{Limit: 10, Cursor: "not a cursor"},
+			result: todo.ListOutput{},
+			err: usecase.NewError("invalid cursor",
+				errors.New("invalid cursor"), usecase.ErrorTypeBadRequest),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			uc := todo.NewList(tc.store)
+			uc := todo.NewList(tc.store, tc.clock, domain.DefaultWorkflow())
 			result, err := uc.Handle(context.TODO(), tc.input)
 			assert.Equal(t, tc.result, result)
 			assert.Equal(t, tc.err, err)
 			tc.store.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
 		})
 	}
 }
@@ -137,7 +404,7 @@ type listStoreMock struct {
 	mock.Mock
 }
 
-func (m *listStoreMock) List(ctx context.Context, status *domain.TodoStatus) ([]domain.Todo, error) {
-	args := m.Called(ctx, status)
+func (m *listStoreMock) List(ctx context.Context, query todo.ListQuery) ([]domain.Todo, error) {
+	args := m.Called(ctx, query)
 	return args.Get(0).([]domain.Todo), args.Error(1)
 }
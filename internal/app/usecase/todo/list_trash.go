@@ -0,0 +1,32 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	ListTrashStore interface {
+		// ListTrash returns the todos in the trash, the most recently deleted first
+		ListTrash(context.Context) ([]domain.Todo, error)
+	}
+	ListTrash interface {
+		Handle(context.Context) ([]TodoOutput, error)
+	}
+	listTrash struct {
+		store ListTrashStore
+	}
+)
+
+func NewListTrash(store ListTrashStore) *listTrash {
+	return &listTrash{store}
+}
+
+func (uc *listTrash) Handle(ctx context.Context) ([]TodoOutput, error) {
+	todos, err := uc.store.ListTrash(ctx)
+	if err != nil {
+		return []TodoOutput{}, internalError("fail to list the trash", err)
+	}
+	return TodoOutputsFromDomain(todos), nil
+}
@@ -0,0 +1,66 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestListTrash_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name   string
+		store  *listTrashStoreMock
+		result []todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should fail when store fails",
+			store: func() *listTrashStoreMock {
+				m := new(listTrashStoreMock)
+				m.On("ListTrash", context.TODO()).Return([]domain.Todo(nil), assert.AnError).Once()
+				return m
+			}(),
+			result: []todo.TodoOutput{},
+			err:    usecase.NewError("fail to list the trash", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should list the todos in the trash",
+			store: func() *listTrashStoreMock {
+				m := new(listTrashStoreMock)
+				m.On("ListTrash", context.TODO()).Return([]domain.Todo{
+					{ID: "123", Title: "title", DeletedAt: &exampleDate},
+				}, nil).Once()
+				return m
+			}(),
+			result: []todo.TodoOutput{
+				{ID: "123", Title: "title", DeletedAt: &exampleDate},
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewListTrash(tc.store)
+			result, err := uc.Handle(context.TODO())
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
+
+type listTrashStoreMock struct {
+	mock.Mock
+}
+
+func (m *listTrashStoreMock) ListTrash(ctx context.Context) ([]domain.Todo, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Todo), args.Error(1)
+}
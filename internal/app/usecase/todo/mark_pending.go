@@ -3,45 +3,32 @@ package todo
 import (
 	"context"
 
-	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
 type (
 	MarkAsPendingInput struct {
 		ID string
+		// Versions, when given, are the only versions the todo may still have
+		Versions []int
 	}
-	MarkAsPendingStore = TodoUpdater
-	MarkAsPending      interface {
+	MarkAsPending interface {
 		Handle(context.Context, MarkAsPendingInput) (TodoOutput, error)
 	}
+	// markAsPending is an alias of the transition to pending.
 	markAsPending struct {
-		store MarkAsPendingStore
-		clock usecase.Clock
+		transition Transition
 	}
 )
 
-func NewMarkAsPending(store MarkAsPendingStore, clock usecase.Clock) *markAsPending {
-	return &markAsPending{
-		store: store,
-		clock: clock,
-	}
+func NewMarkAsPending(transition Transition) *markAsPending {
+	return &markAsPending{transition: transition}
 }
 
 func (uc *markAsPending) Handle(ctx context.Context, input MarkAsPendingInput) (TodoOutput, error) {
-	todo, err := uc.store.GetByID(ctx, input.ID)
-	if err != nil {
-		if isNotFound(err) {
-			return TodoOutput{}, notFoundError(input.ID, err)
-		}
-		return TodoOutput{}, internalError("fail to get a todo by id", err)
-	}
-	todo = todo.MarkAsPending(uc.clock.Now())
-	todo, err = uc.store.Update(ctx, todo)
-	if err != nil {
-		if isNotFound(err) {
-			return TodoOutput{}, notFoundError(input.ID, err)
-		}
-		return TodoOutput{}, internalError("fail to update a todo in the store", err)
-	}
-	return TodoOutputFromDomain(todo), nil
+	return uc.transition.Handle(ctx, TransitionInput{
+		ID:       input.ID,
+		Status:   domain.TodoStatusPending,
+		Versions: input.Versions,
+	})
 }
@@ -0,0 +1,74 @@
+package todo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	// MoveInput moves the todo with ID to the project with ProjectID, or out of its
+	// project when ProjectID is nil.
+	MoveInput struct {
+		ID        string
+		ProjectID *string
+	}
+	MoveStore = TodoUpdater
+	// MoveProjectStore gets the projects the todos are moved to. A project read inside a unit of
+	// work stays locked until it ends, so it cannot be deleted before the todo is moved.
+	MoveProjectStore interface {
+		GetByID(context.Context, string) (domain.Project, error)
+	}
+	Move interface {
+		Handle(context.Context, MoveInput) (TodoOutput, error)
+	}
+	move struct {
+		store      MoveStore
+		projects   MoveProjectStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewMove(
+	store MoveStore, projects MoveProjectStore, transactor usecase.Transactor, clock usecase.Clock,
+	audit AuditStore, events usecase.EventPublisher,
+) *move {
+	return &move{
+		store:      store,
+		projects:   projects,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+// Handle checks the project exists and moves the todo to it in a single unit of work, recording
+// the move in the audit log.
+func (uc *move) Handle(ctx context.Context, input MoveInput) (TodoOutput, error) {
+	return inPublishedTransaction(ctx, uc.transactor, uc.events, func(ctx context.Context) (TodoOutput, error) {
+		if input.ProjectID != nil {
+			if _, err := uc.projects.GetByID(ctx, *input.ProjectID); err != nil {
+				if errors.Is(err, domain.ErrProjectNotFound) {
+					return TodoOutput{}, projectNotFoundError(*input.ProjectID, err)
+				}
+				return TodoOutput{}, internalError("fail to get a project by id", err)
+			}
+		}
+		before, after, err := updateTodo(ctx, uc.store, input.ID, nil, func(todo domain.Todo) (domain.Todo, error) {
+			return todo.MoveToProject(input.ProjectID, uc.clock.Now()), nil
+		})
+		if err != nil {
+			return TodoOutput{}, err
+		}
+		if err := recordAudit(ctx, uc.audit, domain.AuditOperationMove, &before, &after, after.UpdatedAt); err != nil {
+			return TodoOutput{}, err
+		}
+		return TodoOutputFromDomain(after), nil
+	})
+}
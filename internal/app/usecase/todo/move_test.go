@@ -0,0 +1,181 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestMove_Handle(t *testing.T) {
+	created, _ := time.Parse(time.DateOnly, "2024-01-01")
+	updated := created.Add(time.Hour)
+	projectID := "p1"
+	existing := domain.Todo{
+		ID: "123", Title: "example title", Status: domain.TodoStatusPending,
+		CreatedAt: created, UpdatedAt: created, Version: 1,
+	}
+	moved := existing
+	moved.ProjectID = &projectID
+	moved.UpdatedAt = updated
+	saved := moved
+	saved.Version = 2
+	testCases := []struct {
+		name     string
+		projects *moveProjectStoreMock
+		store    *todoUpdaterMock
+		clock    *clockMock
+		audit    *auditStoreMock
+		input    todo.MoveInput
+		result   todo.TodoOutput
+		err      error
+	}{
+		{
+			name: "should fail when project is not found",
+			projects: func() *moveProjectStoreMock {
+				m := new(moveProjectStoreMock)
+				m.On("GetByID", mock.Anything, "p1").Return(domain.Project{}, domain.ErrProjectNotFound).Once()
+				return m
+			}(),
+			store:  new(todoUpdaterMock),
+			clock:  newClockMock(),
+			audit:  new(auditStoreMock),
+			input:  todo.MoveInput{ID: "123", ProjectID: &projectID},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("project not found with id p1", domain.ErrProjectNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when getting the project fails",
+			projects: func() *moveProjectStoreMock {
+				m := new(moveProjectStoreMock)
+				m.On("GetByID", mock.Anything, "p1").Return(domain.Project{}, assert.AnError).Once()
+				return m
+			}(),
+			store:  new(todoUpdaterMock),
+			clock:  newClockMock(),
+			audit:  new(auditStoreMock),
+			input:  todo.MoveInput{ID: "123", ProjectID: &projectID},
+			result: todo.TodoOutput{},
+			err:    usecase.NewError("fail to get a project by id", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name:     "should fail when todo is not found",
+			projects: new(moveProjectStoreMock),
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			clock:  newClockMock(),
+			audit:  new(auditStoreMock),
+			input:  todo.MoveInput{ID: "123"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo not found with id 123", domain.ErrTodoNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when store fails to update",
+			projects: func() *moveProjectStoreMock {
+				m := new(moveProjectStoreMock)
+				m.On("GetByID", mock.Anything, "p1").Return(domain.Project{ID: "p1"}, nil).Once()
+				return m
+			}(),
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(existing, nil).Once()
+				m.On("Update", mock.Anything, moved).Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(updated).Once()
+				return m
+			}(),
+			audit:  new(auditStoreMock),
+			input:  todo.MoveInput{ID: "123", ProjectID: &projectID},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to update a todo in the store", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should move a todo to a project and record it in the audit log",
+			projects: func() *moveProjectStoreMock {
+				m := new(moveProjectStoreMock)
+				m.On("GetByID", mock.Anything, "p1").Return(domain.Project{ID: "p1"}, nil).Once()
+				return m
+			}(),
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(existing, nil).Once()
+				m.On("Update", mock.Anything, moved).Return(saved, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(updated).Once()
+				return m
+			}(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.TodoID == "123" && record.Operation == domain.AuditOperationMove
+				})).Return(nil).Once()
+				return m
+			}(),
+			input:  todo.MoveInput{ID: "123", ProjectID: &projectID},
+			result: todo.TodoOutputFromDomain(saved),
+			err:    nil,
+		},
+		{
+			name:     "should remove a todo from its project",
+			projects: new(moveProjectStoreMock),
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				removed := existing
+				removed.UpdatedAt = updated
+				m.On("GetByID", mock.Anything, "123").Return(moved, nil).Once()
+				m.On("Update", mock.Anything, removed).Return(removed, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(updated).Once()
+				return m
+			}(),
+			audit: newAuditStoreMock(),
+			input: todo.MoveInput{ID: "123"},
+			result: todo.TodoOutput{
+				ID: "123", Title: "example title", Status: "pending", CreatedAt: created, UpdatedAt: updated,
+				Version: 1,
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewMove(tc.store, tc.projects, newTransactorMock(), tc.clock, tc.audit, newEventPublisherMock())
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.projects.AssertExpectations(t)
+			tc.store.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+			tc.audit.AssertExpectations(t)
+		})
+	}
+}
+
+type moveProjectStoreMock struct {
+	mock.Mock
+}
+
+func (m *moveProjectStoreMock) GetByID(ctx context.Context, id string) (domain.Project, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Project), args.Error(1)
+}
@@ -12,9 +12,25 @@ type TodoOutput struct {
 	Title       string
 	Description string
 	Status      string
+	Priority    string
+	Tags        []string
+	Items       []ChecklistItemOutput
+	Recurrence  string
+	ProjectID   *string
 	DueDate     *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CompletedAt *time.Time
+	ArchivedAt  *time.Time
+	Version     int
+	DeletedAt   *time.Time
+}
+
+// ChecklistItemOutput represents a checklist item of a todo output
+type ChecklistItemOutput struct {
+	ID    string
+	Title string
+	Done  bool
 }
 
 // TodoOutputFromDomain converts a domain.Todo to TodoOutput
@@ -24,10 +40,39 @@ func TodoOutputFromDomain(todo domain.Todo) TodoOutput {
 		Title:       todo.Title,
 		Description: todo.Description,
 		Status:      string(todo.Status),
+		Priority:    string(todo.Priority),
+		Tags:        todo.Tags,
+		Items:       checklistItemOutputsFromDomain(todo.Items),
+		Recurrence:  recurrenceOutputFromDomain(todo.Recurrence),
+		ProjectID:   todo.ProjectID,
 		DueDate:     todo.DueDate,
 		CreatedAt:   todo.CreatedAt,
 		UpdatedAt:   todo.UpdatedAt,
+		CompletedAt: todo.CompletedAt,
+		ArchivedAt:  todo.ArchivedAt,
+		Version:     todo.Version,
+		DeletedAt:   todo.DeletedAt,
+	}
+}
+
+// checklistItemOutputsFromDomain converts the checklist items, nil when there are none
+func checklistItemOutputsFromDomain(items []domain.ChecklistItem) []ChecklistItemOutput {
+	if len(items) == 0 {
+		return nil
+	}
+	outputs := make([]ChecklistItemOutput, len(items))
+	for i, item := range items {
+		outputs[i] = ChecklistItemOutput{ID: item.ID, Title: item.Title, Done: item.Done}
+	}
+	return outputs
+}
+
+// recurrenceOutputFromDomain formats the recurrence rule, empty when the todo does not recur
+func recurrenceOutputFromDomain(recurrence *domain.Recurrence) string {
+	if recurrence == nil {
+		return ""
 	}
+	return recurrence.String()
 }
 
 // TodoOutputsFromDomain converts a slice of domain.Todo to []TodoOutput
@@ -25,6 +25,9 @@ func TestTodoOutputFromDomain(t *testing.T) {
 				Title:       "Test Title",
 				Description: "Test Description",
 				Status:      domain.TodoStatusCompleted,
+				Priority:    domain.TodoPriorityHigh,
+				Tags:        []string{"work"},
+				Items:       []domain.ChecklistItem{{ID: "1", Title: "first step", Done: true}},
 				DueDate:     &exampleDueDate,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -34,6 +37,9 @@ func TestTodoOutputFromDomain(t *testing.T) {
 				Title:       "Test Title",
 				Description: "Test Description",
 				Status:      "completed",
+				Priority:    "high",
+				Tags:        []string{"work"},
+				Items:       []todo.ChecklistItemOutput{{ID: "1", Title: "first step", Done: true}},
 				DueDate:     &exampleDueDate,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -0,0 +1,95 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	// PatchInput changes only some fields of a todo, following JSON Merge Patch (RFC 7396):
+	// nil fields are left untouched, and a field set to its zero value is cleared.
+	PatchInput struct {
+		ID          string
+		Title       *string
+		Description *string
+		Priority    *domain.TodoPriority
+		Tags        *[]string
+		Recurrence  *string
+		// DueDate sets the due date, and RemoveDueDate clears it
+		DueDate       *time.Time
+		RemoveDueDate bool
+		// Versions, when given, are the only versions the todo may still have
+		Versions []int
+	}
+	PatchStore = TodoUpdater
+	Patch      interface {
+		Handle(context.Context, PatchInput) (TodoOutput, error)
+	}
+	patch struct {
+		store      PatchStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewPatch(
+	store PatchStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *patch {
+	return &patch{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+func (uc *patch) Handle(ctx context.Context, input PatchInput) (TodoOutput, error) {
+	return changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events, domain.AuditOperationUpdate,
+		input.ID, input.Versions,
+		func(todo domain.Todo) (domain.Todo, error) {
+			patched, err := applyPatch(todo, input, uc.clock.Now())
+			if err != nil {
+				return domain.Todo{}, badRequestError(err.Error(), err)
+			}
+			return patched, nil
+		})
+}
+
+// applyPatch merges the patched fields into the todo and validates the result as Update does.
+// A due date left untouched is kept as it is, even when it has already passed.
+func applyPatch(todo domain.Todo, input PatchInput, now time.Time) (domain.Todo, error) {
+	title, description := todo.Title, todo.Description
+	if input.Title != nil {
+		title = *input.Title
+	}
+	if input.Description != nil {
+		description = *input.Description
+	}
+	priority, tags, recurrence := todo.Priority, todo.Tags, recurrenceOutputFromDomain(todo.Recurrence)
+	if input.Priority != nil {
+		priority = *input.Priority
+	}
+	if input.Tags != nil {
+		tags = *input.Tags
+	}
+	if input.Recurrence != nil {
+		recurrence = *input.Recurrence
+	}
+
+	dueDate := todo.DueDate
+	todo, err := todo.Update(title, description, now, input.DueDate)
+	if err != nil {
+		return domain.Todo{}, err
+	}
+	if input.DueDate == nil && !input.RemoveDueDate {
+		todo.DueDate = dueDate
+	}
+	return withAttributes(todo, priority, tags, recurrence)
+}
@@ -0,0 +1,168 @@
+package todo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestPatch_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-10")
+	pastDueDate, _ := time.Parse(time.DateOnly, "2024-01-05")
+	futureDueDate, _ := time.Parse(time.DateOnly, "2024-02-01")
+	weekly := domain.Recurrence{Frequency: domain.RecurrenceWeekly, Interval: 1}
+	exampleTodo := domain.Todo{
+		ID:          "123",
+		Title:       "water the plants",
+		Description: "all of them",
+		Status:      domain.TodoStatusPending,
+		Priority:    domain.TodoPriorityHigh,
+		Tags:        []string{"home"},
+		Recurrence:  &weekly,
+		DueDate:     &pastDueDate,
+		CreatedAt:   exampleDate,
+		UpdatedAt:   exampleDate,
+		Version:     2,
+	}
+	patched := func(change func(*domain.Todo)) domain.Todo {
+		todo := exampleTodo
+		todo.UpdatedAt = exampleDateUpdated
+		change(&todo)
+		return todo
+	}
+	str := func(s string) *string { return &s }
+	testCases := []struct {
+		name   string
+		store  *todoUpdaterMock
+		input  todo.PatchInput
+		result todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should fail when todo not found",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			input:  todo.PatchInput{ID: "123", Title: str("feed the cat")},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo not found with id 123",
+				domain.ErrTodoNotFound, usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when the title is cleared",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				return m
+			}(),
+			input:  todo.PatchInput{ID: "123", Title: str("")},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo invalid input: title",
+				fmt.Errorf("%w: title", domain.ErrTodoInvalidInput), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when the todo is not at the expected version",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				return m
+			}(),
+			input:  todo.PatchInput{ID: "123", Title: str("feed the cat"), Versions: []int{0}},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo has changed: expected version 0, current version is 2",
+				domain.ErrTodoVersionConflict, usecase.ErrorTypePreconditionFailed),
+		},
+		{
+			name: "should change only the patched fields, keeping a past due date",
+			store: func() *todoUpdaterMock {
+				result := patched(func(t *domain.Todo) { t.Title = "feed the cat" })
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				m.On("Update", mock.Anything, result).Return(result, nil).Once()
+				return m
+			}(),
+			input:  todo.PatchInput{ID: "123", Title: str(" feed the cat ")},
+			result: todo.TodoOutputFromDomain(patched(func(t *domain.Todo) { t.Title = "feed the cat" })),
+			err:    nil,
+		},
+		{
+			name: "should clear the fields patched to their zero value",
+			store: func() *todoUpdaterMock {
+				result := patched(func(t *domain.Todo) {
+					t.Description = ""
+					t.Priority = domain.TodoPriorityNone
+					t.Tags = nil
+					t.Recurrence = nil
+					t.DueDate = nil
+				})
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				m.On("Update", mock.Anything, result).Return(result, nil).Once()
+				return m
+			}(),
+			input: todo.PatchInput{
+				ID:            "123",
+				Description:   str(""),
+				Priority:      new(domain.TodoPriority),
+				Tags:          new([]string),
+				Recurrence:    str(""),
+				RemoveDueDate: true,
+			},
+			result: todo.TodoOutputFromDomain(patched(func(t *domain.Todo) {
+				t.Description = ""
+				t.Priority = domain.TodoPriorityNone
+				t.Tags = nil
+				t.Recurrence = nil
+				t.DueDate = nil
+			})),
+			err: nil,
+		},
+		{
+			name: "should set a new due date",
+			store: func() *todoUpdaterMock {
+				result := patched(func(t *domain.Todo) { t.DueDate = &futureDueDate })
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				m.On("Update", mock.Anything, result).Return(result, nil).Once()
+				return m
+			}(),
+			input:  todo.PatchInput{ID: "123", DueDate: &futureDueDate},
+			result: todo.TodoOutputFromDomain(patched(func(t *domain.Todo) { t.DueDate = &futureDueDate })),
+			err:    nil,
+		},
+		{
+			name: "should fail when the new due date has passed",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				return m
+			}(),
+			input:  todo.PatchInput{ID: "123", DueDate: &pastDueDate},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo invalid input: due date must be in the future",
+				fmt.Errorf("%w: due date must be in the future", domain.ErrTodoInvalidInput),
+				usecase.ErrorTypeBadRequest),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDateUpdated).Maybe()
+			uc := todo.NewPatch(tc.store, newTransactorMock(), clock, newAuditStoreMock(), newEventPublisherMock())
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
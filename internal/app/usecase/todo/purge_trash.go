@@ -0,0 +1,77 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// TrashRetention is how long a todo stays in the trash before it is purged.
+type TrashRetention time.Duration
+
+type (
+	PurgeTrashStore interface {
+		// ListTrashedBefore returns the todos moved to the trash before deletedBefore
+		ListTrashedBefore(ctx context.Context, deletedBefore time.Time) ([]domain.Todo, error)
+		// DeleteByID removes a todo, whether it is in the trash or not, when it is still at one of versions
+		DeleteByID(ctx context.Context, id string, versions []int) (domain.Todo, error)
+	}
+	PurgeTrash interface {
+		Handle(context.Context) (int, error)
+	}
+	purgeTrash struct {
+		store      PurgeTrashStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+		retention  TrashRetention
+	}
+)
+
+func NewPurgeTrash(
+	store PurgeTrashStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher, retention TrashRetention,
+) *purgeTrash {
+	return &purgeTrash{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+		retention:  retention,
+	}
+}
+
+// Handle permanently deletes the todos kept in the trash longer than the retention, each one like
+// a permanent delete request, and returns how many were deleted. A todo restored or deleted since
+// it was listed is left alone.
+func (uc *purgeTrash) Handle(ctx context.Context) (int, error) {
+	now := uc.clock.Now()
+	before := now.Add(-time.Duration(uc.retention))
+	todos, err := uc.store.ListTrashedBefore(ctx, before)
+	if err != nil {
+		return 0, internalError("fail to list the trash to purge", err)
+	}
+	purged := 0
+	for _, todo := range todos {
+		input := DeleteByIDInput{ID: todo.ID, Versions: []int{todo.Version}, Permanent: true}
+		_, err := inPublishedTransaction(ctx, uc.transactor, uc.events, func(ctx context.Context) (TodoOutput, error) {
+			deleted, err := uc.store.DeleteByID(ctx, input.ID, input.Versions)
+			if err != nil {
+				return TodoOutput{}, deleteError(input, err)
+			}
+			return TodoOutput{}, recordAudit(ctx, uc.audit, domain.AuditOperationDelete, &deleted, nil, now)
+		})
+		if isChangedSince(err) {
+			continue
+		}
+		if err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
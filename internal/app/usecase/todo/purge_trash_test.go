@@ -0,0 +1,143 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestPurgeTrash_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-31")
+	old, _ := time.Parse(time.DateOnly, "2023-12-01")
+	retention := todo.TrashRetention(30 * 24 * time.Hour)
+	cutoff, _ := time.Parse(time.DateOnly, "2024-01-01")
+	expired := domain.Todo{ID: "1", DeletedAt: &old, Version: 2}
+	restored := domain.Todo{ID: "2", DeletedAt: &old, Version: 3}
+	testCases := []struct {
+		name   string
+		store  *purgeTrashStoreMock
+		clock  *clockMock
+		audit  *auditStoreMock
+		result int
+		err    error
+	}{
+		{
+			name: "should fail when store fails to list the trash",
+			store: func() *purgeTrashStoreMock {
+				m := new(purgeTrashStoreMock)
+				m.On("ListTrashedBefore", context.TODO(), cutoff).Return([]domain.Todo(nil), assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit:  new(auditStoreMock),
+			result: 0,
+			err: usecase.NewError("fail to list the trash to purge", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should fail when a todo cannot be deleted",
+			store: func() *purgeTrashStoreMock {
+				m := new(purgeTrashStoreMock)
+				m.On("ListTrashedBefore", context.TODO(), cutoff).Return([]domain.Todo{expired}, nil).Once()
+				m.On("DeleteByID", mock.Anything, "1", []int{expired.Version}).
+					Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit:  new(auditStoreMock),
+			result: 0,
+			err:    usecase.NewError("fail to delete a todo by id", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should purge the todos kept in the trash longer than the retention",
+			store: func() *purgeTrashStoreMock {
+				m := new(purgeTrashStoreMock)
+				m.On("ListTrashedBefore", context.TODO(), cutoff).Return([]domain.Todo{expired, restored}, nil).Once()
+				m.On("DeleteByID", mock.Anything, "1", []int{expired.Version}).Return(expired, nil).Once()
+				m.On("DeleteByID", mock.Anything, "2", []int{restored.Version}).
+					Return(domain.Todo{}, domain.ErrTodoVersionConflict).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDate).Once()
+				return m
+			}(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.TodoID == "1" && record.Operation == domain.AuditOperationDelete
+				})).Return(nil).Once()
+				return m
+			}(),
+			result: 1,
+			err:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewPurgeTrash(tc.store, newTransactorMock(), tc.clock, tc.audit, newEventPublisherMock(),
+				retention)
+			result, err := uc.Handle(context.TODO())
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+			tc.audit.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPurgeTrash_Handle_Events(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-31")
+	old, _ := time.Parse(time.DateOnly, "2023-12-01")
+	cutoff, _ := time.Parse(time.DateOnly, "2024-01-01")
+	expired := domain.Todo{ID: "1", Title: "old", DeletedAt: &old, Version: 2}
+	store := new(purgeTrashStoreMock)
+	store.On("ListTrashedBefore", context.TODO(), cutoff).Return([]domain.Todo{expired}, nil).Once()
+	store.On("DeleteByID", mock.Anything, "1", []int{expired.Version}).Return(expired, nil).Once()
+	clock := newClockMock()
+	clock.On("Now").Return(exampleDate).Once()
+	publisher := new(eventPublisherMock)
+	publisher.On("Publish", context.TODO(), domain.Event{
+		Type:       domain.EventTodoDeleted,
+		TodoID:     "1",
+		Todo:       expired,
+		Actor:      usecase.AnonymousActor,
+		OccurredAt: exampleDate,
+	}).Return().Once()
+	uc := todo.NewPurgeTrash(store, newTransactorMock(), clock, newAuditStoreMock(), publisher,
+		todo.TrashRetention(30*24*time.Hour))
+	purged, err := uc.Handle(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, purged)
+	publisher.AssertExpectations(t)
+}
+
+type purgeTrashStoreMock struct {
+	mock.Mock
+}
+
+func (m *purgeTrashStoreMock) ListTrashedBefore(ctx context.Context, deletedBefore time.Time) ([]domain.Todo, error) {
+	args := m.Called(ctx, deletedBefore)
+	return args.Get(0).([]domain.Todo), args.Error(1)
+}
+
+func (m *purgeTrashStoreMock) DeleteByID(ctx context.Context, id string, versions []int) (domain.Todo, error) {
+	args := m.Called(ctx, id, versions)
+	return args.Get(0).(domain.Todo), args.Error(1)
+}
@@ -0,0 +1,51 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	RemoveItemInput struct {
+		TodoID string
+		ItemID string
+	}
+	RemoveItemStore = TodoUpdater
+	RemoveItem      interface {
+		Handle(context.Context, RemoveItemInput) (TodoOutput, error)
+	}
+	removeItem struct {
+		store      RemoveItemStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewRemoveItem(
+	store RemoveItemStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *removeItem {
+	return &removeItem{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+func (uc *removeItem) Handle(ctx context.Context, input RemoveItemInput) (TodoOutput, error) {
+	return changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events, domain.AuditOperationUpdate,
+		input.TodoID, nil,
+		func(todo domain.Todo) (domain.Todo, error) {
+			todo, err := todo.RemoveItem(input.ItemID, uc.clock.Now())
+			if err != nil {
+				return domain.Todo{}, itemNotFoundError(input.ItemID, err)
+			}
+			return todo, nil
+		})
+}
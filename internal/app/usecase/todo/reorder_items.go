@@ -0,0 +1,51 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	ReorderItemsInput struct {
+		TodoID  string
+		ItemIDs []string
+	}
+	ReorderItemsStore = TodoUpdater
+	ReorderItems      interface {
+		Handle(context.Context, ReorderItemsInput) (TodoOutput, error)
+	}
+	reorderItems struct {
+		store      ReorderItemsStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewReorderItems(
+	store ReorderItemsStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *reorderItems {
+	return &reorderItems{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+func (uc *reorderItems) Handle(ctx context.Context, input ReorderItemsInput) (TodoOutput, error) {
+	return changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events, domain.AuditOperationUpdate,
+		input.TodoID, nil,
+		func(todo domain.Todo) (domain.Todo, error) {
+			todo, err := todo.ReorderItems(input.ItemIDs, uc.clock.Now())
+			if err != nil {
+				return domain.Todo{}, badRequestError(err.Error(), err)
+			}
+			return todo, nil
+		})
+}
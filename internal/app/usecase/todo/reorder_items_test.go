@@ -0,0 +1,91 @@
+package todo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestReorderItems_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	exampleTodo := domain.Todo{
+		ID:        "123",
+		Title:     "paint the room",
+		Status:    domain.TodoStatusPending,
+		Items:     []domain.ChecklistItem{{ID: "1", Title: "buy paint"}, {ID: "2", Title: "paint"}},
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDate,
+	}
+	reorderedTodo := domain.Todo{
+		ID:        "123",
+		Title:     "paint the room",
+		Status:    domain.TodoStatusPending,
+		Items:     []domain.ChecklistItem{{ID: "2", Title: "paint"}, {ID: "1", Title: "buy paint"}},
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDateUpdated,
+	}
+	testCases := []struct {
+		name   string
+		store  *todoUpdaterMock
+		input  todo.ReorderItemsInput
+		result todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should fail when get by id fails",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			input:  todo.ReorderItemsInput{TodoID: "123", ItemIDs: []string{"2", "1"}},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to get a todo by id",
+				assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should fail when ids are not every item",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				return m
+			}(),
+			input:  todo.ReorderItemsInput{TodoID: "123", ItemIDs: []string{"2"}},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo invalid input: item ids must list every checklist item exactly once",
+				fmt.Errorf("%w: item ids must list every checklist item exactly once", domain.ErrTodoInvalidInput),
+				usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should reorder the items",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				m.On("Update", mock.Anything, reorderedTodo).Return(reorderedTodo, nil).Once()
+				return m
+			}(),
+			input:  todo.ReorderItemsInput{TodoID: "123", ItemIDs: []string{"2", "1"}},
+			result: todo.TodoOutputFromDomain(reorderedTodo),
+			err:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDateUpdated).Maybe()
+			uc := todo.NewReorderItems(tc.store, newTransactorMock(), clock, newAuditStoreMock(), newEventPublisherMock())
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
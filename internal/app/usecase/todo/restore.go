@@ -0,0 +1,66 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	// RestoreStore restores todos from the trash. Restore returns the todo as it was in the trash,
+	// and a todo that is not there is not found, domain.ErrTodoNotFound being returned.
+	RestoreStore interface {
+		Restore(ctx context.Context, id string, date time.Time) (domain.Todo, error)
+		GetByID(context.Context, string) (domain.Todo, error)
+	}
+	Restore interface {
+		Handle(ctx context.Context, id string) (TodoOutput, error)
+	}
+	restore struct {
+		store      RestoreStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewRestore(
+	store RestoreStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *restore {
+	return &restore{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+// Handle takes the todo out of the trash as it was when deleted, updated now, and records it in
+// the audit log in a single unit of work.
+func (uc *restore) Handle(ctx context.Context, id string) (TodoOutput, error) {
+	now := uc.clock.Now()
+	return inPublishedTransaction(ctx, uc.transactor, uc.events, func(ctx context.Context) (TodoOutput, error) {
+		trashed, err := uc.store.Restore(ctx, id, now)
+		if err != nil {
+			if isNotFound(err) {
+				return TodoOutput{}, usecase.NewError(fmt.Sprintf("todo not found in the trash with id %s", id),
+					err, usecase.ErrorTypeNotFound)
+			}
+			return TodoOutput{}, internalError("fail to restore a todo from the trash", err)
+		}
+		todo, err := uc.store.GetByID(ctx, id)
+		if err != nil {
+			return TodoOutput{}, internalError("fail to get a todo by id", err)
+		}
+		if err := recordAudit(ctx, uc.audit, domain.AuditOperationRestore, &trashed, &todo, now); err != nil {
+			return TodoOutput{}, err
+		}
+		return TodoOutputFromDomain(todo), nil
+	})
+}
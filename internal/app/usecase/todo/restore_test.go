@@ -0,0 +1,173 @@
+package todo_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestRestore_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateRestored, _ := time.Parse(time.DateOnly, "2024-01-02")
+	trashed := domain.Todo{
+		ID:        "123",
+		Title:     "title",
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDate,
+		Version:   2,
+		DeletedAt: &exampleDate,
+	}
+	restored := domain.Todo{
+		ID:        "123",
+		Title:     "title",
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDateRestored,
+		Version:   3,
+	}
+	testCases := []struct {
+		name   string
+		store  *restoreStoreMock
+		audit  *auditStoreMock
+		ctx    context.Context
+		id     string
+		result todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should fail when todo is not in the trash",
+			store: func() *restoreStoreMock {
+				m := new(restoreStoreMock)
+				m.On("Restore", mock.Anything, "123", exampleDateRestored).
+					Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			audit:  new(auditStoreMock),
+			ctx:    context.TODO(),
+			id:     "123",
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo not found in the trash with id 123",
+				domain.ErrTodoNotFound, usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when store fails",
+			store: func() *restoreStoreMock {
+				m := new(restoreStoreMock)
+				m.On("Restore", mock.Anything, "123", exampleDateRestored).
+					Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			audit:  new(auditStoreMock),
+			ctx:    context.TODO(),
+			id:     "123",
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to restore a todo from the trash",
+				assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should fail when the audit log fails",
+			store: func() *restoreStoreMock {
+				m := new(restoreStoreMock)
+				m.On("Restore", mock.Anything, "123", exampleDateRestored).Return(trashed, nil).Once()
+				m.On("GetByID", mock.Anything, "123").Return(restored, nil).Once()
+				return m
+			}(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.Anything).Return(assert.AnError).Once()
+				return m
+			}(),
+			ctx:    context.TODO(),
+			id:     "123",
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to record a todo change in the audit log", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should restore a todo from the trash and record it in the audit log",
+			store: func() *restoreStoreMock {
+				m := new(restoreStoreMock)
+				m.On("Restore", mock.Anything, "123", exampleDateRestored).Return(trashed, nil).Once()
+				m.On("GetByID", mock.Anything, "123").Return(restored, nil).Once()
+				return m
+			}(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, domain.AuditRecord{
+					TodoID:    "123",
+					Actor:     "alice",
+					Operation: domain.AuditOperationRestore,
+					Diff: json.RawMessage(`{"deleted_at":{"before":"2024-01-01T00:00:00Z","after":null},` +
+						`"updated_at":{"before":"2024-01-01T00:00:00Z","after":"2024-01-02T00:00:00Z"},` +
+						`"version":{"before":2,"after":3}}`),
+					CreatedAt: exampleDateRestored,
+				}).Return(nil).Once()
+				return m
+			}(),
+			ctx: usecase.WithActor(context.TODO(), "alice"),
+			id:  "123",
+			result: todo.TodoOutput{
+				ID:        "123",
+				Title:     "title",
+				CreatedAt: exampleDate,
+				UpdatedAt: exampleDateRestored,
+				Version:   3,
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDateRestored).Once()
+			uc := todo.NewRestore(tc.store, newTransactorMock(), clock, tc.audit, newEventPublisherMock())
+			result, err := uc.Handle(tc.ctx, tc.id)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.audit.AssertExpectations(t)
+			clock.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should publish an updated event once the todo is restored", func(t *testing.T) {
+		store := new(restoreStoreMock)
+		store.On("Restore", mock.Anything, "123", exampleDateRestored).Return(trashed, nil).Once()
+		store.On("GetByID", mock.Anything, "123").Return(restored, nil).Once()
+		clock := newClockMock()
+		clock.On("Now").Return(exampleDateRestored).Once()
+		ctx := usecase.WithActor(context.TODO(), "alice")
+		publisher := new(eventPublisherMock)
+		publisher.On("Publish", ctx, domain.Event{
+			Type:       domain.EventTodoUpdated,
+			TodoID:     "123",
+			Todo:       restored,
+			Actor:      "alice",
+			OccurredAt: exampleDateRestored,
+		}).Return().Once()
+		uc := todo.NewRestore(store, newTransactorMock(), clock, newAuditStoreMock(), publisher)
+		_, err := uc.Handle(ctx, "123")
+		assert.Nil(t, err)
+		publisher.AssertExpectations(t)
+	})
+}
+
+type restoreStoreMock struct {
+	mock.Mock
+}
+
+func (m *restoreStoreMock) Restore(ctx context.Context, id string, date time.Time) (domain.Todo, error) {
+	args := m.Called(ctx, id, date)
+	return args.Get(0).(domain.Todo), args.Error(1)
+}
+
+func (m *restoreStoreMock) GetByID(ctx context.Context, id string) (domain.Todo, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Todo), args.Error(1)
+}
@@ -0,0 +1,84 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	SearchInput struct {
+		Query  string
+		Status *domain.TodoStatus
+		Limit  int
+	}
+	// SearchQuery is what the store receives to run a full-text search.
+	// A todo matches when its title or description contains a word starting with any
+	// of the terms, and the store returns the best matches first.
+	SearchQuery struct {
+		Terms  []string
+		Status *domain.TodoStatus
+		Limit  int
+	}
+	SearchStore interface {
+		Search(context.Context, SearchQuery) ([]domain.Todo, error)
+	}
+	Search interface {
+		Handle(context.Context, SearchInput) ([]TodoOutput, error)
+	}
+	search struct {
+		store    SearchStore
+		workflow domain.Workflow
+	}
+)
+
+func NewSearch(store SearchStore, workflow domain.Workflow) *search {
+	return &search{store: store, workflow: workflow}
+}
+
+func (uc *search) Handle(ctx context.Context, input SearchInput) ([]TodoOutput, error) {
+	terms := SearchTerms(input.Query)
+	if len(terms) == 0 {
+		return []TodoOutput{}, badRequestError("search query must contain at least one word", nil)
+	}
+	if err := validateStatusFilter(input.Status, uc.workflow); err != nil {
+		return []TodoOutput{}, err
+	}
+	if input.Limit < 0 || input.Limit > MaxListLimit {
+		return []TodoOutput{}, badRequestError(
+			fmt.Sprintf("limit must be between 1 and %d", MaxListLimit), nil)
+	}
+	limit := input.Limit
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	todos, err := uc.store.Search(ctx, SearchQuery{
+		Terms:  terms,
+		Status: input.Status,
+		Limit:  limit,
+	})
+	if err != nil {
+		return []TodoOutput{}, internalError("fail to search todos", err)
+	}
+	return TodoOutputsFromDomain(todos), nil
+}
+
+// SearchTerms splits a text into lowercase words made of letters and digits,
+// without duplicates and in order of appearance. Stores use it to tokenize
+// documents the same way search queries are tokenized.
+func SearchTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if !slices.Contains(terms, word) {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
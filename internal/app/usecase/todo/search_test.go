@@ -0,0 +1,146 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestSearch_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	pendingStatus := domain.TodoStatusPending
+	invalidStatus := domain.TodoStatus("done")
+
+	testCases := []struct {
+		name   string
+		store  *searchStoreMock
+		input  todo.SearchInput
+		result []todo.TodoOutput
+		err    error
+	}{
+		{
+			name:   "should fail when query has no words",
+			store:  new(searchStoreMock),
+			input:  todo.SearchInput{Query: " ?! "},
+			result: []todo.TodoOutput{},
+			err: usecase.NewError("search query must contain at least one word",
+				nil, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when limit is greater than the maximum",
+			store:  new(searchStoreMock),
+			input:  todo.SearchInput{Query: "milk", Limit: todo.MaxListLimit + 1},
+			result: []todo.TodoOutput{},
+			err: usecase.NewError("limit must be between 1 and 100",
+				nil, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:   "should fail when the status is not in the workflow",
+			store:  new(searchStoreMock),
+			input:  todo.SearchInput{Query: "milk", Status: &invalidStatus},
+			result: []todo.TodoOutput{},
+			err: usecase.NewError("invalid status: must be 'pending' or 'completed'",
+				nil, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when store fails",
+			store: func() *searchStoreMock {
+				m := new(searchStoreMock)
+				m.On("Search", context.TODO(), mock.Anything).
+					Return([]domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			input:  todo.SearchInput{Query: "milk"},
+			result: []todo.TodoOutput{},
+			err: usecase.NewError("fail to search todos",
+				assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should search the tokenized query with the default limit",
+			store: func() *searchStoreMock {
+				m := new(searchStoreMock)
+				m.On("Search", context.TODO(), todo.SearchQuery{
+					Terms: []string{"buy", "milk", "bread"},
+					Limit: todo.DefaultListLimit,
+				}).Return([]domain.Todo{
+					{
+						ID:        "123",
+						Title:     "Buy milk",
+						Status:    domain.TodoStatusPending,
+						CreatedAt: exampleDate,
+						UpdatedAt: exampleDate,
+					},
+				}, nil).Once()
+				return m
+			}(),
+			input: todo.SearchInput{Query: "Buy MILK, milk & bread!"},
+			result: []todo.TodoOutput{
+				{
+					ID:        "123",
+					Title:     "Buy milk",
+					Status:    "pending",
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDate,
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "should combine the search with the status filter",
+			store: func() *searchStoreMock {
+				m := new(searchStoreMock)
+				m.On("Search", context.TODO(), todo.SearchQuery{
+					Terms:  []string{"milk"},
+					Status: &pendingStatus,
+					Limit:  5,
+				}).Return([]domain.Todo{}, nil).Once()
+				return m
+			}(),
+			input:  todo.SearchInput{Query: "milk", Status: &pendingStatus, Limit: 5},
+			result: []todo.TodoOutput{},
+			err:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewSearch(tc.store, domain.DefaultWorkflow())
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSearchTerms(t *testing.T) {
+	testCases := []struct {
+		name   string
+		text   string
+		result []string
+	}{
+		{"should lowercase and split on punctuation", "Buy MILK, eggs & bread!", []string{"buy", "milk", "eggs", "bread"}},
+		{"should drop duplicated words", "milk Milk MILK", []string{"milk"}},
+		{"should keep digits and accented letters", "Café 2024", []string{"café", "2024"}},
+		{"should return no terms for blank text", "  -- ", []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.result, todo.SearchTerms(tc.text))
+		})
+	}
+}
+
+type searchStoreMock struct {
+	mock.Mock
+}
+
+func (m *searchStoreMock) Search(ctx context.Context, query todo.SearchQuery) ([]domain.Todo, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]domain.Todo), args.Error(1)
+}
@@ -0,0 +1,22 @@
+package todo_test
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type todoUpdaterMock struct {
+	mock.Mock
+}
+
+func (m *todoUpdaterMock) GetByID(ctx context.Context, id string) (domain.Todo, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Todo), args.Error(1)
+}
+
+func (m *todoUpdaterMock) Update(ctx context.Context, todo domain.Todo) (domain.Todo, error) {
+	args := m.Called(ctx, todo)
+	return args.Get(0).(domain.Todo), args.Error(1)
+}
@@ -0,0 +1,51 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	ToggleItemInput struct {
+		TodoID string
+		ItemID string
+	}
+	ToggleItemStore = TodoUpdater
+	ToggleItem      interface {
+		Handle(context.Context, ToggleItemInput) (TodoOutput, error)
+	}
+	toggleItem struct {
+		store      ToggleItemStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewToggleItem(
+	store ToggleItemStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *toggleItem {
+	return &toggleItem{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+func (uc *toggleItem) Handle(ctx context.Context, input ToggleItemInput) (TodoOutput, error) {
+	return changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events, domain.AuditOperationUpdate,
+		input.TodoID, nil,
+		func(todo domain.Todo) (domain.Todo, error) {
+			todo, err := todo.ToggleItem(input.ItemID, uc.clock.Now())
+			if err != nil {
+				return domain.Todo{}, itemNotFoundError(input.ItemID, err)
+			}
+			return todo, nil
+		})
+}
@@ -0,0 +1,89 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestToggleItem_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	exampleTodo := domain.Todo{
+		ID:        "123",
+		Title:     "paint the room",
+		Status:    domain.TodoStatusPending,
+		Items:     []domain.ChecklistItem{{ID: "1", Title: "buy paint"}},
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDate,
+	}
+	toggledTodo := domain.Todo{
+		ID:        "123",
+		Title:     "paint the room",
+		Status:    domain.TodoStatusPending,
+		Items:     []domain.ChecklistItem{{ID: "1", Title: "buy paint", Done: true}},
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDateUpdated,
+	}
+	testCases := []struct {
+		name   string
+		store  *todoUpdaterMock
+		input  todo.ToggleItemInput
+		result todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should fail when todo not found",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			input:  todo.ToggleItemInput{TodoID: "123", ItemID: "1"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo not found with id 123",
+				domain.ErrTodoNotFound, usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when item not found",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				return m
+			}(),
+			input:  todo.ToggleItemInput{TodoID: "123", ItemID: "2"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("checklist item not found with id 2",
+				domain.ErrChecklistItemNotFound, usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should toggle an item",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").Return(exampleTodo, nil).Once()
+				m.On("Update", mock.Anything, toggledTodo).Return(toggledTodo, nil).Once()
+				return m
+			}(),
+			input:  todo.ToggleItemInput{TodoID: "123", ItemID: "1"},
+			result: todo.TodoOutputFromDomain(toggledTodo),
+			err:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDateUpdated).Maybe()
+			uc := todo.NewToggleItem(tc.store, newTransactorMock(), clock, newAuditStoreMock(), newEventPublisherMock())
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
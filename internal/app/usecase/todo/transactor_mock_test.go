@@ -0,0 +1,28 @@
+package todo_test
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// transactorMock runs the work in place and returns its error, or the configured commit error
+// when the work succeeds.
+type transactorMock struct {
+	mock.Mock
+}
+
+// newTransactorMock returns a transactor that commits every unit of work it runs.
+func newTransactorMock() *transactorMock {
+	m := new(transactorMock)
+	m.On("Transaction", mock.Anything).Return(nil).Maybe()
+	return m
+}
+
+func (m *transactorMock) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	args := m.Called(ctx)
+	if err := fn(ctx); err != nil {
+		return err
+	}
+	return args.Error(0)
+}
@@ -0,0 +1,139 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// OpenItemsPolicy tells Transition what to do with the checklist items not done yet
+// when a todo is completed.
+type OpenItemsPolicy string
+
+const (
+	// OpenItemsAllow completes the todo leaving its open items as they are. It is the default.
+	OpenItemsAllow OpenItemsPolicy = "allow"
+	// OpenItemsRefuse fails to complete a todo with open items.
+	OpenItemsRefuse OpenItemsPolicy = "refuse"
+	// OpenItemsCascade marks the open items as done together with the todo.
+	OpenItemsCascade OpenItemsPolicy = "cascade"
+)
+
+// IsValid checks if the policy is one of the known policies.
+func (p OpenItemsPolicy) IsValid() bool {
+	return slices.Contains([]OpenItemsPolicy{OpenItemsAllow, OpenItemsRefuse, OpenItemsCascade}, p)
+}
+
+type (
+	TransitionInput struct {
+		ID     string
+		Status domain.TodoStatus
+		// OpenItems is only applied when the todo moves to completed
+		OpenItems OpenItemsPolicy
+		// Versions, when given, are the only versions the todo may still have
+		Versions []int
+	}
+	TransitionStore interface {
+		TodoUpdater
+		Create(context.Context, domain.Todo) (domain.Todo, error)
+	}
+	Transition interface {
+		Handle(context.Context, TransitionInput) (TodoOutput, error)
+	}
+	transition struct {
+		store      TransitionStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		workflow   domain.Workflow
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewTransition(
+	store TransitionStore, transactor usecase.Transactor, clock usecase.Clock, workflow domain.Workflow,
+	audit AuditStore, events usecase.EventPublisher,
+) *transition {
+	return &transition{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		workflow:   workflow,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+// Handle moves the todo to another status of the workflow. Completing a todo applies the
+// open items policy, and completing a recurring todo creates its next occurrence. Moves to
+// completed and pending are recorded in the audit log as complete and pending operations.
+func (uc *transition) Handle(ctx context.Context, input TransitionInput) (TodoOutput, error) {
+	if !uc.workflow.HasStatus(input.Status) {
+		return TodoOutput{}, badRequestError(
+			fmt.Sprintf("invalid status: must be %s", uc.workflow.DescribeStatuses()), nil)
+	}
+	policy := input.OpenItems
+	if policy == "" {
+		policy = OpenItemsAllow
+	}
+	if !policy.IsValid() {
+		return TodoOutput{}, badRequestError("invalid open_items: must be 'allow', 'refuse' or 'cascade'", nil)
+	}
+	completing := input.Status == domain.TodoStatusCompleted
+	// The completion and the creation of the next occurrence are a single unit of work
+	return inPublishedTransaction(ctx, uc.transactor, uc.events, func(ctx context.Context) (TodoOutput, error) {
+		var next domain.Todo
+		var recurs bool
+		output, err := changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events,
+			transitionOperation(input.Status), input.ID, input.Versions, func(todo domain.Todo) (domain.Todo, error) {
+				if open := todo.OpenItems(); completing && open > 0 && policy == OpenItemsRefuse {
+					return domain.Todo{}, conflictError(
+						fmt.Sprintf("cannot complete a todo with %d open checklist items", open), domain.ErrTodoHasOpenItems)
+				}
+				now := uc.clock.Now()
+				if completing {
+					if policy == OpenItemsCascade {
+						todo = todo.CompleteItems()
+					}
+					// Only the completion of a todo not completed yet spawns its next occurrence,
+					// so completing it again does not repeat it twice
+					if todo.Status != domain.TodoStatusCompleted {
+						next, recurs = todo.NextOccurrence(now)
+					}
+				}
+				return transitionTodo(todo, uc.workflow, input.Status, now)
+			})
+		if err != nil || !recurs {
+			return output, err
+		}
+		next, err = uc.store.Create(ctx, next)
+		if err != nil {
+			return TodoOutput{}, internalError("fail to create the next occurrence of a todo", err)
+		}
+		if err := recordAudit(ctx, uc.audit, domain.AuditOperationCreate, nil, &next, next.CreatedAt); err != nil {
+			return TodoOutput{}, err
+		}
+		return output, nil
+	})
+}
+
+// transitionTodo moves the todo to the status, failing with a conflict when the workflow
+// does not allow it.
+func transitionTodo(
+	todo domain.Todo, workflow domain.Workflow, status domain.TodoStatus, now time.Time,
+) (domain.Todo, error) {
+	changed, err := todo.Transition(workflow, status, now)
+	if errors.Is(err, domain.ErrTodoInvalidTransition) {
+		return domain.Todo{}, conflictError(
+			fmt.Sprintf("cannot move a todo from %s to %s", todo.Status, status), err)
+	}
+	if err != nil {
+		return domain.Todo{}, badRequestError(err.Error(), err)
+	}
+	return changed, nil
+}
@@ -0,0 +1,799 @@
+package todo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestTransition_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	exampleDueDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleNextDueDate, _ := time.Parse(time.DateOnly, "2024-01-08")
+	version := 2
+	recurringTodo := domain.Todo{
+		ID:         "123",
+		Title:      "water the plants",
+		Status:     domain.TodoStatusPending,
+		Priority:   domain.TodoPriorityNone,
+		Recurrence: &domain.Recurrence{Frequency: domain.RecurrenceWeekly, Interval: 1},
+		DueDate:    &exampleDueDate,
+		CreatedAt:  exampleDate,
+		UpdatedAt:  exampleDate,
+	}
+	completedRecurringTodo, _ := recurringTodo.Transition(domain.DefaultWorkflow(), domain.TodoStatusCompleted, exampleDateUpdated)
+	nextOccurrence := domain.Todo{
+		Title:      "water the plants",
+		Status:     domain.TodoStatusPending,
+		Priority:   domain.TodoPriorityNone,
+		Recurrence: &domain.Recurrence{Frequency: domain.RecurrenceWeekly, Interval: 1},
+		DueDate:    &exampleNextDueDate,
+		CreatedAt:  exampleDateUpdated,
+		UpdatedAt:  exampleDateUpdated,
+	}
+	workflow, _ := domain.NewWorkflow(
+		[]domain.TodoStatus{"pending", "in_progress", "blocked", "completed"},
+		map[domain.TodoStatus][]domain.TodoStatus{
+			"pending":     {"in_progress", "completed"},
+			"in_progress": {"pending", "blocked", "completed"},
+			"blocked":     {"in_progress"},
+			"completed":   {"pending"},
+		})
+	testCases := []struct {
+		name   string
+		store  *transitionStoreMock
+		clock  *clockMock
+		ctx    context.Context
+		input  todo.TransitionInput
+		result todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should fail when todo not found",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				return m
+			}(),
+			ctx: context.TODO(),
+			input: todo.TransitionInput{
+				ID:     "123",
+				Status: domain.TodoStatusCompleted,
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo not found with id 123",
+				domain.ErrTodoNotFound, usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when repository fails",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				return m
+			}(),
+			ctx: context.TODO(),
+			input: todo.TransitionInput{
+				ID:     "123",
+				Status: domain.TodoStatusCompleted,
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to get a todo by id",
+				assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should fail when update fails",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{
+						ID:          "123",
+						Title:       "example title",
+						Description: "example description",
+						Status:      domain.TodoStatusPending,
+						CreatedAt:   exampleDate,
+						UpdatedAt:   exampleDate,
+					}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:          "123",
+					Title:       "example title",
+					Description: "example description",
+					Status:      domain.TodoStatusCompleted,
+					CreatedAt:   exampleDate,
+					UpdatedAt:   exampleDateUpdated,
+					CompletedAt: &exampleDateUpdated,
+				}).Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx: context.TODO(),
+			input: todo.TransitionInput{
+				ID:     "123",
+				Status: domain.TodoStatusCompleted,
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to update a todo in the store", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should complete a todo",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{
+						ID:          "123",
+						Title:       "example title",
+						Description: "example description",
+						Status:      domain.TodoStatusPending,
+						CreatedAt:   exampleDate,
+						UpdatedAt:   exampleDate,
+					}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:          "123",
+					Title:       "example title",
+					Description: "example description",
+					Status:      domain.TodoStatusCompleted,
+					CreatedAt:   exampleDate,
+					UpdatedAt:   exampleDateUpdated,
+					CompletedAt: &exampleDateUpdated,
+				}).Return(domain.Todo{
+					ID:          "123",
+					Title:       "example title",
+					Description: "example description",
+					Status:      domain.TodoStatusCompleted,
+					CreatedAt:   exampleDate,
+					UpdatedAt:   exampleDateUpdated,
+					CompletedAt: &exampleDateUpdated,
+				}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx: context.TODO(),
+			input: todo.TransitionInput{
+				ID:     "123",
+				Status: domain.TodoStatusCompleted,
+			},
+			result: todo.TodoOutput{
+				ID:          "123",
+				Title:       "example title",
+				Description: "example description",
+				Status:      "completed",
+				CreatedAt:   exampleDate,
+				UpdatedAt:   exampleDateUpdated,
+				CompletedAt: &exampleDateUpdated,
+			},
+			err: nil,
+		},
+		{
+			name:  "should fail when open items policy is invalid",
+			store: new(transitionStoreMock),
+			clock: newClockMock(),
+			ctx:   context.TODO(),
+			input: todo.TransitionInput{
+				ID:        "123",
+				Status:    domain.TodoStatusCompleted,
+				OpenItems: "ignore",
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("invalid open_items: must be 'allow', 'refuse' or 'cascade'",
+				nil, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should refuse to complete a todo with open items",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{
+						ID:        "123",
+						Title:     "example title",
+						Status:    domain.TodoStatusPending,
+						Items:     []domain.ChecklistItem{{ID: "1", Title: "a"}, {ID: "2", Title: "b", Done: true}},
+						CreatedAt: exampleDate,
+						UpdatedAt: exampleDate,
+					}, nil).Once()
+				return m
+			}(),
+			clock: newClockMock(),
+			ctx:   context.TODO(),
+			input: todo.TransitionInput{
+				ID:        "123",
+				Status:    domain.TodoStatusCompleted,
+				OpenItems: todo.OpenItemsRefuse,
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("cannot complete a todo with 1 open checklist items",
+				domain.ErrTodoHasOpenItems, usecase.ErrorTypeConflict),
+		},
+		{
+			name: "should complete the open items together with the todo",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{
+						ID:        "123",
+						Title:     "example title",
+						Status:    domain.TodoStatusPending,
+						Items:     []domain.ChecklistItem{{ID: "1", Title: "a"}, {ID: "2", Title: "b", Done: true}},
+						CreatedAt: exampleDate,
+						UpdatedAt: exampleDate,
+					}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:          "123",
+					Title:       "example title",
+					Status:      domain.TodoStatusCompleted,
+					Items:       []domain.ChecklistItem{{ID: "1", Title: "a", Done: true}, {ID: "2", Title: "b", Done: true}},
+					CreatedAt:   exampleDate,
+					UpdatedAt:   exampleDateUpdated,
+					CompletedAt: &exampleDateUpdated,
+				}).Return(domain.Todo{
+					ID:          "123",
+					Title:       "example title",
+					Status:      domain.TodoStatusCompleted,
+					Items:       []domain.ChecklistItem{{ID: "1", Title: "a", Done: true}, {ID: "2", Title: "b", Done: true}},
+					CreatedAt:   exampleDate,
+					UpdatedAt:   exampleDateUpdated,
+					CompletedAt: &exampleDateUpdated,
+				}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx: context.TODO(),
+			input: todo.TransitionInput{
+				ID:        "123",
+				Status:    domain.TodoStatusCompleted,
+				OpenItems: todo.OpenItemsCascade,
+			},
+			result: todo.TodoOutput{
+				ID:          "123",
+				Title:       "example title",
+				Status:      "completed",
+				Items:       []todo.ChecklistItemOutput{{ID: "1", Title: "a", Done: true}, {ID: "2", Title: "b", Done: true}},
+				CreatedAt:   exampleDate,
+				UpdatedAt:   exampleDateUpdated,
+				CompletedAt: &exampleDateUpdated,
+			},
+			err: nil,
+		},
+		{
+			name: "should spawn the next occurrence of a recurring todo",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(recurringTodo, nil).Once()
+				m.On("Update", mock.Anything, completedRecurringTodo).Return(completedRecurringTodo, nil).Once()
+				m.On("Create", mock.Anything, nextOccurrence).Return(nextOccurrence, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx:    context.TODO(),
+			input:  todo.TransitionInput{ID: "123", Status: domain.TodoStatusCompleted},
+			result: todo.TodoOutputFromDomain(completedRecurringTodo),
+			err:    nil,
+		},
+		{
+			name: "should fail when the next occurrence cannot be created",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(recurringTodo, nil).Once()
+				m.On("Update", mock.Anything, completedRecurringTodo).Return(completedRecurringTodo, nil).Once()
+				m.On("Create", mock.Anything, nextOccurrence).Return(domain.Todo{}, assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx:    context.TODO(),
+			input:  todo.TransitionInput{ID: "123", Status: domain.TodoStatusCompleted},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to create the next occurrence of a todo", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should not spawn again when the recurring todo is already completed",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(completedRecurringTodo, nil).Once()
+				m.On("Update", mock.Anything, completedRecurringTodo).Return(completedRecurringTodo, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx:    context.TODO(),
+			input:  todo.TransitionInput{ID: "123", Status: domain.TodoStatusCompleted},
+			result: todo.TodoOutputFromDomain(completedRecurringTodo),
+			err:    nil,
+		},
+		{
+			name: "should move a todo to a status of the workflow",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Title: "example title", Status: domain.TodoStatusPending}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "in_progress",
+					UpdatedAt: exampleDateUpdated,
+				}).Return(domain.Todo{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "in_progress",
+					UpdatedAt: exampleDateUpdated,
+				}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx:   context.TODO(),
+			input: todo.TransitionInput{ID: "123", Status: "in_progress"},
+			result: todo.TodoOutput{
+				ID:        "123",
+				Title:     "example title",
+				Status:    "in_progress",
+				UpdatedAt: exampleDateUpdated,
+			},
+			err: nil,
+		},
+		{
+			name: "should not spawn the next occurrence when a recurring todo moves to another status",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").Return(recurringTodo, nil).Once()
+				inProgress := recurringTodo
+				inProgress.Status = "in_progress"
+				inProgress.UpdatedAt = exampleDateUpdated
+				m.On("Update", mock.Anything, inProgress).Return(inProgress, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx:   context.TODO(),
+			input: todo.TransitionInput{ID: "123", Status: "in_progress"},
+			result: func() todo.TodoOutput {
+				inProgress := recurringTodo
+				inProgress.Status = "in_progress"
+				inProgress.UpdatedAt = exampleDateUpdated
+				return todo.TodoOutputFromDomain(inProgress)
+			}(),
+			err: nil,
+		},
+		{
+			name: "should fail when the workflow does not allow the transition",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Title: "example title", Status: domain.TodoStatusPending}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx:    context.TODO(),
+			input:  todo.TransitionInput{ID: "123", Status: "blocked"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("cannot move a todo from pending to blocked",
+				fmt.Errorf("%w: cannot move a todo from pending to blocked", domain.ErrTodoInvalidTransition),
+				usecase.ErrorTypeConflict),
+		},
+		{
+			name:   "should fail when the status is not in the workflow",
+			store:  new(transitionStoreMock),
+			clock:  newClockMock(),
+			ctx:    context.TODO(),
+			input:  todo.TransitionInput{ID: "123", Status: "cancelled"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("invalid status: must be 'pending', 'in_progress', 'blocked' or 'completed'",
+				nil, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when the todo is not at the expected version",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Status: domain.TodoStatusCompleted, Version: 3}, nil).Once()
+				return m
+			}(),
+			clock: newClockMock(),
+			ctx:   context.TODO(),
+			input: todo.TransitionInput{
+				ID:       "123",
+				Status:   domain.TodoStatusPending,
+				Versions: []int{version},
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo has changed: expected version 2, current version is 3",
+				domain.ErrTodoVersionConflict, usecase.ErrorTypePreconditionFailed),
+		},
+		{
+			name: "should fail when the todo changes before it is saved at the expected version",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Status: domain.TodoStatusCompleted, Version: 2}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:        "123",
+					Status:    domain.TodoStatusPending,
+					UpdatedAt: exampleDateUpdated,
+					Version:   2,
+				}).Return(domain.Todo{}, domain.ErrTodoVersionConflict).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx: context.TODO(),
+			input: todo.TransitionInput{
+				ID:       "123",
+				Status:   domain.TodoStatusPending,
+				Versions: []int{version},
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo has changed: expected version 2",
+				domain.ErrTodoVersionConflict, usecase.ErrorTypePreconditionFailed),
+		},
+		{
+			name: "should fail with a conflict when the todo changes before it is saved",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Status: domain.TodoStatusCompleted, Version: 2}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:        "123",
+					Status:    domain.TodoStatusPending,
+					UpdatedAt: exampleDateUpdated,
+					Version:   2,
+				}).Return(domain.Todo{}, domain.ErrTodoVersionConflict).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx: context.TODO(),
+			input: todo.TransitionInput{
+				ID:     "123",
+				Status: domain.TodoStatusPending,
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo was changed by another request, retry",
+				domain.ErrTodoVersionConflict, usecase.ErrorTypeConflict),
+		},
+		{
+			name: "should mark a completed todo as pending",
+			store: func() *transitionStoreMock {
+				m := new(transitionStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{
+						ID:          "123",
+						Title:       "example title",
+						Description: "example description",
+						Status:      domain.TodoStatusCompleted,
+						CreatedAt:   exampleDate,
+						UpdatedAt:   exampleDate,
+					}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:          "123",
+					Title:       "example title",
+					Description: "example description",
+					Status:      domain.TodoStatusPending,
+					CreatedAt:   exampleDate,
+					UpdatedAt:   exampleDateUpdated,
+				}).Return(domain.Todo{
+					ID:          "123",
+					Title:       "example title",
+					Description: "example description",
+					Status:      domain.TodoStatusPending,
+					CreatedAt:   exampleDate,
+					UpdatedAt:   exampleDateUpdated,
+				}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			ctx: context.TODO(),
+			input: todo.TransitionInput{
+				ID:     "123",
+				Status: domain.TodoStatusPending,
+			},
+			result: todo.TodoOutput{
+				ID:          "123",
+				Title:       "example title",
+				Description: "example description",
+				Status:      "pending",
+				CreatedAt:   exampleDate,
+				UpdatedAt:   exampleDateUpdated,
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewTransition(tc.store, newTransactorMock(), tc.clock, workflow, newAuditStoreMock(),
+				newEventPublisherMock())
+			result, err := uc.Handle(tc.ctx, tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTransition_Handle_Audit(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	workflow, _ := domain.NewWorkflow(
+		[]domain.TodoStatus{"pending", "in_progress", "completed"},
+		map[domain.TodoStatus][]domain.TodoStatus{
+			"pending":     {"in_progress", "completed"},
+			"in_progress": {"pending", "completed"},
+			"completed":   {"pending"},
+		})
+	testCases := []struct {
+		name       string
+		status     domain.TodoStatus
+		recurrence *domain.Recurrence
+		audit      *auditStoreMock
+		err        error
+	}{
+		{
+			name:   "should record a move to completed as a complete operation",
+			status: domain.TodoStatusCompleted,
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.TodoID == "123" && record.Operation == domain.AuditOperationComplete &&
+						record.Actor == usecase.AnonymousActor && record.CreatedAt.Equal(exampleDateUpdated)
+				})).Return(nil).Once()
+				return m
+			}(),
+			err: nil,
+		},
+		{
+			name:   "should record a move to pending as a pending operation",
+			status: domain.TodoStatusPending,
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.Operation == domain.AuditOperationPending
+				})).Return(nil).Once()
+				return m
+			}(),
+			err: nil,
+		},
+		{
+			name:   "should record a move to another status as a transition operation",
+			status: "in_progress",
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.Operation == domain.AuditOperationTransition
+				})).Return(nil).Once()
+				return m
+			}(),
+			err: nil,
+		},
+		{
+			name:       "should record the creation of the next occurrence",
+			status:     domain.TodoStatusCompleted,
+			recurrence: &domain.Recurrence{Frequency: domain.RecurrenceDaily, Interval: 1},
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.Operation == domain.AuditOperationComplete
+				})).Return(nil).Once()
+				m.On("Record", mock.Anything, mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.TodoID == "456" && record.Operation == domain.AuditOperationCreate
+				})).Return(nil).Once()
+				return m
+			}(),
+			err: nil,
+		},
+		{
+			name:   "should fail when the audit log fails",
+			status: domain.TodoStatusCompleted,
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.Anything).Return(assert.AnError).Once()
+				return m
+			}(),
+			err: usecase.NewError("fail to record a todo change in the audit log", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stored := domain.Todo{
+				ID:         "123",
+				Title:      "example title",
+				Status:     domain.TodoStatusPending,
+				Recurrence: tc.recurrence,
+				CreatedAt:  exampleDate,
+				UpdatedAt:  exampleDate,
+			}
+			if tc.status == domain.TodoStatusPending {
+				stored.Status = domain.TodoStatusCompleted
+			}
+			store := new(transitionStoreMock)
+			store.On("GetByID", mock.Anything, "123").Return(stored, nil).Once()
+			store.On("Update", mock.Anything, mock.Anything).
+				Return(domain.Todo{ID: "123", Status: tc.status, UpdatedAt: exampleDateUpdated}, nil).Once()
+			if tc.recurrence != nil {
+				store.On("Create", mock.Anything, mock.Anything).Return(domain.Todo{ID: "456"}, nil).Once()
+			}
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDateUpdated).Once()
+			uc := todo.NewTransition(store, newTransactorMock(), clock, workflow, tc.audit, newEventPublisherMock())
+			_, err := uc.Handle(context.TODO(), todo.TransitionInput{ID: "123", Status: tc.status})
+			assert.Equal(t, tc.err, err)
+			store.AssertExpectations(t)
+			tc.audit.AssertExpectations(t)
+		})
+	}
+}
+
+type transitionStoreMock struct {
+	mock.Mock
+}
+
+func (m *transitionStoreMock) GetByID(ctx context.Context, id string) (domain.Todo, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Todo), args.Error(1)
+}
+
+func (m *transitionStoreMock) Update(ctx context.Context, todo domain.Todo) (domain.Todo, error) {
+	args := m.Called(ctx, todo)
+	return args.Get(0).(domain.Todo), args.Error(1)
+}
+
+func (m *transitionStoreMock) Create(ctx context.Context, todo domain.Todo) (domain.Todo, error) {
+	args := m.Called(ctx, todo)
+	return args.Get(0).(domain.Todo), args.Error(1)
+}
+
+func TestTransition_Handle_Events(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	workflow, _ := domain.NewWorkflow(
+		[]domain.TodoStatus{"pending", "in_progress", "completed"},
+		map[domain.TodoStatus][]domain.TodoStatus{
+			"pending":     {"in_progress", "completed"},
+			"in_progress": {"pending", "completed"},
+			"completed":   {"pending", "in_progress"},
+		})
+	testCases := []struct {
+		name       string
+		from       domain.TodoStatus
+		to         domain.TodoStatus
+		recurrence *domain.Recurrence
+		auditErr   error
+		events     []domain.EventType
+	}{
+		{
+			name:   "should publish a completed event when the todo is completed",
+			from:   domain.TodoStatusPending,
+			to:     domain.TodoStatusCompleted,
+			events: []domain.EventType{domain.EventTodoCompleted},
+		},
+		{
+			name:   "should publish a reopened event when a completed todo moves back to pending",
+			from:   domain.TodoStatusCompleted,
+			to:     domain.TodoStatusPending,
+			events: []domain.EventType{domain.EventTodoReopened},
+		},
+		{
+			name:   "should publish a reopened event when a completed todo moves to another status",
+			from:   domain.TodoStatusCompleted,
+			to:     "in_progress",
+			events: []domain.EventType{domain.EventTodoReopened},
+		},
+		{
+			name:   "should publish an updated event when the todo moves between open statuses",
+			from:   domain.TodoStatusPending,
+			to:     "in_progress",
+			events: []domain.EventType{domain.EventTodoUpdated},
+		},
+		{
+			name:       "should publish the creation of the next occurrence after the completion",
+			from:       domain.TodoStatusPending,
+			to:         domain.TodoStatusCompleted,
+			recurrence: &domain.Recurrence{Frequency: domain.RecurrenceDaily, Interval: 1},
+			events:     []domain.EventType{domain.EventTodoCompleted, domain.EventTodoCreated},
+		},
+		{
+			name:     "should publish nothing when the change is not committed",
+			from:     domain.TodoStatusPending,
+			to:       domain.TodoStatusCompleted,
+			auditErr: assert.AnError,
+			events:   nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(transitionStoreMock)
+			store.On("GetByID", mock.Anything, "123").Return(domain.Todo{
+				ID:         "123",
+				Title:      "example title",
+				Status:     tc.from,
+				Recurrence: tc.recurrence,
+				CreatedAt:  exampleDate,
+				UpdatedAt:  exampleDate,
+			}, nil).Once()
+			store.On("Update", mock.Anything, mock.Anything).
+				Return(domain.Todo{ID: "123", Status: tc.to, UpdatedAt: exampleDateUpdated}, nil).Once()
+			if tc.recurrence != nil {
+				store.On("Create", mock.Anything, mock.Anything).
+					Return(domain.Todo{ID: "456", CreatedAt: exampleDateUpdated}, nil).Once()
+			}
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDateUpdated).Once()
+			audit := new(auditStoreMock)
+			audit.On("Record", mock.Anything, mock.Anything).Return(tc.auditErr)
+			var published []domain.EventType
+			publisher := new(eventPublisherMock)
+			publisher.On("Publish", usecase.WithActor(context.TODO(), "alice"), mock.Anything).
+				Run(func(args mock.Arguments) {
+					event := args.Get(1).(domain.Event)
+					assert.Equal(t, "alice", event.Actor)
+					assert.Equal(t, exampleDateUpdated, event.OccurredAt)
+					assert.Equal(t, event.TodoID, event.Todo.ID)
+					published = append(published, event.Type)
+				}).Maybe()
+			uc := todo.NewTransition(store, newTransactorMock(), clock, workflow, audit, publisher)
+			_, err := uc.Handle(usecase.WithActor(context.TODO(), "alice"),
+				todo.TransitionInput{ID: "123", Status: tc.to})
+			assert.Equal(t, tc.auditErr != nil, err != nil)
+			assert.Equal(t, tc.events, published)
+		})
+	}
+}
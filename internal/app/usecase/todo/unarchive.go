@@ -0,0 +1,48 @@
+package todo
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	UnarchiveInput struct {
+		ID string
+		// Versions, when given, are the only versions the todo may still have
+		Versions []int
+	}
+	UnarchiveStore = TodoUpdater
+	Unarchive      interface {
+		Handle(context.Context, UnarchiveInput) (TodoOutput, error)
+	}
+	unarchive struct {
+		store      UnarchiveStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
+	}
+)
+
+func NewUnarchive(
+	store UnarchiveStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *unarchive {
+	return &unarchive{
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
+	}
+}
+
+func (uc *unarchive) Handle(ctx context.Context, input UnarchiveInput) (TodoOutput, error) {
+	return changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events, domain.AuditOperationUnarchive,
+		input.ID, input.Versions,
+		func(todo domain.Todo) (domain.Todo, error) {
+			return todo.Unarchive(uc.clock.Now()), nil
+		})
+}
@@ -0,0 +1,118 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestUnarchive_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	testCases := []struct {
+		name   string
+		store  *todoUpdaterMock
+		clock  *clockMock
+		input  todo.UnarchiveInput
+		result todo.TodoOutput
+		err    error
+	}{
+		{
+			name: "should fail when todo not found",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
+				return m
+			}(),
+			clock:  newClockMock(),
+			input:  todo.UnarchiveInput{ID: "123"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo not found with id 123",
+				domain.ErrTodoNotFound, usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail with a conflict when the todo changes before it is saved",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", ArchivedAt: &exampleDate, Version: 2}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{ID: "123", UpdatedAt: exampleDateUpdated, Version: 2}).
+					Return(domain.Todo{}, domain.ErrTodoVersionConflict).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			input:  todo.UnarchiveInput{ID: "123"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo was changed by another request, retry",
+				domain.ErrTodoVersionConflict, usecase.ErrorTypeConflict),
+		},
+		{
+			name: "should unarchive a todo",
+			store: func() *todoUpdaterMock {
+				m := new(todoUpdaterMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{
+						ID:         "123",
+						Title:      "example title",
+						Status:     domain.TodoStatusCompleted,
+						CreatedAt:  exampleDate,
+						UpdatedAt:  exampleDate,
+						ArchivedAt: &exampleDate,
+						Version:    2,
+					}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:        "123",
+					Title:     "example title",
+					Status:    domain.TodoStatusCompleted,
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDateUpdated,
+					Version:   2,
+				}).Return(domain.Todo{
+					ID:        "123",
+					Title:     "example title",
+					Status:    domain.TodoStatusCompleted,
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDateUpdated,
+					Version:   3,
+				}, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			input: todo.UnarchiveInput{ID: "123"},
+			result: todo.TodoOutput{
+				ID:        "123",
+				Title:     "example title",
+				Status:    "completed",
+				CreatedAt: exampleDate,
+				UpdatedAt: exampleDateUpdated,
+				Version:   3,
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := todo.NewUnarchive(tc.store, newTransactorMock(), tc.clock, newAuditStoreMock(), newEventPublisherMock())
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
 type (
@@ -12,43 +13,50 @@ type (
 		ID          string
 		Title       string
 		Description string
+		Priority    domain.TodoPriority
+		Tags        []string
+		Recurrence  string
 		DueDate     *time.Time
+		// Versions, when given, are the only versions the todo may still have
+		Versions []int
 	}
 	UpdateStore = TodoUpdater
 	Update      interface {
 		Handle(context.Context, UpdateInput) (TodoOutput, error)
 	}
 	update struct {
-		store UpdateStore
-		clock usecase.Clock
+		store      UpdateStore
+		transactor usecase.Transactor
+		clock      usecase.Clock
+		audit      AuditStore
+		events     usecase.EventPublisher
 	}
 )
 
-func NewUpdate(store UpdateStore, clock usecase.Clock) *update {
+func NewUpdate(
+	store UpdateStore, transactor usecase.Transactor, clock usecase.Clock, audit AuditStore,
+	events usecase.EventPublisher,
+) *update {
 	return &update{
-		store: store,
-		clock: clock,
+		store:      store,
+		transactor: transactor,
+		clock:      clock,
+		audit:      audit,
+		events:     events,
 	}
 }
 
 func (uc *update) Handle(ctx context.Context, input UpdateInput) (TodoOutput, error) {
-	todo, err := uc.store.GetByID(ctx, input.ID)
-	if err != nil {
-		if isNotFound(err) {
-			return TodoOutput{}, notFoundError(input.ID, err)
-		}
-		return TodoOutput{}, internalError("fail to get a todo by id", err)
-	}
-	todo, err = todo.Update(input.Title, input.Description, uc.clock.Now(), input.DueDate)
-	if err != nil {
-		return TodoOutput{}, badRequestError(err.Error(), err)
-	}
-	todo, err = uc.store.Update(ctx, todo)
-	if err != nil {
-		if isNotFound(err) {
-			return TodoOutput{}, notFoundError(input.ID, err)
-		}
-		return TodoOutput{}, internalError("fail to update a todo in the store", err)
-	}
-	return TodoOutputFromDomain(todo), nil
+	return changeAuditedTodo(ctx, uc.store, uc.transactor, uc.audit, uc.events, domain.AuditOperationUpdate,
+		input.ID, input.Versions,
+		func(todo domain.Todo) (domain.Todo, error) {
+			todo, err := todo.Update(input.Title, input.Description, uc.clock.Now(), input.DueDate)
+			if err == nil {
+				todo, err = withAttributes(todo, input.Priority, input.Tags, input.Recurrence)
+			}
+			if err != nil {
+				return domain.Todo{}, badRequestError(err.Error(), err)
+			}
+			return todo, nil
+		})
 }
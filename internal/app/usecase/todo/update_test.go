@@ -2,6 +2,7 @@ package todo_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -16,10 +17,13 @@ import (
 func TestUpdate_Handle(t *testing.T) {
 	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
 	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	staleVersion := 3
 	testCases := []struct {
 		name        string
 		updateStore *updateStoreMock
+		transactor  *transactorMock
 		clock       *clockMock
+		audit       *auditStoreMock
 		ctx         context.Context
 		input       todo.UpdateInput
 		result      todo.TodoOutput
@@ -29,12 +33,14 @@ func TestUpdate_Handle(t *testing.T) {
 			name: "should fail when todo not found",
 			updateStore: func() *updateStoreMock {
 				m := new(updateStoreMock)
-				m.On("GetByID", context.TODO(), "123").
+				m.On("GetByID", mock.Anything, "123").
 					Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
 				return m
 			}(),
-			clock: newClockMock(),
-			ctx:   context.TODO(),
+			transactor: newTransactorMock(),
+			clock:      newClockMock(),
+			audit:      newAuditStoreMock(),
+			ctx:        context.TODO(),
 			input: todo.UpdateInput{
 				ID:          "123",
 				Title:       "example title updated",
@@ -48,12 +54,14 @@ func TestUpdate_Handle(t *testing.T) {
 			name: "should fail when get by id fails",
 			updateStore: func() *updateStoreMock {
 				m := new(updateStoreMock)
-				m.On("GetByID", context.TODO(), "123").
+				m.On("GetByID", mock.Anything, "123").
 					Return(domain.Todo{}, assert.AnError).Once()
 				return m
 			}(),
-			clock: newClockMock(),
-			ctx:   context.TODO(),
+			transactor: newTransactorMock(),
+			clock:      newClockMock(),
+			audit:      newAuditStoreMock(),
+			ctx:        context.TODO(),
 			input: todo.UpdateInput{
 				ID:          "123",
 				Title:       "example title updated",
@@ -63,11 +71,131 @@ func TestUpdate_Handle(t *testing.T) {
 			err: usecase.NewError("fail to get a todo by id",
 				assert.AnError, usecase.ErrorTypeInternalError),
 		},
+		{
+			name: "should fail when the todo is not at the expected version",
+			updateStore: func() *updateStoreMock {
+				m := new(updateStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Title: "example title", Version: 4}, nil).Once()
+				return m
+			}(),
+			transactor: newTransactorMock(),
+			clock:      newClockMock(),
+			audit:      newAuditStoreMock(),
+			ctx:        context.TODO(),
+			input: todo.UpdateInput{
+				ID:       "123",
+				Title:    "example title updated",
+				Versions: []int{staleVersion},
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo has changed: expected version 3, current version is 4",
+				domain.ErrTodoVersionConflict, usecase.ErrorTypePreconditionFailed),
+		},
+		{
+			name: "should fail when the todo is at none of the expected versions",
+			updateStore: func() *updateStoreMock {
+				m := new(updateStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Title: "example title", Version: 4}, nil).Once()
+				return m
+			}(),
+			transactor: newTransactorMock(),
+			clock:      newClockMock(),
+			audit:      newAuditStoreMock(),
+			ctx:        context.TODO(),
+			input: todo.UpdateInput{
+				ID:       "123",
+				Title:    "example title updated",
+				Versions: []int{2, staleVersion},
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("todo has changed: expected version 2 or 3, current version is 4",
+				domain.ErrTodoVersionConflict, usecase.ErrorTypePreconditionFailed),
+		},
+		{
+			name: "should update a todo at one of the expected versions",
+			updateStore: func() *updateStoreMock {
+				m := new(updateStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Title: "example title", Status: domain.TodoStatusPending, Version: 4}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:        "123",
+					Title:     "example title updated",
+					Status:    domain.TodoStatusPending,
+					Priority:  domain.TodoPriorityNone,
+					UpdatedAt: exampleDateUpdated,
+					Version:   4,
+				}).Return(domain.Todo{
+					ID:        "123",
+					Title:     "example title updated",
+					Status:    domain.TodoStatusPending,
+					Priority:  domain.TodoPriorityNone,
+					UpdatedAt: exampleDateUpdated,
+					Version:   5,
+				}, nil).Once()
+				return m
+			}(),
+			transactor: newTransactorMock(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			audit: newAuditStoreMock(),
+			ctx:   context.TODO(),
+			input: todo.UpdateInput{
+				ID:       "123",
+				Title:    "example title updated",
+				Versions: []int{staleVersion, 4},
+			},
+			result: todo.TodoOutput{
+				ID:        "123",
+				Title:     "example title updated",
+				Status:    "pending",
+				Priority:  "none",
+				UpdatedAt: exampleDateUpdated,
+				Version:   5,
+			},
+			err: nil,
+		},
+		{
+			name: "should fail when the change cannot be committed",
+			updateStore: func() *updateStoreMock {
+				m := new(updateStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Title: "example title", Status: domain.TodoStatusPending}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:        "123",
+					Title:     "example title updated",
+					Status:    domain.TodoStatusPending,
+					Priority:  domain.TodoPriorityNone,
+					UpdatedAt: exampleDateUpdated,
+				}).Return(domain.Todo{ID: "123", Title: "example title updated"}, nil).Once()
+				return m
+			}(),
+			transactor: func() *transactorMock {
+				m := new(transactorMock)
+				m.On("Transaction", mock.Anything).Return(assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			audit:  newAuditStoreMock(),
+			ctx:    context.TODO(),
+			input:  todo.UpdateInput{ID: "123", Title: "example title updated"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to commit a todo change", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
 		{
 			name: "should fail when input is invalid",
 			updateStore: func() *updateStoreMock {
 				m := new(updateStoreMock)
-				m.On("GetByID", context.TODO(), "123").
+				m.On("GetByID", mock.Anything, "123").
 					Return(domain.Todo{
 						ID:          "123",
 						Title:       "example title",
@@ -78,12 +206,14 @@ func TestUpdate_Handle(t *testing.T) {
 					}, nil).Once()
 				return m
 			}(),
+			transactor: newTransactorMock(),
 			clock: func() *clockMock {
 				m := newClockMock()
 				m.On("Now").Return(exampleDateUpdated).Once()
 				return m
 			}(),
-			ctx: context.TODO(),
+			audit: newAuditStoreMock(),
+			ctx:   context.TODO(),
 			input: todo.UpdateInput{
 				ID:          "123",
 				Title:       "",
@@ -93,11 +223,43 @@ func TestUpdate_Handle(t *testing.T) {
 			err: usecase.NewError(fmt.Errorf("%w: title", domain.ErrTodoInvalidInput).Error(),
 				fmt.Errorf("%w: title", domain.ErrTodoInvalidInput), usecase.ErrorTypeBadRequest),
 		},
+		{
+			name: "should fail when priority is invalid",
+			updateStore: func() *updateStoreMock {
+				m := new(updateStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{
+						ID:        "123",
+						Title:     "example title",
+						Status:    domain.TodoStatusPending,
+						Priority:  domain.TodoPriorityNone,
+						CreatedAt: exampleDate,
+						UpdatedAt: exampleDate,
+					}, nil).Once()
+				return m
+			}(),
+			transactor: newTransactorMock(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			audit: newAuditStoreMock(),
+			ctx:   context.TODO(),
+			input: todo.UpdateInput{
+				ID:       "123",
+				Title:    "example title updated",
+				Priority: domain.TodoPriority("invalid"),
+			},
+			result: todo.TodoOutput{},
+			err: usecase.NewError(fmt.Errorf("%w: priority", domain.ErrTodoInvalidInput).Error(),
+				fmt.Errorf("%w: priority", domain.ErrTodoInvalidInput), usecase.ErrorTypeBadRequest),
+		},
 		{
 			name: "should fail when update todo not found",
 			updateStore: func() *updateStoreMock {
 				m := new(updateStoreMock)
-				m.On("GetByID", context.TODO(), "123").
+				m.On("GetByID", mock.Anything, "123").
 					Return(domain.Todo{
 						ID:          "123",
 						Title:       "example title",
@@ -106,22 +268,25 @@ func TestUpdate_Handle(t *testing.T) {
 						CreatedAt:   exampleDate,
 						UpdatedAt:   exampleDate,
 					}, nil).Once()
-				m.On("Update", context.TODO(), domain.Todo{
+				m.On("Update", mock.Anything, domain.Todo{
 					ID:          "123",
 					Title:       "example title updated",
 					Status:      domain.TodoStatusPending,
+					Priority:    domain.TodoPriorityNone,
 					Description: "example description updated",
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDateUpdated,
 				}).Return(domain.Todo{}, domain.ErrTodoNotFound).Once()
 				return m
 			}(),
+			transactor: newTransactorMock(),
 			clock: func() *clockMock {
 				m := newClockMock()
 				m.On("Now").Return(exampleDateUpdated).Once()
 				return m
 			}(),
-			ctx: context.TODO(),
+			audit: newAuditStoreMock(),
+			ctx:   context.TODO(),
 			input: todo.UpdateInput{
 				ID:          "123",
 				Title:       "example title updated",
@@ -135,7 +300,7 @@ func TestUpdate_Handle(t *testing.T) {
 			name: "should fail when update store fails",
 			updateStore: func() *updateStoreMock {
 				m := new(updateStoreMock)
-				m.On("GetByID", context.TODO(), "123").
+				m.On("GetByID", mock.Anything, "123").
 					Return(domain.Todo{
 						ID:          "123",
 						Title:       "example title",
@@ -144,22 +309,25 @@ func TestUpdate_Handle(t *testing.T) {
 						CreatedAt:   exampleDate,
 						UpdatedAt:   exampleDate,
 					}, nil).Once()
-				m.On("Update", context.TODO(), domain.Todo{
+				m.On("Update", mock.Anything, domain.Todo{
 					ID:          "123",
 					Title:       "example title updated",
 					Description: "example description updated",
 					Status:      domain.TodoStatusPending,
+					Priority:    domain.TodoPriorityNone,
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDateUpdated,
 				}).Return(domain.Todo{}, assert.AnError).Once()
 				return m
 			}(),
+			transactor: newTransactorMock(),
 			clock: func() *clockMock {
 				m := newClockMock()
 				m.On("Now").Return(exampleDateUpdated).Once()
 				return m
 			}(),
-			ctx: context.TODO(),
+			audit: newAuditStoreMock(),
+			ctx:   context.TODO(),
 			input: todo.UpdateInput{
 				ID:          "123",
 				Title:       "example title updated",
@@ -169,11 +337,38 @@ func TestUpdate_Handle(t *testing.T) {
 			err: usecase.NewError("fail to update a todo in the store", assert.AnError,
 				usecase.ErrorTypeInternalError),
 		},
+		{
+			name: "should fail when the audit log fails",
+			updateStore: func() *updateStoreMock {
+				m := new(updateStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{ID: "123", Title: "example title", Status: domain.TodoStatusPending}, nil).Once()
+				m.On("Update", mock.Anything, mock.Anything).
+					Return(domain.Todo{ID: "123", Title: "example title updated", Status: domain.TodoStatusPending}, nil).Once()
+				return m
+			}(),
+			transactor: newTransactorMock(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, mock.Anything).Return(assert.AnError).Once()
+				return m
+			}(),
+			ctx:    context.TODO(),
+			input:  todo.UpdateInput{ID: "123", Title: "example title updated"},
+			result: todo.TodoOutput{},
+			err: usecase.NewError("fail to record a todo change in the audit log", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
 		{
 			name: "should update todo",
 			updateStore: func() *updateStoreMock {
 				m := new(updateStoreMock)
-				m.On("GetByID", context.TODO(), "123").
+				m.On("GetByID", mock.Anything, "123").
 					Return(domain.Todo{
 						ID:          "123",
 						Title:       "example title",
@@ -182,11 +377,13 @@ func TestUpdate_Handle(t *testing.T) {
 						CreatedAt:   exampleDate,
 						UpdatedAt:   exampleDate,
 					}, nil).Once()
-				m.On("Update", context.TODO(), domain.Todo{
+				m.On("Update", mock.Anything, domain.Todo{
 					ID:          "123",
 					Title:       "example title updated",
 					Description: "example description updated",
 					Status:      domain.TodoStatusPending,
+					Priority:    domain.TodoPriorityUrgent,
+					Tags:        []string{"home", "work"},
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDateUpdated,
 				}).Return(domain.Todo{
@@ -194,41 +391,121 @@ func TestUpdate_Handle(t *testing.T) {
 					Title:       "example title updated",
 					Description: "example description updated",
 					Status:      domain.TodoStatusPending,
+					Priority:    domain.TodoPriorityUrgent,
+					Tags:        []string{"home", "work"},
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDateUpdated,
 				}, nil).Once()
 				return m
 			}(),
+			transactor: newTransactorMock(),
 			clock: func() *clockMock {
 				m := newClockMock()
 				m.On("Now").Return(exampleDateUpdated).Once()
 				return m
 			}(),
-			ctx: context.TODO(),
+			audit: func() *auditStoreMock {
+				m := new(auditStoreMock)
+				m.On("Record", mock.Anything, domain.AuditRecord{
+					TodoID:    "123",
+					Actor:     "alice",
+					Operation: domain.AuditOperationUpdate,
+					Diff: json.RawMessage(`{"description":{"before":"example description","after":"example description updated"},` +
+						`"priority":{"before":"","after":"urgent"},"tags":{"before":[],"after":["home","work"]},` +
+						`"title":{"before":"example title","after":"example title updated"},` +
+						`"updated_at":{"before":"2024-01-01T00:00:00Z","after":"2024-01-02T00:00:00Z"}}`),
+					CreatedAt: exampleDateUpdated,
+				}).Return(nil).Once()
+				return m
+			}(),
+			ctx: usecase.WithActor(context.TODO(), "alice"),
 			input: todo.UpdateInput{
 				ID:          "123",
 				Title:       "example title updated",
 				Description: "example description updated",
+				Priority:    domain.TodoPriorityUrgent,
+				Tags:        []string{"Work", "home"},
 			},
 			result: todo.TodoOutput{
 				ID:          "123",
 				Title:       "example title updated",
 				Description: "example description updated",
 				Status:      "pending",
+				Priority:    "urgent",
+				Tags:        []string{"home", "work"},
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDateUpdated,
 			},
 			err: nil,
 		},
+		{
+			name: "should replace the recurrence",
+			updateStore: func() *updateStoreMock {
+				m := new(updateStoreMock)
+				m.On("GetByID", mock.Anything, "123").
+					Return(domain.Todo{
+						ID:         "123",
+						Title:      "example title",
+						Status:     domain.TodoStatusPending,
+						Priority:   domain.TodoPriorityNone,
+						Recurrence: &domain.Recurrence{Frequency: domain.RecurrenceDaily, Interval: 1},
+						CreatedAt:  exampleDate,
+						UpdatedAt:  exampleDate,
+					}, nil).Once()
+				m.On("Update", mock.Anything, domain.Todo{
+					ID:         "123",
+					Title:      "example title",
+					Status:     domain.TodoStatusPending,
+					Priority:   domain.TodoPriorityNone,
+					Recurrence: &domain.Recurrence{Frequency: domain.RecurrenceMonthly, Interval: 2},
+					CreatedAt:  exampleDate,
+					UpdatedAt:  exampleDateUpdated,
+				}).Return(domain.Todo{
+					ID:         "123",
+					Title:      "example title",
+					Status:     domain.TodoStatusPending,
+					Priority:   domain.TodoPriorityNone,
+					Recurrence: &domain.Recurrence{Frequency: domain.RecurrenceMonthly, Interval: 2},
+					CreatedAt:  exampleDate,
+					UpdatedAt:  exampleDateUpdated,
+				}, nil).Once()
+				return m
+			}(),
+			transactor: newTransactorMock(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(exampleDateUpdated).Once()
+				return m
+			}(),
+			audit: newAuditStoreMock(),
+			ctx:   context.TODO(),
+			input: todo.UpdateInput{
+				ID:         "123",
+				Title:      "example title",
+				Recurrence: "FREQ=MONTHLY;INTERVAL=2",
+			},
+			result: todo.TodoOutput{
+				ID:         "123",
+				Title:      "example title",
+				Status:     "pending",
+				Priority:   "none",
+				Recurrence: "FREQ=MONTHLY;INTERVAL=2",
+				CreatedAt:  exampleDate,
+				UpdatedAt:  exampleDateUpdated,
+			},
+			err: nil,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			uc := todo.NewUpdate(tc.updateStore, tc.clock)
+			uc := todo.NewUpdate(tc.updateStore, tc.transactor, tc.clock, tc.audit, newEventPublisherMock())
 			result, err := uc.Handle(tc.ctx, tc.input)
 			assert.Equal(t, tc.result, result)
 			assert.Equal(t, tc.err, err)
 			tc.updateStore.AssertExpectations(t)
+			tc.transactor.AssertExpectations(t)
 			tc.clock.AssertExpectations(t)
+			tc.audit.AssertExpectations(t)
 		})
 	}
 }
@@ -2,7 +2,12 @@ package todo
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
 	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
@@ -10,3 +15,119 @@ type TodoUpdater interface {
 	GetByID(context.Context, string) (domain.Todo, error)
 	Update(context.Context, domain.Todo) (domain.Todo, error)
 }
+
+// changeAuditedTodo gets the todo with the id, applies change to it and saves the result in a single
+// unit of work of transactor, so no other change of the todo can happen in between. The change is
+// recorded in the audit log as operation in the same unit of work, at the date the todo is updated,
+// and its domain event is published once committed.
+// When versions are given the todo must still be at one of them, as with an If-Match request.
+// change must return usecase errors, which are returned as they are.
+func changeAuditedTodo(
+	ctx context.Context,
+	store TodoUpdater,
+	transactor usecase.Transactor,
+	audit AuditStore,
+	events usecase.EventPublisher,
+	operation domain.AuditOperation,
+	id string,
+	versions []int,
+	change func(domain.Todo) (domain.Todo, error),
+) (TodoOutput, error) {
+	return inPublishedTransaction(ctx, transactor, events, func(ctx context.Context) (TodoOutput, error) {
+		before, after, err := updateTodo(ctx, store, id, versions, change)
+		if err != nil {
+			return TodoOutput{}, err
+		}
+		if err := recordAudit(ctx, audit, operation, &before, &after, after.UpdatedAt); err != nil {
+			return TodoOutput{}, err
+		}
+		return TodoOutputFromDomain(after), nil
+	})
+}
+
+// updateTodo gets the todo with the id, applies change to it and saves the result, returning
+// the todo before and after the change. It is meant to run inside a unit of work.
+func updateTodo(
+	ctx context.Context,
+	store TodoUpdater,
+	id string,
+	versions []int,
+	change func(domain.Todo) (domain.Todo, error),
+) (domain.Todo, domain.Todo, error) {
+	before, err := store.GetByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			return domain.Todo{}, domain.Todo{}, notFoundError(id, err)
+		}
+		return domain.Todo{}, domain.Todo{}, internalError("fail to get a todo by id", err)
+	}
+	if err := checkVersion(before, versions); err != nil {
+		return domain.Todo{}, domain.Todo{}, err
+	}
+	after, err := change(before)
+	if err != nil {
+		return domain.Todo{}, domain.Todo{}, err
+	}
+	after, err = store.Update(ctx, after)
+	if err != nil {
+		return domain.Todo{}, domain.Todo{}, updateError(id, versions, err)
+	}
+	return before, after, nil
+}
+
+// inTransaction runs work in a transaction of transactor. The usecase errors of work are
+// returned as they are and any other error means the transaction could not be committed.
+func inTransaction(
+	ctx context.Context,
+	transactor usecase.Transactor,
+	work func(context.Context) (TodoOutput, error),
+) (TodoOutput, error) {
+	var output TodoOutput
+	err := transactor.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		output, err = work(ctx)
+		return err
+	})
+	if err != nil {
+		if _, ok := err.(usecase.Error); ok {
+			return TodoOutput{}, err
+		}
+		return TodoOutput{}, internalError("fail to commit a todo change", err)
+	}
+	return output, nil
+}
+
+// checkVersion fails with a precondition error when versions are expected and the todo is at none of them.
+func checkVersion(todo domain.Todo, versions []int) error {
+	if len(versions) == 0 || slices.Contains(versions, todo.Version) {
+		return nil
+	}
+	return preconditionFailedError(
+		fmt.Sprintf("todo has changed: expected version %s, current version is %d", formatVersions(versions), todo.Version),
+		domain.ErrTodoVersionConflict)
+}
+
+// formatVersions lists the expected versions in an error message, such as "3" or "3 or 4".
+func formatVersions(versions []int) string {
+	texts := make([]string, len(versions))
+	for i, version := range versions {
+		texts[i] = strconv.Itoa(version)
+	}
+	return strings.Join(texts, " or ")
+}
+
+// updateError converts an error of TodoUpdater.Update. A version conflict means the todo was
+// changed by someone else after it was read, which fails the precondition when versions were
+// expected and is a conflict otherwise.
+func updateError(id string, versions []int, err error) error {
+	switch {
+	case isNotFound(err):
+		return notFoundError(id, err)
+	case isVersionConflict(err) && len(versions) > 0:
+		return preconditionFailedError(fmt.Sprintf("todo has changed: expected version %s", formatVersions(versions)), err)
+	case isVersionConflict(err):
+		return conflictError("todo was changed by another request, retry", err)
+	default:
+		return internalError("fail to update a todo in the store", err)
+	}
+}
@@ -1,14 +1,32 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
 type Clock interface {
 	Now() time.Time
 }
 
+// Transactor runs a unit of work atomically. The stores called with the context given to fn take
+// part in the transaction, which is committed when fn returns nil and rolled back otherwise.
+// A todo read inside the transaction stays locked until it ends, so concurrent units of work that
+// read and then change the same todo run one after the other. Transactions started inside another
+// one are part of it.
+type Transactor interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// EventPublisher publishes the domain events of the changes made by the usecases, once they are
+// committed, to whatever is subscribed to them. A change that is rolled back publishes nothing.
+type EventPublisher interface {
+	Publish(context.Context, domain.Event)
+}
+
 type (
 	ErrorType string
 	Error     struct {
@@ -19,9 +37,12 @@ type (
 )
 
 var (
-	ErrorTypeBadRequest    = ErrorType("bad_request")
-	ErrorTypeInternalError = ErrorType("internal_error")
-	ErrorTypeNotFound      = ErrorType("not_found")
+	ErrorTypeBadRequest         = ErrorType("bad_request")
+	ErrorTypeInternalError      = ErrorType("internal_error")
+	ErrorTypeNotFound           = ErrorType("not_found")
+	ErrorTypeConflict           = ErrorType("conflict")
+	ErrorTypePreconditionFailed = ErrorType("precondition_failed")
+	ErrorTypeFailedDependency   = ErrorType("failed_dependency")
 
 	AnError = NewError("an error", errors.New("an error"), ErrorTypeInternalError)
 )
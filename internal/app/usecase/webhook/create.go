@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	CreateInput struct {
+		URL    string
+		Secret string
+		Events []domain.EventType
+	}
+	CreateStore interface {
+		Create(context.Context, domain.Webhook) (domain.Webhook, error)
+	}
+	Create interface {
+		Handle(context.Context, CreateInput) (WebhookOutput, error)
+	}
+	create struct {
+		store CreateStore
+		clock usecase.Clock
+	}
+)
+
+func NewCreate(store CreateStore, clock usecase.Clock) *create {
+	return &create{
+		store: store,
+		clock: clock,
+	}
+}
+
+func (uc *create) Handle(ctx context.Context, input CreateInput) (WebhookOutput, error) {
+	webhook, err := domain.NewWebhook(input.URL, input.Secret, input.Events, uc.clock.Now())
+	if err != nil {
+		return WebhookOutput{}, badRequestError(err.Error(), err)
+	}
+	webhook, err = uc.store.Create(ctx, webhook)
+	if err != nil {
+		return WebhookOutput{}, internalError("fail to create a webhook in the repository", err)
+	}
+	return WebhookOutputFromDomain(webhook), nil
+}
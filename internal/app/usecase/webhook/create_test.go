@@ -0,0 +1,87 @@
+package webhook_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestCreate_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	eventsErr := fmt.Errorf("%w: events must not be empty", domain.ErrWebhookInvalidInput)
+	toCreate := domain.Webhook{
+		URL:       "https://example.com/hooks",
+		Secret:    "s3cret",
+		Events:    []domain.EventType{domain.EventTodoCreated},
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDate,
+	}
+	testCases := []struct {
+		name   string
+		store  *webhookStoreMock
+		input  webhook.CreateInput
+		result webhook.WebhookOutput
+		err    error
+	}{
+		{
+			name:   "should fail when input is invalid",
+			store:  new(webhookStoreMock),
+			input:  webhook.CreateInput{URL: "https://example.com/hooks", Secret: "s3cret"},
+			result: webhook.WebhookOutput{},
+			err:    usecase.NewError(eventsErr.Error(), eventsErr, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when repository fails",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("Create", context.TODO(), toCreate).Return(domain.Webhook{}, assert.AnError).Once()
+				return m
+			}(),
+			input: webhook.CreateInput{
+				URL: "https://example.com/hooks", Secret: "s3cret", Events: []domain.EventType{domain.EventTodoCreated},
+			},
+			result: webhook.WebhookOutput{},
+			err: usecase.NewError("fail to create a webhook in the repository", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should create a webhook without returning its secret",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				created := toCreate
+				created.ID = "w1"
+				m.On("Create", context.TODO(), toCreate).Return(created, nil).Once()
+				return m
+			}(),
+			input: webhook.CreateInput{
+				URL: "https://example.com/hooks", Secret: "s3cret", Events: []domain.EventType{domain.EventTodoCreated},
+			},
+			result: webhook.WebhookOutput{
+				ID:        "w1",
+				URL:       "https://example.com/hooks",
+				Events:    []string{"todo.created"},
+				CreatedAt: exampleDate,
+				UpdatedAt: exampleDate,
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDate).Once()
+			uc := webhook.NewCreate(tc.store, clock)
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			clock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"context"
+)
+
+type (
+	// DeleteByIDStore deletes webhooks together with their deliveries.
+	DeleteByIDStore interface {
+		DeleteByID(context.Context, string) error
+	}
+	DeleteByID interface {
+		Handle(ctx context.Context, id string) error
+	}
+	deleteByID struct {
+		store DeleteByIDStore
+	}
+)
+
+func NewDeleteByID(store DeleteByIDStore) *deleteByID {
+	return &deleteByID{store}
+}
+
+// Handle deletes the webhook, whose pending deliveries are not attempted anymore.
+func (uc *deleteByID) Handle(ctx context.Context, id string) error {
+	if err := uc.store.DeleteByID(ctx, id); err != nil {
+		if isNotFound(err) {
+			return notFoundError(id, err)
+		}
+		return internalError("fail to delete a webhook by id", err)
+	}
+	return nil
+}
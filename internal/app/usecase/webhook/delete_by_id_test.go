@@ -0,0 +1,46 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestDeleteByID_Handle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		storeErr error
+		err      error
+	}{
+		{
+			name:     "should fail when webhook is not found",
+			storeErr: domain.ErrWebhookNotFound,
+			err: usecase.NewError("webhook not found with id w1", domain.ErrWebhookNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name:     "should fail when repository fails",
+			storeErr: assert.AnError,
+			err:      usecase.NewError("fail to delete a webhook by id", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name:     "should delete the webhook",
+			storeErr: nil,
+			err:      nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := new(webhookStoreMock)
+			store.On("DeleteByID", context.TODO(), "w1").Return(tc.storeErr).Once()
+			uc := webhook.NewDeleteByID(store)
+			err := uc.Handle(context.TODO(), "w1")
+			assert.Equal(t, tc.err, err)
+			store.AssertExpectations(t)
+		})
+	}
+}
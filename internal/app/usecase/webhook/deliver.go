@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// MaxRetryBackoff is the longest a failed delivery waits for its next attempt, however many
+// attempts it already had.
+const MaxRetryBackoff = 24 * time.Hour
+
+type (
+	// RetryPolicy tells how failed deliveries are retried: Backoff after the first attempt, and
+	// twice the previous delay after each of the next ones up to MaxRetryBackoff, until
+	// MaxAttempts attempts were made.
+	RetryPolicy struct {
+		MaxAttempts int
+		Backoff     time.Duration
+	}
+	// Request is the delivery of an event to a webhook, sent as a POST of the payload
+	// signed with the secret of the webhook.
+	Request struct {
+		URL        string
+		Secret     string
+		DeliveryID string
+		EventType  domain.EventType
+		Payload    json.RawMessage
+	}
+	// Sender sends requests to webhooks, returning the HTTP status of the response, or an error
+	// when there was none.
+	Sender interface {
+		Send(context.Context, Request) (int, error)
+	}
+	DeliveryStore interface {
+		CreateDelivery(context.Context, domain.WebhookDelivery) (domain.WebhookDelivery, error)
+		UpdateDelivery(context.Context, domain.WebhookDelivery) (domain.WebhookDelivery, error)
+	}
+)
+
+// retryAt returns when a delivery that failed its attempt number attempt at date is attempted
+// again, nil when it is given up.
+func (p RetryPolicy) retryAt(attempt int, date time.Time) *time.Time {
+	if attempt >= p.MaxAttempts {
+		return nil
+	}
+	delay := MaxRetryBackoff
+	if doublings := attempt - 1; doublings < 32 && p.Backoff <= MaxRetryBackoff>>doublings {
+		delay = p.Backoff << doublings
+	}
+	next := date.Add(delay)
+	return &next
+}
+
+// deliverer attempts the deliveries of the webhooks.
+type deliverer struct {
+	store  DeliveryStore
+	sender Sender
+	clock  usecase.Clock
+	policy RetryPolicy
+}
+
+// attempt sends the delivery to the webhook and records the outcome: the delivery succeeds when
+// the webhook answers with a 2xx status, and otherwise stays pending until its next attempt or
+// is given up according to the retry policy.
+func (d deliverer) attempt(ctx context.Context, webhook domain.Webhook, delivery domain.WebhookDelivery) error {
+	status, err := d.sender.Send(ctx, Request{
+		URL:        webhook.URL,
+		Secret:     webhook.Secret,
+		DeliveryID: delivery.ID,
+		EventType:  delivery.EventType,
+		Payload:    delivery.Payload,
+	})
+	now := d.clock.Now()
+	switch {
+	case err != nil:
+		delivery = delivery.Fail(0, err.Error(), now, d.policy.retryAt(delivery.Attempts+1, now))
+	case status < 200 || status > 299:
+		delivery = delivery.Fail(status, fmt.Sprintf("unexpected status %d", status), now,
+			d.policy.retryAt(delivery.Attempts+1, now))
+	default:
+		delivery = delivery.Succeed(status, now)
+	}
+	if _, err := d.store.UpdateDelivery(ctx, delivery); err != nil {
+		return internalError("fail to update a webhook delivery", err)
+	}
+	return nil
+}
+
+type (
+	// eventPayload is the JSON body of the delivery of an event.
+	eventPayload struct {
+		ID         string      `json:"id"`
+		Type       string      `json:"type"`
+		TodoID     string      `json:"todo_id"`
+		Actor      string      `json:"actor"`
+		OccurredAt time.Time   `json:"occurred_at"`
+		Todo       todoPayload `json:"todo"`
+	}
+	todoPayload struct {
+		ID          string             `json:"id"`
+		Title       string             `json:"title"`
+		Description string             `json:"description"`
+		Status      string             `json:"status"`
+		Priority    string             `json:"priority"`
+		Tags        []string           `json:"tags"`
+		Items       []checklistPayload `json:"items"`
+		Recurrence  string             `json:"recurrence,omitempty"`
+		ProjectID   *string            `json:"project_id,omitempty"`
+		DueDate     *time.Time         `json:"due_date,omitempty"`
+		CreatedAt   time.Time          `json:"created_at"`
+		UpdatedAt   time.Time          `json:"updated_at"`
+		CompletedAt *time.Time         `json:"completed_at,omitempty"`
+		ArchivedAt  *time.Time         `json:"archived_at,omitempty"`
+		Version     int                `json:"version,omitempty"`
+	}
+	checklistPayload struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+		Done  bool   `json:"done"`
+	}
+)
+
+// marshalEvent returns the JSON body of the deliveries of the event, with the todo in the same
+// shape as the todos of the HTTP API.
+func marshalEvent(event domain.Event) (json.RawMessage, error) {
+	output := todo.TodoOutputFromDomain(event.Todo)
+	tags := output.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	items := make([]checklistPayload, 0, len(output.Items))
+	for _, item := range output.Items {
+		items = append(items, checklistPayload{ID: item.ID, Title: item.Title, Done: item.Done})
+	}
+	return json.Marshal(eventPayload{
+		ID:         event.ID,
+		Type:       string(event.Type),
+		TodoID:     event.TodoID,
+		Actor:      event.Actor,
+		OccurredAt: event.OccurredAt,
+		Todo: todoPayload{
+			ID:          output.ID,
+			Title:       output.Title,
+			Description: output.Description,
+			Status:      output.Status,
+			Priority:    output.Priority,
+			Tags:        tags,
+			Items:       items,
+			Recurrence:  output.Recurrence,
+			ProjectID:   output.ProjectID,
+			DueDate:     output.DueDate,
+			CreatedAt:   output.CreatedAt,
+			UpdatedAt:   output.UpdatedAt,
+			CompletedAt: output.CompletedAt,
+			ArchivedAt:  output.ArchivedAt,
+			Version:     output.Version,
+		},
+	})
+}
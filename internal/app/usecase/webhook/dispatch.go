@@ -0,0 +1,65 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	DispatchStore interface {
+		ListStore
+		CreateDelivery(context.Context, domain.WebhookDelivery) (domain.WebhookDelivery, error)
+	}
+	// DeliveryNotifier is told when deliveries are due, so that they are attempted without
+	// waiting for the next retry of the due deliveries.
+	DeliveryNotifier interface {
+		Notify()
+	}
+	Dispatch interface {
+		Handle(context.Context, domain.Event) error
+	}
+	dispatch struct {
+		store    DispatchStore
+		clock    usecase.Clock
+		notifier DeliveryNotifier
+	}
+)
+
+func NewDispatch(store DispatchStore, clock usecase.Clock, notifier DeliveryNotifier) *dispatch {
+	return &dispatch{store: store, clock: clock, notifier: notifier}
+}
+
+// Handle records a delivery of the event, due right away, for every webhook subscribed to its
+// type, and leaves their attempts to RetryDeliveries, so that the next events never wait for a
+// webhook. A delivery that cannot be recorded does not stop the others,
+// the errors of all of them being returned together.
+func (uc *dispatch) Handle(ctx context.Context, event domain.Event) error {
+	webhooks, err := uc.store.List(ctx)
+	if err != nil {
+		return internalError("fail to list webhooks", err)
+	}
+	payload, err := marshalEvent(event)
+	if err != nil {
+		return internalError("fail to encode the payload of a webhook delivery", err)
+	}
+	created := 0
+	var errs []error
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(event.Type) {
+			continue
+		}
+		if _, err := uc.store.CreateDelivery(ctx,
+			domain.NewWebhookDelivery(webhook.ID, event, payload, uc.clock.Now())); err != nil {
+			errs = append(errs, internalError("fail to create a webhook delivery", err))
+			continue
+		}
+		created++
+	}
+	if created > 0 {
+		uc.notifier.Notify()
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,120 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type deliveryNotifierMock struct {
+	mock.Mock
+}
+
+func (m *deliveryNotifierMock) Notify() {
+	m.Called()
+}
+
+func TestDispatch_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	event := domain.Event{
+		ID:     "e1",
+		Type:   domain.EventTodoCompleted,
+		TodoID: "t1",
+		Todo: domain.Todo{
+			ID: "t1", Title: "Write report", Status: domain.TodoStatusCompleted,
+			CreatedAt: exampleDate, UpdatedAt: exampleDate,
+		},
+		Actor:      "alice",
+		OccurredAt: exampleDate,
+	}
+	payload := json.RawMessage(`{"id":"e1","type":"todo.completed","todo_id":"t1","actor":"alice",` +
+		`"occurred_at":"2024-01-01T00:00:00Z","todo":{"id":"t1","title":"Write report","description":"",` +
+		`"status":"completed","priority":"","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z",` +
+		`"updated_at":"2024-01-01T00:00:00Z"}}`)
+	subscribed := domain.Webhook{ID: "w1", URL: "https://example.com/hooks", Secret: "s3cret",
+		Events: []domain.EventType{domain.EventTodoCompleted}}
+	alsoSubscribed := domain.Webhook{ID: "w3", URL: "https://example.com/also", Secret: "al5o",
+		Events: []domain.EventType{domain.EventTodoCompleted}}
+	other := domain.Webhook{ID: "w2", URL: "https://example.com/other", Secret: "0ther",
+		Events: []domain.EventType{domain.EventTodoCreated}}
+	pending := domain.NewWebhookDelivery("w1", event, payload, exampleDate)
+	alsoPending := domain.NewWebhookDelivery("w3", event, payload, exampleDate)
+	testCases := []struct {
+		name     string
+		store    *webhookStoreMock
+		notifier *deliveryNotifierMock
+		err      error
+	}{
+		{
+			name: "should fail when the webhooks cannot be listed",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("List", context.TODO()).Return([]domain.Webhook(nil), assert.AnError).Once()
+				return m
+			}(),
+			notifier: new(deliveryNotifierMock),
+			err:      usecase.NewError("fail to list webhooks", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should record a due delivery for the subscribed webhooks only and notify them",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("List", context.TODO()).Return([]domain.Webhook{other, subscribed}, nil).Once()
+				m.On("CreateDelivery", context.TODO(), pending).Return(pending, nil).Once()
+				return m
+			}(),
+			notifier: func() *deliveryNotifierMock {
+				m := new(deliveryNotifierMock)
+				m.On("Notify").Once()
+				return m
+			}(),
+			err: nil,
+		},
+		{
+			name: "should not notify when no webhook is subscribed",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("List", context.TODO()).Return([]domain.Webhook{other}, nil).Once()
+				return m
+			}(),
+			notifier: new(deliveryNotifierMock),
+			err:      nil,
+		},
+		{
+			name: "should keep recording the other deliveries when one cannot be recorded",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("List", context.TODO()).Return([]domain.Webhook{subscribed, alsoSubscribed}, nil).Once()
+				m.On("CreateDelivery", context.TODO(), pending).Return(domain.WebhookDelivery{}, assert.AnError).Once()
+				m.On("CreateDelivery", context.TODO(), alsoPending).Return(alsoPending, nil).Once()
+				return m
+			}(),
+			notifier: func() *deliveryNotifierMock {
+				m := new(deliveryNotifierMock)
+				m.On("Notify").Once()
+				return m
+			}(),
+			err: errors.Join(usecase.NewError("fail to create a webhook delivery", assert.AnError,
+				usecase.ErrorTypeInternalError)),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDate).Maybe()
+			uc := webhook.NewDispatch(tc.store, clock, tc.notifier)
+			err := uc.Handle(context.TODO(), event)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.notifier.AssertExpectations(t)
+		})
+	}
+}
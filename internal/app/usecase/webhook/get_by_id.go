@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	GetByIDStore interface {
+		GetByID(context.Context, string) (domain.Webhook, error)
+	}
+	GetByID interface {
+		Handle(ctx context.Context, id string) (WebhookOutput, error)
+	}
+	getByID struct {
+		store GetByIDStore
+	}
+)
+
+func NewGetByID(store GetByIDStore) *getByID {
+	return &getByID{store}
+}
+
+func (uc *getByID) Handle(ctx context.Context, id string) (WebhookOutput, error) {
+	webhook, err := getWebhook(ctx, uc.store, id)
+	if err != nil {
+		return WebhookOutput{}, err
+	}
+	return WebhookOutputFromDomain(webhook), nil
+}
+
+// getWebhook gets the webhook with the id, returning usecase errors.
+func getWebhook(ctx context.Context, store GetByIDStore, id string) (domain.Webhook, error) {
+	webhook, err := store.GetByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			return domain.Webhook{}, notFoundError(id, err)
+		}
+		return domain.Webhook{}, internalError("fail to get a webhook by id", err)
+	}
+	return webhook, nil
+}
@@ -0,0 +1,67 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestGetByID_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name   string
+		store  *webhookStoreMock
+		result webhook.WebhookOutput
+		err    error
+	}{
+		{
+			name: "should fail when webhook is not found",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("GetByID", context.TODO(), "w1").Return(domain.Webhook{}, domain.ErrWebhookNotFound).Once()
+				return m
+			}(),
+			err: usecase.NewError("webhook not found with id w1", domain.ErrWebhookNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when repository fails",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("GetByID", context.TODO(), "w1").Return(domain.Webhook{}, assert.AnError).Once()
+				return m
+			}(),
+			err: usecase.NewError("fail to get a webhook by id", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should get the webhook",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("GetByID", context.TODO(), "w1").Return(domain.Webhook{
+					ID: "w1", URL: "https://example.com/hooks", Secret: "s3cret",
+					Events:    []domain.EventType{domain.EventTodoDeleted},
+					CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}, nil).Once()
+				return m
+			}(),
+			result: webhook.WebhookOutput{
+				ID: "w1", URL: "https://example.com/hooks", Events: []string{"todo.deleted"},
+				CreatedAt: exampleDate, UpdatedAt: exampleDate,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := webhook.NewGetByID(tc.store)
+			result, err := uc.Handle(context.TODO(), "w1")
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
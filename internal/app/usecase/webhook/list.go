@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	ListStore interface {
+		List(context.Context) ([]domain.Webhook, error)
+	}
+	List interface {
+		Handle(context.Context) ([]WebhookOutput, error)
+	}
+	list struct {
+		store ListStore
+	}
+)
+
+func NewList(store ListStore) *list {
+	return &list{store}
+}
+
+// Handle returns every webhook, the oldest first.
+func (uc *list) Handle(ctx context.Context) ([]WebhookOutput, error) {
+	webhooks, err := uc.store.List(ctx)
+	if err != nil {
+		return nil, internalError("fail to list webhooks", err)
+	}
+	return WebhookOutputsFromDomain(webhooks), nil
+}
@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	ListDeliveriesStore interface {
+		GetByIDStore
+		ListDeliveries(ctx context.Context, webhookID string) ([]domain.WebhookDelivery, error)
+	}
+	ListDeliveries interface {
+		Handle(ctx context.Context, webhookID string) ([]DeliveryOutput, error)
+	}
+	listDeliveries struct {
+		store ListDeliveriesStore
+	}
+)
+
+func NewListDeliveries(store ListDeliveriesStore) *listDeliveries {
+	return &listDeliveries{store}
+}
+
+// Handle returns the deliveries of the webhook, the newest first.
+func (uc *listDeliveries) Handle(ctx context.Context, webhookID string) ([]DeliveryOutput, error) {
+	if _, err := getWebhook(ctx, uc.store, webhookID); err != nil {
+		return nil, err
+	}
+	deliveries, err := uc.store.ListDeliveries(ctx, webhookID)
+	if err != nil {
+		return nil, internalError("fail to list the deliveries of a webhook", err)
+	}
+	return DeliveryOutputsFromDomain(deliveries), nil
+}
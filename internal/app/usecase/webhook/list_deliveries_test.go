@@ -0,0 +1,72 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestListDeliveries_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name   string
+		store  *webhookStoreMock
+		result []webhook.DeliveryOutput
+		err    error
+	}{
+		{
+			name: "should fail when webhook is not found",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("GetByID", context.TODO(), "w1").Return(domain.Webhook{}, domain.ErrWebhookNotFound).Once()
+				return m
+			}(),
+			err: usecase.NewError("webhook not found with id w1", domain.ErrWebhookNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when repository fails",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("GetByID", context.TODO(), "w1").Return(domain.Webhook{ID: "w1"}, nil).Once()
+				m.On("ListDeliveries", context.TODO(), "w1").Return([]domain.WebhookDelivery(nil), assert.AnError).Once()
+				return m
+			}(),
+			err: usecase.NewError("fail to list the deliveries of a webhook", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should list the deliveries of the webhook",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("GetByID", context.TODO(), "w1").Return(domain.Webhook{ID: "w1"}, nil).Once()
+				m.On("ListDeliveries", context.TODO(), "w1").Return([]domain.WebhookDelivery{{
+					ID: "d1", WebhookID: "w1", EventID: "e1", EventType: domain.EventTodoCreated,
+					Payload: json.RawMessage(`{}`), Status: domain.WebhookDeliverySucceeded, Attempts: 1,
+					ResponseStatus: 200, CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}}, nil).Once()
+				return m
+			}(),
+			result: []webhook.DeliveryOutput{{
+				ID: "d1", WebhookID: "w1", EventID: "e1", EventType: "todo.created",
+				Payload: json.RawMessage(`{}`), Status: "succeeded", Attempts: 1,
+				ResponseStatus: 200, CreatedAt: exampleDate, UpdatedAt: exampleDate,
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := webhook.NewListDeliveries(tc.store)
+			result, err := uc.Handle(context.TODO(), "w1")
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
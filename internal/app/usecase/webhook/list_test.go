@@ -0,0 +1,59 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestList_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name   string
+		store  *webhookStoreMock
+		result []webhook.WebhookOutput
+		err    error
+	}{
+		{
+			name: "should fail when repository fails",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("List", context.TODO()).Return([]domain.Webhook(nil), assert.AnError).Once()
+				return m
+			}(),
+			result: nil,
+			err:    usecase.NewError("fail to list webhooks", assert.AnError, usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should list the webhooks",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("List", context.TODO()).Return([]domain.Webhook{{
+					ID: "w1", URL: "https://example.com/hooks", Secret: "s3cret",
+					Events:    []domain.EventType{domain.EventTodoCompleted},
+					CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}}, nil).Once()
+				return m
+			}(),
+			result: []webhook.WebhookOutput{{
+				ID: "w1", URL: "https://example.com/hooks", Events: []string{"todo.completed"},
+				CreatedAt: exampleDate, UpdatedAt: exampleDate,
+			}},
+			err: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := webhook.NewList(tc.store)
+			result, err := uc.Handle(context.TODO())
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// WebhookOutput represents the output structure for webhook operations. The secret
+// of the webhook is never part of it.
+type WebhookOutput struct {
+	ID        string
+	URL       string
+	Events    []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DeliveryOutput represents the output structure of a webhook delivery
+type DeliveryOutput struct {
+	ID             string
+	WebhookID      string
+	EventID        string
+	EventType      string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int
+	ResponseStatus int
+	LastError      string
+	NextAttemptAt  *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// WebhookOutputFromDomain converts a domain.Webhook to WebhookOutput
+func WebhookOutputFromDomain(webhook domain.Webhook) WebhookOutput {
+	events := make([]string, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+	return WebhookOutput{
+		ID:        webhook.ID,
+		URL:       webhook.URL,
+		Events:    events,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}
+}
+
+// WebhookOutputsFromDomain converts a slice of domain.Webhook to []WebhookOutput
+func WebhookOutputsFromDomain(webhooks []domain.Webhook) []WebhookOutput {
+	outputs := make([]WebhookOutput, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		outputs = append(outputs, WebhookOutputFromDomain(webhook))
+	}
+	return outputs
+}
+
+// DeliveryOutputFromDomain converts a domain.WebhookDelivery to DeliveryOutput
+func DeliveryOutputFromDomain(delivery domain.WebhookDelivery) DeliveryOutput {
+	return DeliveryOutput{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventID:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+// DeliveryOutputsFromDomain converts a slice of domain.WebhookDelivery to []DeliveryOutput
+func DeliveryOutputsFromDomain(deliveries []domain.WebhookDelivery) []DeliveryOutput {
+	outputs := make([]DeliveryOutput, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		outputs = append(outputs, DeliveryOutputFromDomain(delivery))
+	}
+	return outputs
+}
@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	RetryDeliveriesStore interface {
+		GetByIDStore
+		DeliveryStore
+		// ListDueDeliveries returns the pending deliveries whose next attempt is at or before date
+		ListDueDeliveries(ctx context.Context, date time.Time) ([]domain.WebhookDelivery, error)
+	}
+	RetryDeliveries interface {
+		Handle(context.Context) (int, error)
+	}
+	retryDeliveries struct {
+		store     RetryDeliveriesStore
+		clock     usecase.Clock
+		deliverer deliverer
+	}
+)
+
+func NewRetryDeliveries(
+	store RetryDeliveriesStore, sender Sender, clock usecase.Clock, policy RetryPolicy,
+) *retryDeliveries {
+	return &retryDeliveries{
+		store:     store,
+		clock:     clock,
+		deliverer: deliverer{store: store, sender: sender, clock: clock, policy: policy},
+	}
+}
+
+// Handle attempts the pending deliveries that are due, the new ones as well as the failed ones
+// waiting for a retry, returning how many were attempted. The webhooks are attempted concurrently,
+// each one getting its deliveries one at a time in the order of their events, so that a slow webhook
+// does not hold up the attempts of the others. The deliveries of a webhook deleted in the meantime
+// are skipped.
+func (uc *retryDeliveries) Handle(ctx context.Context) (int, error) {
+	deliveries, err := uc.store.ListDueDeliveries(ctx, uc.clock.Now())
+	if err != nil {
+		return 0, internalError("fail to list the due webhook deliveries", err)
+	}
+	var webhookIDs []string
+	byWebhook := map[string][]domain.WebhookDelivery{}
+	for _, delivery := range deliveries {
+		if _, ok := byWebhook[delivery.WebhookID]; !ok {
+			webhookIDs = append(webhookIDs, delivery.WebhookID)
+		}
+		byWebhook[delivery.WebhookID] = append(byWebhook[delivery.WebhookID], delivery)
+	}
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		attempted int
+		errs      []error
+	)
+	for _, webhookID := range webhookIDs {
+		webhook, err := uc.store.GetByID(ctx, webhookID)
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, internalError("fail to get a webhook by id", err))
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, delivery := range byWebhook[webhookID] {
+				err := uc.deliverer.attempt(ctx, webhook, delivery)
+				mu.Lock()
+				attempted++
+				if err != nil {
+					errs = append(errs, err)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return attempted, errors.Join(errs...)
+}
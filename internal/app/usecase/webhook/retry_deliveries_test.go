@@ -0,0 +1,240 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestRetryDeliveries_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	policy := webhook.RetryPolicy{MaxAttempts: 3, Backoff: time.Minute}
+	due := domain.WebhookDelivery{ID: "d1", WebhookID: "w1", EventType: domain.EventTodoCreated,
+		Status: domain.WebhookDeliveryPending, Attempts: 1, NextAttemptAt: &exampleDate}
+	orphan := domain.WebhookDelivery{ID: "d2", WebhookID: "deleted", Status: domain.WebhookDeliveryPending}
+	subscribed := domain.Webhook{ID: "w1", URL: "https://example.com/hooks", Secret: "s3cret",
+		Events: []domain.EventType{domain.EventTodoCreated}}
+	testCases := []struct {
+		name      string
+		store     *webhookStoreMock
+		sender    *senderMock
+		attempted int
+		err       error
+	}{
+		{
+			name: "should fail when the due deliveries cannot be listed",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("ListDueDeliveries", context.TODO(), exampleDate).
+					Return([]domain.WebhookDelivery(nil), assert.AnError).Once()
+				return m
+			}(),
+			sender: new(senderMock),
+			err: usecase.NewError("fail to list the due webhook deliveries", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should attempt the due deliveries of the existing webhooks",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("ListDueDeliveries", context.TODO(), exampleDate).
+					Return([]domain.WebhookDelivery{due, orphan}, nil).Once()
+				m.On("GetByID", context.TODO(), "w1").Return(subscribed, nil).Once()
+				m.On("GetByID", context.TODO(), "deleted").Return(domain.Webhook{}, domain.ErrWebhookNotFound).Once()
+				m.On("UpdateDelivery", context.TODO(), due.Succeed(204, exampleDate)).Return(due, nil).Once()
+				return m
+			}(),
+			sender: func() *senderMock {
+				m := new(senderMock)
+				m.On("Send", context.TODO(), webhook.Request{
+					URL: "https://example.com/hooks", Secret: "s3cret", DeliveryID: "d1",
+					EventType: domain.EventTodoCreated,
+				}).Return(204, nil).Once()
+				return m
+			}(),
+			attempted: 1,
+			err:       nil,
+		},
+		{
+			name: "should keep attempting the other deliveries when one cannot be updated",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("ListDueDeliveries", context.TODO(), exampleDate).
+					Return([]domain.WebhookDelivery{due}, nil).Once()
+				m.On("GetByID", context.TODO(), "w1").Return(subscribed, nil).Once()
+				m.On("UpdateDelivery", context.TODO(), due.Succeed(204, exampleDate)).
+					Return(domain.WebhookDelivery{}, assert.AnError).Once()
+				return m
+			}(),
+			sender: func() *senderMock {
+				m := new(senderMock)
+				m.On("Send", context.TODO(), webhook.Request{
+					URL: "https://example.com/hooks", Secret: "s3cret", DeliveryID: "d1",
+					EventType: domain.EventTodoCreated,
+				}).Return(204, nil).Once()
+				return m
+			}(),
+			attempted: 1,
+			err: errors.Join(usecase.NewError("fail to update a webhook delivery", assert.AnError,
+				usecase.ErrorTypeInternalError)),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDate)
+			uc := webhook.NewRetryDeliveries(tc.store, tc.sender, clock, policy)
+			attempted, err := uc.Handle(context.TODO())
+			assert.Equal(t, tc.attempted, attempted)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.sender.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRetryDeliveries_Handle_Concurrency(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	slow := domain.Webhook{ID: "slow", URL: "https://slow.example.com"}
+	fast := domain.Webhook{ID: "fast", URL: "https://fast.example.com"}
+	newClock := func() *clockMock {
+		m := newClockMock()
+		m.On("Now").Return(exampleDate)
+		return m
+	}
+
+	t.Run("should not hold up a webhook while another one is slow", func(t *testing.T) {
+		store := new(webhookStoreMock)
+		store.On("ListDueDeliveries", mock.Anything, exampleDate).Return([]domain.WebhookDelivery{
+			{ID: "d1", WebhookID: "slow"}, {ID: "d2", WebhookID: "fast"},
+		}, nil).Once()
+		store.On("GetByID", mock.Anything, "slow").Return(slow, nil).Once()
+		store.On("GetByID", mock.Anything, "fast").Return(fast, nil).Once()
+		store.On("UpdateDelivery", mock.Anything, mock.Anything).Return(domain.WebhookDelivery{}, nil).Twice()
+		release, sent := make(chan struct{}), make(chan struct{})
+		sender := new(senderMock)
+		sender.On("Send", mock.Anything, mock.MatchedBy(func(r webhook.Request) bool { return r.URL == slow.URL })).
+			Run(func(mock.Arguments) { <-release }).Return(204, nil).Once()
+		sender.On("Send", mock.Anything, mock.MatchedBy(func(r webhook.Request) bool { return r.URL == fast.URL })).
+			Run(func(mock.Arguments) { close(sent) }).Return(204, nil).Once()
+		uc := webhook.NewRetryDeliveries(store, sender, newClock(), webhook.RetryPolicy{MaxAttempts: 1})
+		done := make(chan int)
+		go func() {
+			attempted, _ := uc.Handle(context.TODO())
+			done <- attempted
+		}()
+		select {
+		case <-sent:
+		case <-time.After(time.Second):
+			t.Fatal("the delivery of the fast webhook waited for the slow one")
+		}
+		close(release)
+		assert.Equal(t, 2, <-done)
+	})
+
+	t.Run("should attempt the deliveries of a webhook one at a time in order", func(t *testing.T) {
+		deliveries := make([]domain.WebhookDelivery, 5)
+		for i := range deliveries {
+			deliveries[i] = domain.WebhookDelivery{ID: fmt.Sprintf("d%d", i), WebhookID: "slow"}
+		}
+		store := new(webhookStoreMock)
+		store.On("ListDueDeliveries", mock.Anything, exampleDate).Return(deliveries, nil).Once()
+		store.On("GetByID", mock.Anything, "slow").Return(slow, nil).Once()
+		store.On("UpdateDelivery", mock.Anything, mock.Anything).Return(domain.WebhookDelivery{}, nil)
+		var running, most atomic.Int32
+		var sent []string
+		sender := new(senderMock)
+		sender.On("Send", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			most.Store(max(most.Load(), running.Add(1)))
+			sent = append(sent, args.Get(1).(webhook.Request).DeliveryID)
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+		}).Return(204, nil)
+		uc := webhook.NewRetryDeliveries(store, sender, newClock(), webhook.RetryPolicy{MaxAttempts: 1})
+		attempted, err := uc.Handle(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, len(deliveries), attempted)
+		assert.Equal(t, int32(1), most.Load())
+		assert.Equal(t, []string{"d0", "d1", "d2", "d3", "d4"}, sent)
+	})
+}
+
+func TestRetryDeliveries_Handle_Backoff(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	subscribed := domain.Webhook{ID: "w1", URL: "https://example.com/hooks", Secret: "s3cret",
+		Events: []domain.EventType{domain.EventTodoCreated}}
+	testCases := []struct {
+		name     string
+		policy   webhook.RetryPolicy
+		attempts int
+		status   domain.WebhookDeliveryStatus
+		retryAt  *time.Time
+	}{
+		{
+			name:     "should wait the backoff after the first attempt",
+			policy:   webhook.RetryPolicy{MaxAttempts: 3, Backoff: time.Minute},
+			attempts: 0,
+			status:   domain.WebhookDeliveryPending,
+			retryAt:  func() *time.Time { d := exampleDate.Add(time.Minute); return &d }(),
+		},
+		{
+			name:     "should wait twice as long after each next attempt",
+			policy:   webhook.RetryPolicy{MaxAttempts: 3, Backoff: time.Minute},
+			attempts: 1,
+			status:   domain.WebhookDeliveryPending,
+			retryAt:  func() *time.Time { d := exampleDate.Add(2 * time.Minute); return &d }(),
+		},
+		{
+			name:     "should wait at most the max backoff",
+			policy:   webhook.RetryPolicy{MaxAttempts: 20, Backoff: time.Minute},
+			attempts: 15,
+			status:   domain.WebhookDeliveryPending,
+			retryAt:  func() *time.Time { d := exampleDate.Add(webhook.MaxRetryBackoff); return &d }(),
+		},
+		{
+			name:     "should wait the max backoff when doubling the backoff would overflow",
+			policy:   webhook.RetryPolicy{MaxAttempts: 100, Backoff: time.Minute},
+			attempts: 70,
+			status:   domain.WebhookDeliveryPending,
+			retryAt:  func() *time.Time { d := exampleDate.Add(webhook.MaxRetryBackoff); return &d }(),
+		},
+		{
+			name:     "should give up after the last attempt",
+			policy:   webhook.RetryPolicy{MaxAttempts: 3, Backoff: time.Minute},
+			attempts: 2,
+			status:   domain.WebhookDeliveryFailed,
+			retryAt:  nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			delivery := domain.WebhookDelivery{ID: "d1", WebhookID: "w1", Attempts: tc.attempts}
+			var updated domain.WebhookDelivery
+			store := new(webhookStoreMock)
+			store.On("ListDueDeliveries", mock.Anything, exampleDate).Return([]domain.WebhookDelivery{delivery}, nil).Once()
+			store.On("GetByID", mock.Anything, "w1").Return(subscribed, nil).Once()
+			store.On("UpdateDelivery", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				updated = args.Get(1).(domain.WebhookDelivery)
+			}).Return(delivery, nil).Once()
+			sender := new(senderMock)
+			sender.On("Send", mock.Anything, mock.Anything).Return(500, nil).Once()
+			clock := newClockMock()
+			clock.On("Now").Return(exampleDate)
+			uc := webhook.NewRetryDeliveries(store, sender, clock, tc.policy)
+			_, err := uc.Handle(context.TODO())
+			assert.NoError(t, err)
+			assert.Equal(t, tc.status, updated.Status)
+			assert.Equal(t, tc.attempts+1, updated.Attempts)
+			assert.Equal(t, tc.retryAt, updated.NextAttemptAt)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	UpdateInput struct {
+		ID  string
+		URL string
+		// Secret, when empty, keeps the current secret of the webhook
+		Secret string
+		Events []domain.EventType
+	}
+	UpdateStore interface {
+		GetByIDStore
+		Update(context.Context, domain.Webhook) (domain.Webhook, error)
+	}
+	Update interface {
+		Handle(context.Context, UpdateInput) (WebhookOutput, error)
+	}
+	update struct {
+		store UpdateStore
+		clock usecase.Clock
+	}
+)
+
+func NewUpdate(store UpdateStore, clock usecase.Clock) *update {
+	return &update{
+		store: store,
+		clock: clock,
+	}
+}
+
+func (uc *update) Handle(ctx context.Context, input UpdateInput) (WebhookOutput, error) {
+	webhook, err := getWebhook(ctx, uc.store, input.ID)
+	if err != nil {
+		return WebhookOutput{}, err
+	}
+	webhook, err = webhook.Update(input.URL, input.Secret, input.Events, uc.clock.Now())
+	if err != nil {
+		return WebhookOutput{}, badRequestError(err.Error(), err)
+	}
+	webhook, err = uc.store.Update(ctx, webhook)
+	if err != nil {
+		if isNotFound(err) {
+			return WebhookOutput{}, notFoundError(input.ID, err)
+		}
+		return WebhookOutput{}, internalError("fail to update a webhook in the repository", err)
+	}
+	return WebhookOutputFromDomain(webhook), nil
+}
@@ -0,0 +1,112 @@
+package webhook_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestUpdate_Handle(t *testing.T) {
+	created, _ := time.Parse(time.DateOnly, "2024-01-01")
+	updated := created.Add(time.Hour)
+	existing := domain.Webhook{
+		ID: "w1", URL: "https://example.com/hooks", Secret: "s3cret",
+		Events: []domain.EventType{domain.EventTodoCreated}, CreatedAt: created, UpdatedAt: created,
+	}
+	changed := domain.Webhook{
+		ID: "w1", URL: "https://example.com/other", Secret: "s3cret",
+		Events: []domain.EventType{domain.EventTodoCreated, domain.EventTodoDeleted}, CreatedAt: created, UpdatedAt: updated,
+	}
+	input := webhook.UpdateInput{
+		ID: "w1", URL: "https://example.com/other", Events: []domain.EventType{domain.EventTodoDeleted, domain.EventTodoCreated},
+	}
+	urlErr := fmt.Errorf("%w: url must be an absolute http or https URL", domain.ErrWebhookInvalidInput)
+	testCases := []struct {
+		name   string
+		store  *webhookStoreMock
+		clock  *clockMock
+		input  webhook.UpdateInput
+		result webhook.WebhookOutput
+		err    error
+	}{
+		{
+			name: "should fail when webhook is not found",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("GetByID", context.TODO(), "w1").Return(domain.Webhook{}, domain.ErrWebhookNotFound).Once()
+				return m
+			}(),
+			clock: newClockMock(),
+			input: input,
+			err: usecase.NewError("webhook not found with id w1", domain.ErrWebhookNotFound,
+				usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should fail when input is invalid",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("GetByID", context.TODO(), "w1").Return(existing, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(updated).Once()
+				return m
+			}(),
+			input: webhook.UpdateInput{ID: "w1", URL: "example.com", Events: input.Events},
+			err:   usecase.NewError(urlErr.Error(), urlErr, usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when store fails to update",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("GetByID", context.TODO(), "w1").Return(existing, nil).Once()
+				m.On("Update", context.TODO(), changed).Return(domain.Webhook{}, assert.AnError).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(updated).Once()
+				return m
+			}(),
+			input: input,
+			err: usecase.NewError("fail to update a webhook in the repository", assert.AnError,
+				usecase.ErrorTypeInternalError),
+		},
+		{
+			name: "should update the webhook keeping its secret",
+			store: func() *webhookStoreMock {
+				m := new(webhookStoreMock)
+				m.On("GetByID", context.TODO(), "w1").Return(existing, nil).Once()
+				m.On("Update", context.TODO(), changed).Return(changed, nil).Once()
+				return m
+			}(),
+			clock: func() *clockMock {
+				m := newClockMock()
+				m.On("Now").Return(updated).Once()
+				return m
+			}(),
+			input: input,
+			result: webhook.WebhookOutput{
+				ID: "w1", URL: "https://example.com/other", Events: []string{"todo.created", "todo.deleted"},
+				CreatedAt: created, UpdatedAt: updated,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := webhook.NewUpdate(tc.store, tc.clock)
+			result, err := uc.Handle(context.TODO(), tc.input)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			tc.store.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package webhook_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type webhookStoreMock struct {
+	mock.Mock
+}
+
+func (m *webhookStoreMock) Create(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	args := m.Called(ctx, webhook)
+	return args.Get(0).(domain.Webhook), args.Error(1)
+}
+
+func (m *webhookStoreMock) List(ctx context.Context) ([]domain.Webhook, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Webhook), args.Error(1)
+}
+
+func (m *webhookStoreMock) GetByID(ctx context.Context, id string) (domain.Webhook, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Webhook), args.Error(1)
+}
+
+func (m *webhookStoreMock) Update(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	args := m.Called(ctx, webhook)
+	return args.Get(0).(domain.Webhook), args.Error(1)
+}
+
+func (m *webhookStoreMock) DeleteByID(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *webhookStoreMock) ListDeliveries(ctx context.Context, webhookID string) ([]domain.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID)
+	return args.Get(0).([]domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *webhookStoreMock) ListDueDeliveries(ctx context.Context, date time.Time) ([]domain.WebhookDelivery, error) {
+	args := m.Called(ctx, date)
+	return args.Get(0).([]domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *webhookStoreMock) CreateDelivery(
+	ctx context.Context, delivery domain.WebhookDelivery,
+) (domain.WebhookDelivery, error) {
+	args := m.Called(ctx, delivery)
+	return args.Get(0).(domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *webhookStoreMock) UpdateDelivery(
+	ctx context.Context, delivery domain.WebhookDelivery,
+) (domain.WebhookDelivery, error) {
+	args := m.Called(ctx, delivery)
+	return args.Get(0).(domain.WebhookDelivery), args.Error(1)
+}
+
+type senderMock struct {
+	mock.Mock
+}
+
+func (m *senderMock) Send(ctx context.Context, request webhook.Request) (int, error) {
+	args := m.Called(ctx, request)
+	return args.Int(0), args.Error(1)
+}
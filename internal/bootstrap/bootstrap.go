@@ -19,9 +19,20 @@ func FXOptions() fx.Option {
 				Password: getEnv("DB_PASSWORD", "todo_password"),
 				Database: getEnv("DB_NAME", "todo_api"),
 			},
-			WithLifecycle: true,
-			WithSwagger:   true,
-			Port:          getEnv("PORT", "8080"),
+			WithLifecycle:        true,
+			WithSwagger:          true,
+			Port:                 getEnv("PORT", "8080"),
+			GRPCPort:             getEnv("GRPC_PORT", "9090"),
+			ProjectDeletePolicy:  getEnv("PROJECT_DELETE_POLICY", "refuse"),
+			TrashRetention:       getEnv("TRASH_RETENTION", "720h"),
+			TrashPurgeInterval:   getEnv("TRASH_PURGE_INTERVAL", "1h"),
+			AutoArchiveAfter:     getEnv("AUTO_ARCHIVE_AFTER", "720h"),
+			AutoArchiveInterval:  getEnv("AUTO_ARCHIVE_INTERVAL", "1h"),
+			WorkflowFile:         getEnv("WORKFLOW_FILE", ""),
+			WebhookMaxAttempts:   getEnv("WEBHOOK_MAX_ATTEMPTS", "5"),
+			WebhookRetryBackoff:  getEnv("WEBHOOK_RETRY_BACKOFF", "30s"),
+			WebhookRetryInterval: getEnv("WEBHOOK_RETRY_INTERVAL", "10s"),
+			EventStreamBuffer:    getEnv("EVENT_STREAM_BUFFER", "1000"),
 		}),
 		// Infrastructure providers (middlewares, database, handler registration)
 		InfrastructureProviders(),
@@ -29,8 +40,11 @@ func FXOptions() fx.Option {
 		CommonProviders(),
 		// Production-specific providers
 		fx.Provide(provideEchoWithLifecycle),
+		fx.Provide(provideGRPCServerWithLifecycle),
 		// Production-specific invokes
 		fx.Invoke(provideSwaggerRegistration()),
+		fx.Invoke(provideTrashPurge),
+		fx.Invoke(provideAutoArchive),
 	)
 }
 
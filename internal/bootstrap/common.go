@@ -1,14 +1,32 @@
 package bootstrap
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	echoSwagger "github.com/swaggo/echo-swagger"
+	todov1 "github.com/wellingtonlope/todo-api/api/todo/v1"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/eventbus"
+	"github.com/wellingtonlope/todo-api/internal/infra/eventstream"
 	gormRepo "github.com/wellingtonlope/todo-api/internal/infra/gorm"
+	grpcServer "github.com/wellingtonlope/todo-api/internal/infra/grpc"
 	"github.com/wellingtonlope/todo-api/internal/infra/handler"
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -18,6 +36,7 @@ import (
 func provideMiddlewares() []echo.MiddlewareFunc {
 	return []echo.MiddlewareFunc{
 		handler.Error,
+		handler.Actor,
 	}
 }
 
@@ -52,6 +71,56 @@ func provideEchoWithLifecycle(config Config, middlewares []echo.MiddlewareFunc,
 	return e
 }
 
+// provideGRPCServer creates a gRPC server converting the errors and reading the actor of the calls
+func provideGRPCServer() *grpc.Server {
+	return grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpcServer.UnaryError, grpcServer.UnaryActor),
+		grpc.ChainStreamInterceptor(grpcServer.StreamError),
+	)
+}
+
+// provideGRPCServerWithLifecycle creates a gRPC server with lifecycle hooks
+func provideGRPCServerWithLifecycle(config Config, lc fx.Lifecycle) *grpc.Server {
+	server := provideGRPCServer()
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			listener, err := net.Listen("tcp", fmt.Sprintf(":%s", config.GRPCPort))
+			if err != nil {
+				return fmt.Errorf("fail to listen for gRPC on port %s: %w", config.GRPCPort, err)
+			}
+			go func() {
+				if err := server.Serve(listener); err != nil {
+					log.Fatalf("gRPC server failed: %v", err)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				server.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				server.Stop()
+				return ctx.Err()
+			}
+		},
+	})
+
+	return server
+}
+
+// provideGRPCRegistration registers the gRPC services, with reflection for the clients discovering them
+func provideGRPCRegistration(server *grpc.Server, todoServer todov1.TodoServiceServer) {
+	todov1.RegisterTodoServiceServer(server, todoServer)
+	reflection.Register(server)
+}
+
 // provideDatabase creates a GORM database connection
 func provideDatabase(config Config) (*gorm.DB, error) {
 	var db *gorm.DB
@@ -74,13 +143,234 @@ func provideDatabase(config Config) (*gorm.DB, error) {
 		return nil, err
 	}
 
-	if err := db.AutoMigrate(&gormRepo.TodoModel{}); err != nil {
+	if config.Database.Driver == "sqlite" {
+		// every connection to an in-memory SQLite database opens a new empty one, so the
+		// asynchronous event subscribers must share the single connection of the requests
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		sqlDB.SetMaxOpenConns(1)
+	}
+
+	if err := gormRepo.Migrate(db); err != nil {
 		return nil, err
 	}
 
 	return db, nil
 }
 
+// provideEventBus creates the in-process bus the usecases publish their domain events to, closed
+// when the application stops once its asynchronous subscribers are done with the published events
+func provideEventBus(lc fx.Lifecycle) (usecase.EventPublisher, eventbus.Subscriber) {
+	bus := eventbus.NewBus()
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return bus.Close(ctx)
+		},
+	})
+	return bus, bus
+}
+
+// provideEventStream creates the stream of the domain events pushed to the clients of GET /todos/events,
+// /ws and the Watch call. It depends on Echo and the gRPC server so that it is closed before they are
+// shut down, which would otherwise wait for the streaming requests to end.
+func provideEventStream(
+	config Config, subscriber eventbus.Subscriber, clock usecase.Clock, _ *echo.Echo, _ *grpc.Server,
+	lc fx.Lifecycle,
+) (eventstream.Stream, error) {
+	size, err := strconv.Atoi(config.EventStreamBuffer)
+	if err != nil || size < 0 {
+		return nil, fmt.Errorf("invalid event stream buffer %q: must be a number of events such as 1000",
+			config.EventStreamBuffer)
+	}
+	stream := eventstream.NewStream(size, clock.Now())
+	subscriber.Subscribe(stream.Publish)
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			stream.Close()
+			return nil
+		},
+	})
+	return stream, nil
+}
+
+// provideWebhookDispatch records the deliveries of the domain events to the webhooks subscribed to
+// them as they are published, so that no event is dropped however many a change raises, even once
+// the request that made it is over. The requests do not wait for the webhooks, which the webhook
+// retry attempts in the background.
+func provideWebhookDispatch(subscriber eventbus.Subscriber, dispatch webhook.Dispatch) {
+	subscriber.Subscribe(func(ctx context.Context, event domain.Event) error {
+		return dispatch.Handle(context.WithoutCancel(ctx), event)
+	})
+}
+
+// provideRetryPolicy validates the configured number of attempts and backoff of the webhook deliveries
+func provideRetryPolicy(config Config) (webhook.RetryPolicy, error) {
+	attempts, err := strconv.Atoi(config.WebhookMaxAttempts)
+	if err != nil || attempts <= 0 {
+		return webhook.RetryPolicy{}, fmt.Errorf("invalid webhook max attempts %q: must be a positive number such as 5",
+			config.WebhookMaxAttempts)
+	}
+	backoff, err := time.ParseDuration(config.WebhookRetryBackoff)
+	if err != nil || backoff <= 0 {
+		return webhook.RetryPolicy{}, fmt.Errorf("invalid webhook retry backoff %q: must be a positive duration such as 30s",
+			config.WebhookRetryBackoff)
+	}
+	return webhook.RetryPolicy{MaxAttempts: attempts, Backoff: backoff}, nil
+}
+
+// provideProjectDeletePolicy validates the configured default policy for the todos of a deleted project
+func provideProjectDeletePolicy(config Config) (project.DeletePolicy, error) {
+	policy := project.DeletePolicy(config.ProjectDeletePolicy)
+	if !policy.IsValid() {
+		return "", fmt.Errorf("invalid project delete policy %q: must be cascade, orphan or refuse",
+			config.ProjectDeletePolicy)
+	}
+	return policy, nil
+}
+
+// provideTrashRetention validates the configured time deleted todos stay in the trash
+func provideTrashRetention(config Config) (todo.TrashRetention, error) {
+	retention, err := time.ParseDuration(config.TrashRetention)
+	if err != nil || retention <= 0 {
+		return 0, fmt.Errorf("invalid trash retention %q: must be a positive duration such as 720h",
+			config.TrashRetention)
+	}
+	return todo.TrashRetention(retention), nil
+}
+
+// provideAutoArchiveAfter validates the configured time completed todos wait before they are archived
+func provideAutoArchiveAfter(config Config) (todo.AutoArchiveAfter, error) {
+	after, err := time.ParseDuration(config.AutoArchiveAfter)
+	if err != nil || after < 0 {
+		return 0, fmt.Errorf("invalid auto archive time %q: must be a duration such as 720h, or 0 to disable it",
+			config.AutoArchiveAfter)
+	}
+	return todo.AutoArchiveAfter(after), nil
+}
+
+// workflowFile is the JSON document of a configured workflow
+type workflowFile struct {
+	Statuses    []domain.TodoStatus                       `json:"statuses"`
+	Transitions map[domain.TodoStatus][]domain.TodoStatus `json:"transitions"`
+}
+
+// provideWorkflow loads the configured workflow of the todo statuses, or the default one
+// with only pending and completed when no workflow file is configured
+func provideWorkflow(config Config) (domain.Workflow, error) {
+	if config.WorkflowFile == "" {
+		return domain.DefaultWorkflow(), nil
+	}
+	content, err := os.ReadFile(config.WorkflowFile)
+	if err != nil {
+		return domain.Workflow{}, fmt.Errorf("fail to read the workflow file: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	var file workflowFile
+	if err := decoder.Decode(&file); err != nil {
+		return domain.Workflow{}, fmt.Errorf("invalid workflow file %q: %w", config.WorkflowFile, err)
+	}
+	workflow, err := domain.NewWorkflow(file.Statuses, file.Transitions)
+	if err != nil {
+		return domain.Workflow{}, fmt.Errorf("invalid workflow file %q: %w", config.WorkflowFile, err)
+	}
+	return workflow, nil
+}
+
+// provideTrashPurge purges the trash when the application starts and then at every configured interval
+func provideTrashPurge(config Config, purge todo.PurgeTrash, lc fx.Lifecycle) error {
+	return runPeriodically(lc, "trash purge", config.TrashPurgeInterval, nil, func(ctx context.Context) {
+		if purged, err := purge.Handle(ctx); err != nil {
+			log.Printf("Error purging the trash: %v", err)
+		} else if purged > 0 {
+			log.Printf("Purged %d todos from the trash", purged)
+		}
+	})
+}
+
+// provideAutoArchive archives the todos completed long enough ago when the application starts
+// and then at every configured interval
+func provideAutoArchive(config Config, archive todo.ArchiveCompleted, lc fx.Lifecycle) error {
+	return runPeriodically(lc, "auto archive", config.AutoArchiveInterval, nil, func(ctx context.Context) {
+		if archived, err := archive.Handle(ctx); err != nil {
+			log.Printf("Error archiving the completed todos: %v", err)
+		} else if archived > 0 {
+			log.Printf("Archived %d completed todos", archived)
+		}
+	})
+}
+
+// deliveryWakeup wakes the webhook retry up when deliveries are dispatched. A wake-up already
+// waiting stands for the next ones, every due delivery being attempted once it is handled.
+type deliveryWakeup chan struct{}
+
+func provideDeliveryWakeup() deliveryWakeup {
+	return make(deliveryWakeup, 1)
+}
+
+func (w deliveryWakeup) Notify() {
+	select {
+	case w <- struct{}{}:
+	default:
+	}
+}
+
+// provideWebhookRetry attempts the due webhook deliveries when the application starts, when
+// deliveries are dispatched and then at every configured interval
+func provideWebhookRetry(config Config, retry webhook.RetryDeliveries, wakeup deliveryWakeup, lc fx.Lifecycle) error {
+	return runPeriodically(lc, "webhook retry", config.WebhookRetryInterval, wakeup, func(ctx context.Context) {
+		if retried, err := retry.Handle(ctx); err != nil {
+			log.Printf("Error retrying the webhook deliveries: %v", err)
+		} else if retried > 0 {
+			log.Printf("Retried %d webhook deliveries", retried)
+		}
+	})
+}
+
+// runPeriodically runs job in the background when the application starts and then at every interval,
+// as well as whenever wake receives, until the application stops. name tells which job the interval is
+// for when it is invalid.
+func runPeriodically(lc fx.Lifecycle, name, interval string, wake <-chan struct{}, job func(context.Context)) error {
+	every, err := time.ParseDuration(interval)
+	if err != nil || every <= 0 {
+		return fmt.Errorf("invalid %s interval %q: must be a positive duration such as 1h", name, interval)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(every)
+				defer ticker.Stop()
+				job(ctx)
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						job(ctx)
+					case <-wake:
+						job(ctx)
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+	return nil
+}
+
 // provideHandlerRegistration registers all handlers in Echo
 func provideHandlerRegistration() interface{} {
 	return fx.Annotate(
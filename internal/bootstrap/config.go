@@ -2,10 +2,21 @@ package bootstrap
 
 // Config holds environment-specific configuration for bootstrap
 type Config struct {
-	Database      DatabaseConfig // MySQL database configuration
-	WithLifecycle bool           // Whether to add lifecycle hooks to Echo
-	WithSwagger   bool           // Whether to add Swagger documentation
-	Port          string         // Port for Echo server (used only with lifecycle)
+	Database             DatabaseConfig // MySQL database configuration
+	WithLifecycle        bool           // Whether to add lifecycle hooks to Echo
+	WithSwagger          bool           // Whether to add Swagger documentation
+	Port                 string         // Port for Echo server (used only with lifecycle)
+	GRPCPort             string         // Port for the gRPC server (used only with lifecycle)
+	ProjectDeletePolicy  string         // Default policy for the todos of a deleted project (cascade, orphan or refuse)
+	TrashRetention       string         // How long deleted todos stay in the trash, as a Go duration
+	TrashPurgeInterval   string         // How often the trash is purged, as a Go duration (used only with lifecycle)
+	AutoArchiveAfter     string         // How long todos stay completed before they are archived, as a Go duration (0 disables it)
+	AutoArchiveInterval  string         // How often completed todos are archived, as a Go duration (used only with lifecycle)
+	WorkflowFile         string         // Path to the JSON workflow of the todo statuses (empty uses pending and completed)
+	WebhookMaxAttempts   string         // How many times a webhook delivery is attempted before it is given up
+	WebhookRetryBackoff  string         // Delay before the first retry of a webhook delivery, doubled after each retry, as a Go duration
+	WebhookRetryInterval string         // How often the due webhook deliveries are retried, as a Go duration
+	EventStreamBuffer    string         // How many of the last todo events are kept for the event stream clients resuming after one
 }
 
 // DatabaseConfig holds MySQL connection configuration
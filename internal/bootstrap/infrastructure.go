@@ -14,6 +14,9 @@ func InfrastructureProviders() fx.Option {
 
 	invokes := []interface{}{
 		provideHandlerRegistration(),
+		provideGRPCRegistration,
+		provideWebhookDispatch,
+		provideWebhookRetry,
 	}
 
 	return fx.Module("infrastructure",
@@ -1,10 +1,17 @@
 package bootstrap
 
 import (
+	todov1 "github.com/wellingtonlope/todo-api/api/todo/v1"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/audit"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
 	gormRepo "github.com/wellingtonlope/todo-api/internal/infra/gorm"
+	graphqlHandler "github.com/wellingtonlope/todo-api/internal/infra/graphql"
+	grpcServer "github.com/wellingtonlope/todo-api/internal/infra/grpc"
 	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+	webhookSender "github.com/wellingtonlope/todo-api/internal/infra/webhook"
 	"github.com/wellingtonlope/todo-api/pkg/clock"
 	"go.uber.org/fx"
 )
@@ -22,9 +29,71 @@ func CommonProviders() fx.Option {
 			gormRepo.NewTodoRepository,
 			fx.As(new(todo.CreateStore)),
 			fx.As(new(todo.ListStore)),
+			fx.As(new(todo.SearchStore)),
+			fx.As(new(todo.ListTagsStore)),
 			fx.As(new(todo.GetByIDStore)),
 			fx.As(new(todo.DeleteByIDStore)),
+			fx.As(new(todo.RestoreStore)),
+			fx.As(new(todo.ListTrashStore)),
+			fx.As(new(todo.PurgeTrashStore)),
+			fx.As(new(todo.ArchiveCompletedStore)),
 			fx.As(new(todo.TodoUpdater)),
+			fx.As(new(todo.TransitionStore)),
+			fx.As(new(todo.HistoryStore)),
+		),
+		fx.Annotate(
+			gormRepo.NewProjectRepository,
+			fx.As(new(project.CreateStore)),
+			fx.As(new(project.GetByIDStore)),
+			fx.As(new(project.ListStore)),
+			fx.As(new(project.UpdateStore)),
+			fx.As(new(project.DeleteByIDStore)),
+			fx.As(new(todo.MoveProjectStore)),
+		),
+		fx.Annotate(
+			gormRepo.NewAuditRepository,
+			fx.As(new(todo.AuditStore)),
+			fx.As(new(audit.ListStore)),
+		),
+		fx.Annotate(
+			gormRepo.NewWebhookRepository,
+			fx.As(new(webhook.CreateStore)),
+			fx.As(new(webhook.ListStore)),
+			fx.As(new(webhook.GetByIDStore)),
+			fx.As(new(webhook.UpdateStore)),
+			fx.As(new(webhook.DeleteByIDStore)),
+			fx.As(new(webhook.ListDeliveriesStore)),
+			fx.As(new(webhook.DispatchStore)),
+			fx.As(new(webhook.RetryDeliveriesStore)),
+		),
+		// Webhook deliveries sender
+		fx.Annotate(
+			webhookSender.NewSender,
+			fx.As(new(webhook.Sender)),
+		),
+		fx.Annotate(
+			gormRepo.NewTransactor,
+			fx.As(new(usecase.Transactor)),
+		),
+		// Domain events bus
+		provideEventBus,
+		// Stream of the domain events for the clients of GET /todos/events
+		provideEventStream,
+		// Configured project delete policy
+		provideProjectDeletePolicy,
+		// Configured trash retention
+		provideTrashRetention,
+		// Configured time completed todos wait before they are archived
+		provideAutoArchiveAfter,
+		// Configured workflow of the todo statuses
+		provideWorkflow,
+		// Configured retries of the webhook deliveries
+		provideRetryPolicy,
+		// Wake-up of the webhook retry by the dispatched deliveries
+		fx.Annotate(
+			provideDeliveryWakeup,
+			fx.As(fx.Self()),
+			fx.As(new(webhook.DeliveryNotifier)),
 		),
 		// Use case providers
 		fx.Annotate(
@@ -35,6 +104,14 @@ func CommonProviders() fx.Option {
 			todo.NewList,
 			fx.As(new(todo.List)),
 		),
+		fx.Annotate(
+			todo.NewSearch,
+			fx.As(new(todo.Search)),
+		),
+		fx.Annotate(
+			todo.NewListTags,
+			fx.As(new(todo.ListTags)),
+		),
 		fx.Annotate(
 			todo.NewGetByID,
 			fx.As(new(todo.GetByID)),
@@ -43,10 +120,34 @@ func CommonProviders() fx.Option {
 			todo.NewDeleteByID,
 			fx.As(new(todo.DeleteByID)),
 		),
+		fx.Annotate(
+			todo.NewRestore,
+			fx.As(new(todo.Restore)),
+		),
+		fx.Annotate(
+			todo.NewListTrash,
+			fx.As(new(todo.ListTrash)),
+		),
+		fx.Annotate(
+			todo.NewPurgeTrash,
+			fx.As(new(todo.PurgeTrash)),
+		),
 		fx.Annotate(
 			todo.NewUpdate,
 			fx.As(new(todo.Update)),
 		),
+		fx.Annotate(
+			todo.NewPatch,
+			fx.As(new(todo.Patch)),
+		),
+		fx.Annotate(
+			todo.NewJSONPatch,
+			fx.As(new(todo.JSONPatch)),
+		),
+		fx.Annotate(
+			todo.NewTransition,
+			fx.As(new(todo.Transition)),
+		),
 		fx.Annotate(
 			todo.NewComplete,
 			fx.As(new(todo.Complete)),
@@ -55,6 +156,114 @@ func CommonProviders() fx.Option {
 			todo.NewMarkAsPending,
 			fx.As(new(todo.MarkAsPending)),
 		),
+		fx.Annotate(
+			todo.NewHistory,
+			fx.As(new(todo.History)),
+		),
+		fx.Annotate(
+			todo.NewArchive,
+			fx.As(new(todo.Archive)),
+		),
+		fx.Annotate(
+			todo.NewUnarchive,
+			fx.As(new(todo.Unarchive)),
+		),
+		fx.Annotate(
+			todo.NewArchiveCompleted,
+			fx.As(new(todo.ArchiveCompleted)),
+		),
+		fx.Annotate(
+			todo.NewMove,
+			fx.As(new(todo.Move)),
+		),
+		fx.Annotate(
+			todo.NewBulk,
+			fx.As(new(todo.Bulk)),
+		),
+		fx.Annotate(
+			todo.NewAddItem,
+			fx.As(new(todo.AddItem)),
+		),
+		fx.Annotate(
+			todo.NewToggleItem,
+			fx.As(new(todo.ToggleItem)),
+		),
+		fx.Annotate(
+			todo.NewRemoveItem,
+			fx.As(new(todo.RemoveItem)),
+		),
+		fx.Annotate(
+			todo.NewReorderItems,
+			fx.As(new(todo.ReorderItems)),
+		),
+		fx.Annotate(
+			audit.NewList,
+			fx.As(new(audit.List)),
+		),
+		fx.Annotate(
+			project.NewCreate,
+			fx.As(new(project.Create)),
+		),
+		fx.Annotate(
+			project.NewList,
+			fx.As(new(project.List)),
+		),
+		fx.Annotate(
+			project.NewGetByID,
+			fx.As(new(project.GetByID)),
+		),
+		fx.Annotate(
+			project.NewUpdate,
+			fx.As(new(project.Update)),
+		),
+		fx.Annotate(
+			project.NewDeleteByID,
+			fx.As(new(project.DeleteByID)),
+		),
+		fx.Annotate(
+			project.NewListTodos,
+			fx.As(new(project.ListTodos)),
+		),
+		fx.Annotate(
+			project.NewCreateTodo,
+			fx.As(new(project.CreateTodo)),
+		),
+		fx.Annotate(
+			project.NewMoveTodo,
+			fx.As(new(project.MoveTodo)),
+		),
+		fx.Annotate(
+			webhook.NewCreate,
+			fx.As(new(webhook.Create)),
+		),
+		fx.Annotate(
+			webhook.NewList,
+			fx.As(new(webhook.List)),
+		),
+		fx.Annotate(
+			webhook.NewGetByID,
+			fx.As(new(webhook.GetByID)),
+		),
+		fx.Annotate(
+			webhook.NewUpdate,
+			fx.As(new(webhook.Update)),
+		),
+		fx.Annotate(
+			webhook.NewDeleteByID,
+			fx.As(new(webhook.DeleteByID)),
+		),
+		fx.Annotate(
+			webhook.NewListDeliveries,
+			fx.As(new(webhook.ListDeliveries)),
+		),
+		fx.Annotate(
+			webhook.NewDispatch,
+			fx.As(new(webhook.Dispatch)),
+		),
+		fx.Annotate(
+			webhook.NewRetryDeliveries,
+			fx.As(new(webhook.RetryDeliveries)),
+		),
 		// Handler providers
 		fx.Annotate(
 			handler.NewTodoCreate,
@@ -66,6 +275,26 @@ func CommonProviders() fx.Option {
 			fx.As(new(handler.Handler)),
 			fx.ResultTags(`group:"handlers"`),
 		),
+		fx.Annotate(
+			handler.NewTodoSearch,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTagList,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoEvents,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoSocket,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
 		fx.Annotate(
 			handler.NewTodoGetByID,
 			fx.As(new(handler.Handler)),
@@ -76,11 +305,41 @@ func CommonProviders() fx.Option {
 			fx.As(new(handler.Handler)),
 			fx.ResultTags(`group:"handlers"`),
 		),
+		fx.Annotate(
+			handler.NewTodoTrashList,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoRestore,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
 		fx.Annotate(
 			handler.NewTodoUpdate,
 			fx.As(new(handler.Handler)),
 			fx.ResultTags(`group:"handlers"`),
 		),
+		fx.Annotate(
+			handler.NewTodoPatch,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoTransition,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoHistory,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewAuditList,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
 		fx.Annotate(
 			handler.NewTodoComplete,
 			fx.As(new(handler.Handler)),
@@ -91,6 +350,121 @@ func CommonProviders() fx.Option {
 			fx.As(new(handler.Handler)),
 			fx.ResultTags(`group:"handlers"`),
 		),
+		fx.Annotate(
+			handler.NewTodoArchive,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoUnarchive,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoBulk,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoItemAdd,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoItemToggle,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoItemRemove,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoItemReorder,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewProjectCreate,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewProjectList,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewProjectGetByID,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewProjectUpdate,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewProjectDeleteByID,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewProjectTodoList,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewProjectTodoCreate,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewTodoMove,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewWebhookCreate,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewWebhookList,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewWebhookGetByID,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewWebhookUpdate,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewWebhookDeleteByID,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			handler.NewWebhookDeliveryList,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		fx.Annotate(
+			graphqlHandler.NewTodoHandler,
+			fx.As(new(handler.Handler)),
+			fx.ResultTags(`group:"handlers"`),
+		),
+		// gRPC services
+		fx.Annotate(
+			grpcServer.NewTodoServer,
+			fx.As(new(todov1.TodoServiceServer)),
+		),
 	}
 
 	return fx.Module("common", fx.Provide(providers...))
@@ -13,9 +13,17 @@ func TestFXOptions() fx.Option {
 				Driver: "sqlite",
 				Path:   ":memory:",
 			},
-			WithLifecycle: false,
-			WithSwagger:   false,
-			Port:          "",
+			WithLifecycle:        false,
+			WithSwagger:          false,
+			Port:                 "",
+			ProjectDeletePolicy:  "refuse",
+			TrashRetention:       "720h",
+			AutoArchiveAfter:     "720h",
+			WorkflowFile:         "testdata/workflow.json",
+			WebhookMaxAttempts:   "5",
+			WebhookRetryBackoff:  "30s",
+			WebhookRetryInterval: "1h",
+			EventStreamBuffer:    "100",
 		}),
 		// Infrastructure providers (middlewares, database, handler registration)
 		InfrastructureProviders(),
@@ -23,5 +31,6 @@ func TestFXOptions() fx.Option {
 		CommonProviders(),
 		// Test-specific providers
 		fx.Provide(provideEcho),
+		fx.Provide(provideGRPCServer),
 	)
 }
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditOperation is the kind of change of a todo an audit record is about.
+type AuditOperation string
+
+const (
+	AuditOperationCreate   AuditOperation = "create"
+	AuditOperationUpdate   AuditOperation = "update"
+	AuditOperationComplete AuditOperation = "complete"
+	AuditOperationPending  AuditOperation = "pending"
+	// AuditOperationTransition is a move to a status of the workflow other than completed and pending
+	AuditOperationTransition AuditOperation = "transition"
+	AuditOperationDelete     AuditOperation = "delete"
+	AuditOperationArchive    AuditOperation = "archive"
+	AuditOperationUnarchive  AuditOperation = "unarchive"
+	// AuditOperationRestore is a todo taken out of the trash
+	AuditOperationRestore AuditOperation = "restore"
+	// AuditOperationMove is a todo moved to another project or out of its project
+	AuditOperationMove AuditOperation = "move"
+)
+
+// AuditRecord is an entry of the audit log: who changed a todo, how and when.
+type AuditRecord struct {
+	TodoID    string
+	Actor     string
+	Operation AuditOperation
+	// Diff is a JSON object with the fields of the todo that changed, each one with its
+	// "before" and "after" values, null when the todo did not exist before or after the change
+	Diff      json.RawMessage
+	CreatedAt time.Time
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxChecklistItems is the maximum number of checklist items of a todo.
+	MaxChecklistItems = 100
+	// MaxChecklistItemTitleLength is the maximum number of characters of a checklist item title.
+	MaxChecklistItemTitleLength = 200
+)
+
+// ChecklistItem is a step of a todo, kept in the order given by the user.
+// Its ID is assigned by the store when the todo is saved.
+type ChecklistItem struct {
+	ID    string
+	Title string
+	Done  bool
+}
+
+// AddItem appends an open checklist item to the todo.
+//
+// Parameters:
+//   - title: the item title (required)
+//   - date: the current timestamp
+//
+// Returns:
+//   - Todo: the todo with the new item last
+//   - error: ErrTodoInvalidInput if the title is empty or too long, or the checklist is full
+func (t Todo) AddItem(title string, date time.Time) (Todo, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || len([]rune(title)) > MaxChecklistItemTitleLength {
+		return Todo{}, fmt.Errorf("%w: item title must have between 1 and %d characters",
+			ErrTodoInvalidInput, MaxChecklistItemTitleLength)
+	}
+	if len(t.Items) >= MaxChecklistItems {
+		return Todo{}, fmt.Errorf("%w: a todo can have at most %d checklist items",
+			ErrTodoInvalidInput, MaxChecklistItems)
+	}
+	t.Items = append(slices.Clip(t.Items), ChecklistItem{Title: title})
+	t.UpdatedAt = date
+	return t, nil
+}
+
+// ToggleItem flips the done flag of a checklist item.
+//
+// Parameters:
+//   - id: the item id
+//   - date: the current timestamp
+//
+// Returns:
+//   - Todo: the todo with the item toggled
+//   - error: ErrChecklistItemNotFound if the todo has no item with the id
+func (t Todo) ToggleItem(id string, date time.Time) (Todo, error) {
+	i := t.itemIndex(id)
+	if i < 0 {
+		return Todo{}, ErrChecklistItemNotFound
+	}
+	t.Items = slices.Clone(t.Items)
+	t.Items[i].Done = !t.Items[i].Done
+	t.UpdatedAt = date
+	return t, nil
+}
+
+// RemoveItem deletes a checklist item, keeping the order of the others.
+//
+// Parameters:
+//   - id: the item id
+//   - date: the current timestamp
+//
+// Returns:
+//   - Todo: the todo without the item
+//   - error: ErrChecklistItemNotFound if the todo has no item with the id
+func (t Todo) RemoveItem(id string, date time.Time) (Todo, error) {
+	i := t.itemIndex(id)
+	if i < 0 {
+		return Todo{}, ErrChecklistItemNotFound
+	}
+	t.Items = slices.Delete(slices.Clone(t.Items), i, i+1)
+	t.UpdatedAt = date
+	return t, nil
+}
+
+// ReorderItems puts the checklist items in the order of the given ids.
+//
+// Parameters:
+//   - ids: the ids of every item of the todo, each exactly once, in the new order
+//   - date: the current timestamp
+//
+// Returns:
+//   - Todo: the todo with the items reordered
+//   - error: ErrTodoInvalidInput if the ids are not a permutation of the item ids
+func (t Todo) ReorderItems(ids []string, date time.Time) (Todo, error) {
+	if len(ids) != len(t.Items) {
+		return Todo{}, fmt.Errorf("%w: item ids must list every checklist item exactly once", ErrTodoInvalidInput)
+	}
+	items := make([]ChecklistItem, 0, len(ids))
+	for _, id := range ids {
+		i := t.itemIndex(id)
+		if i < 0 || slices.ContainsFunc(items, func(item ChecklistItem) bool { return item.ID == id }) {
+			return Todo{}, fmt.Errorf("%w: item ids must list every checklist item exactly once", ErrTodoInvalidInput)
+		}
+		items = append(items, t.Items[i])
+	}
+	t.Items = items
+	t.UpdatedAt = date
+	return t, nil
+}
+
+// OpenItems returns the number of checklist items not done yet.
+func (t Todo) OpenItems() int {
+	open := 0
+	for _, item := range t.Items {
+		if !item.Done {
+			open++
+		}
+	}
+	return open
+}
+
+// CompleteItems marks every checklist item as done. Like WithPriority it does not
+// touch the timestamps, as it is applied together with the transition to completed.
+func (t Todo) CompleteItems() Todo {
+	t.Items = slices.Clone(t.Items)
+	for i := range t.Items {
+		t.Items[i].Done = true
+	}
+	return t
+}
+
+func (t Todo) itemIndex(id string) int {
+	return slices.IndexFunc(t.Items, func(item ChecklistItem) bool { return item.ID == id })
+}
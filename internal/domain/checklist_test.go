@@ -0,0 +1,221 @@
+package domain_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestTodo_AddItem(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	fullChecklist := make([]domain.ChecklistItem, domain.MaxChecklistItems)
+	testCases := []struct {
+		name   string
+		todo   domain.Todo
+		title  string
+		result domain.Todo
+		err    error
+	}{
+		{
+			name: "should append an open item",
+			todo: domain.Todo{
+				Items:     []domain.ChecklistItem{{ID: "1", Title: "buy paint", Done: true}},
+				UpdatedAt: exampleDate,
+			},
+			title: "  paint the wall ",
+			result: domain.Todo{
+				Items: []domain.ChecklistItem{
+					{ID: "1", Title: "buy paint", Done: true},
+					{Title: "paint the wall"},
+				},
+				UpdatedAt: exampleDateUpdated,
+			},
+			err: nil,
+		},
+		{
+			name:   "should fail when title is blank",
+			todo:   domain.Todo{UpdatedAt: exampleDate},
+			title:  "  ",
+			result: domain.Todo{},
+			err:    fmt.Errorf("%w: item title must have between 1 and 200 characters", domain.ErrTodoInvalidInput),
+		},
+		{
+			name:   "should fail when title is too long",
+			todo:   domain.Todo{UpdatedAt: exampleDate},
+			title:  strings.Repeat("a", 201),
+			result: domain.Todo{},
+			err:    fmt.Errorf("%w: item title must have between 1 and 200 characters", domain.ErrTodoInvalidInput),
+		},
+		{
+			name:   "should fail when checklist is full",
+			todo:   domain.Todo{Items: fullChecklist, UpdatedAt: exampleDate},
+			title:  "one more",
+			result: domain.Todo{},
+			err:    fmt.Errorf("%w: a todo can have at most 100 checklist items", domain.ErrTodoInvalidInput),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.todo.AddItem(tc.title, exampleDateUpdated)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestTodo_ToggleItem(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	exampleTodo := domain.Todo{
+		Items:     []domain.ChecklistItem{{ID: "1", Title: "buy paint"}, {ID: "2", Title: "paint", Done: true}},
+		UpdatedAt: exampleDate,
+	}
+	testCases := []struct {
+		name   string
+		id     string
+		result domain.Todo
+		err    error
+	}{
+		{
+			name: "should mark an open item as done",
+			id:   "1",
+			result: domain.Todo{
+				Items:     []domain.ChecklistItem{{ID: "1", Title: "buy paint", Done: true}, {ID: "2", Title: "paint", Done: true}},
+				UpdatedAt: exampleDateUpdated,
+			},
+			err: nil,
+		},
+		{
+			name: "should reopen a done item",
+			id:   "2",
+			result: domain.Todo{
+				Items:     []domain.ChecklistItem{{ID: "1", Title: "buy paint"}, {ID: "2", Title: "paint"}},
+				UpdatedAt: exampleDateUpdated,
+			},
+			err: nil,
+		},
+		{
+			name:   "should fail when item not found",
+			id:     "3",
+			result: domain.Todo{},
+			err:    domain.ErrChecklistItemNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := exampleTodo.ToggleItem(tc.id, exampleDateUpdated)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, []domain.ChecklistItem{{ID: "1", Title: "buy paint"}, {ID: "2", Title: "paint", Done: true}},
+				exampleTodo.Items, "the original todo must not change")
+		})
+	}
+}
+
+func TestTodo_RemoveItem(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	exampleTodo := domain.Todo{
+		Items:     []domain.ChecklistItem{{ID: "1", Title: "a"}, {ID: "2", Title: "b"}, {ID: "3", Title: "c"}},
+		UpdatedAt: exampleDate,
+	}
+	testCases := []struct {
+		name   string
+		id     string
+		result domain.Todo
+		err    error
+	}{
+		{
+			name: "should remove the item keeping the order",
+			id:   "2",
+			result: domain.Todo{
+				Items:     []domain.ChecklistItem{{ID: "1", Title: "a"}, {ID: "3", Title: "c"}},
+				UpdatedAt: exampleDateUpdated,
+			},
+			err: nil,
+		},
+		{
+			name:   "should fail when item not found",
+			id:     "4",
+			result: domain.Todo{},
+			err:    domain.ErrChecklistItemNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := exampleTodo.RemoveItem(tc.id, exampleDateUpdated)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+			assert.Len(t, exampleTodo.Items, 3)
+		})
+	}
+}
+
+func TestTodo_ReorderItems(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	exampleTodo := domain.Todo{
+		Items:     []domain.ChecklistItem{{ID: "1", Title: "a"}, {ID: "2", Title: "b", Done: true}, {ID: "3", Title: "c"}},
+		UpdatedAt: exampleDate,
+	}
+	errInvalidOrder := fmt.Errorf("%w: item ids must list every checklist item exactly once", domain.ErrTodoInvalidInput)
+	testCases := []struct {
+		name   string
+		ids    []string
+		result domain.Todo
+		err    error
+	}{
+		{
+			name: "should put the items in the given order",
+			ids:  []string{"3", "1", "2"},
+			result: domain.Todo{
+				Items:     []domain.ChecklistItem{{ID: "3", Title: "c"}, {ID: "1", Title: "a"}, {ID: "2", Title: "b", Done: true}},
+				UpdatedAt: exampleDateUpdated,
+			},
+			err: nil,
+		},
+		{
+			name:   "should fail when an item is missing",
+			ids:    []string{"3", "1"},
+			result: domain.Todo{},
+			err:    errInvalidOrder,
+		},
+		{
+			name:   "should fail when an item is repeated",
+			ids:    []string{"3", "1", "1"},
+			result: domain.Todo{},
+			err:    errInvalidOrder,
+		},
+		{
+			name:   "should fail when an item is unknown",
+			ids:    []string{"3", "1", "4"},
+			result: domain.Todo{},
+			err:    errInvalidOrder,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := exampleTodo.ReorderItems(tc.ids, exampleDateUpdated)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestTodo_OpenItems(t *testing.T) {
+	todo := domain.Todo{Items: []domain.ChecklistItem{{ID: "1"}, {ID: "2", Done: true}, {ID: "3"}}}
+	assert.Equal(t, 2, todo.OpenItems())
+	assert.Equal(t, 0, domain.Todo{}.OpenItems())
+}
+
+func TestTodo_CompleteItems(t *testing.T) {
+	todo := domain.Todo{Items: []domain.ChecklistItem{{ID: "1"}, {ID: "2", Done: true}}}
+	result := todo.CompleteItems()
+	assert.Equal(t, []domain.ChecklistItem{{ID: "1", Done: true}, {ID: "2", Done: true}}, result.Items)
+	assert.Equal(t, 1, todo.OpenItems())
+}
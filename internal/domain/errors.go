@@ -2,4 +2,14 @@ package domain
 
 import "errors"
 
-var ErrTodoNotFound = errors.New("todo not found by ID")
+var (
+	ErrTodoNotFound          = errors.New("todo not found by ID")
+	ErrTodoVersionConflict   = errors.New("todo version conflict")
+	ErrChecklistItemNotFound = errors.New("checklist item not found by ID")
+	ErrTodoHasOpenItems      = errors.New("todo has open checklist items")
+	ErrTodoInvalidTransition = errors.New("todo status transition not allowed")
+	ErrInvalidWorkflow       = errors.New("invalid workflow")
+	ErrProjectNotFound       = errors.New("project not found by ID")
+	ErrProjectHasTodos       = errors.New("project has todos")
+	ErrWebhookNotFound       = errors.New("webhook not found by ID")
+)
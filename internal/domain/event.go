@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// EventType is the kind of change of a todo a domain event tells about.
+type EventType string
+
+const (
+	EventTodoCreated   EventType = "todo.created"
+	EventTodoUpdated   EventType = "todo.updated"
+	EventTodoCompleted EventType = "todo.completed"
+	// EventTodoReopened is a completed todo moving back to another status
+	EventTodoReopened EventType = "todo.reopened"
+	EventTodoDeleted  EventType = "todo.deleted"
+)
+
+// EventTypes are every type of domain event, in the order of the life of a todo.
+var EventTypes = []EventType{
+	EventTodoCreated, EventTodoUpdated, EventTodoCompleted, EventTodoReopened, EventTodoDeleted,
+}
+
+// IsValid checks if the type is one of the known event types.
+func (t EventType) IsValid() bool {
+	return slices.Contains(EventTypes, t)
+}
+
+// Event tells about a change of a todo, once it is committed.
+type Event struct {
+	// ID identifies the event, given when it is published
+	ID     string
+	Type   EventType
+	TodoID string
+	// Todo is the todo after the change, or as it was before being deleted
+	Todo       Todo
+	Actor      string
+	OccurredAt time.Time
+}
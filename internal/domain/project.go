@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrProjectInvalidInput is returned when the project input is invalid.
+var ErrProjectInvalidInput = errors.New("project invalid input")
+
+// MaxProjectNameLength is the maximum number of characters of a project name.
+const MaxProjectNameLength = 100
+
+// Project groups todos, each todo belonging to at most one project.
+type Project struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// validateProjectInput checks the name is not blank nor too long and date is not zero.
+func validateProjectInput(name string, date time.Time) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > MaxProjectNameLength {
+		return fmt.Errorf("%w: name must have between 1 and %d characters", ErrProjectInvalidInput, MaxProjectNameLength)
+	}
+	if date.IsZero() {
+		return fmt.Errorf("%w: date", ErrProjectInvalidInput)
+	}
+	return nil
+}
+
+// NewProject creates a new Project with the given parameters.
+//
+// Parameters:
+//   - name: the project name (required)
+//   - description: the project description (optional)
+//   - date: the current timestamp (required, must not be zero)
+//
+// Returns:
+//   - Project: the created project instance
+//   - error: ErrProjectInvalidInput if validation fails
+func NewProject(name, description string, date time.Time) (Project, error) {
+	if err := validateProjectInput(name, date); err != nil {
+		return Project{}, err
+	}
+	return Project{
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+		CreatedAt:   date,
+		UpdatedAt:   date,
+	}, nil
+}
+
+// Update modifies the project with new values.
+//
+// Parameters:
+//   - name: the new project name (required)
+//   - description: the new project description (optional)
+//   - date: the current timestamp (required, must not be zero)
+//
+// Returns:
+//   - Project: the updated project instance
+//   - error: ErrProjectInvalidInput if validation fails
+func (p Project) Update(name, description string, date time.Time) (Project, error) {
+	if err := validateProjectInput(name, date); err != nil {
+		return Project{}, err
+	}
+	p.Name = strings.TrimSpace(name)
+	p.Description = strings.TrimSpace(description)
+	p.UpdatedAt = date
+	return p, nil
+}
+
+// MoveToProject puts the todo in the project with the given id, or out of any
+// project when projectID is nil.
+//
+// Parameters:
+//   - projectID: the id of the new project of the todo, nil for none
+//   - date: the current timestamp
+//
+// Returns:
+//   - Todo: the todo in its new project
+func (t Todo) MoveToProject(projectID *string, date time.Time) Todo {
+	t.ProjectID = projectID
+	t.UpdatedAt = date
+	return t
+}
@@ -0,0 +1,97 @@
+package domain_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestNewProject(t *testing.T) {
+	date := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	nameErr := fmt.Errorf("%w: name must have between 1 and 100 characters", domain.ErrProjectInvalidInput)
+	testCases := []struct {
+		name        string
+		projectName string
+		description string
+		date        time.Time
+		result      domain.Project
+		err         error
+	}{
+		{
+			name:        "should create a project with trimmed values",
+			projectName: " Home ",
+			description: " chores around the house ",
+			date:        date,
+			result: domain.Project{
+				Name:        "Home",
+				Description: "chores around the house",
+				CreatedAt:   date,
+				UpdatedAt:   date,
+			},
+			err: nil,
+		},
+		{
+			name:        "should fail when name is blank",
+			projectName: "  ",
+			date:        date,
+			err:         nameErr,
+		},
+		{
+			name:        "should fail when name is too long",
+			projectName: strings.Repeat("a", 101),
+			date:        date,
+			err:         nameErr,
+		},
+		{
+			name:        "should fail when date is zero",
+			projectName: "Home",
+			err:         fmt.Errorf("%w: date", domain.ErrProjectInvalidInput),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := domain.NewProject(tc.projectName, tc.description, tc.date)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestProject_Update(t *testing.T) {
+	created := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+	project := domain.Project{ID: "123", Name: "Home", CreatedAt: created, UpdatedAt: created}
+
+	t.Run("should update the name and description", func(t *testing.T) {
+		result, err := project.Update("Work", "office", updated)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Project{
+			ID: "123", Name: "Work", Description: "office", CreatedAt: created, UpdatedAt: updated,
+		}, result)
+	})
+
+	t.Run("should fail when name is blank", func(t *testing.T) {
+		result, err := project.Update("", "office", updated)
+		assert.Equal(t, domain.Project{}, result)
+		assert.Equal(t, fmt.Errorf("%w: name must have between 1 and 100 characters", domain.ErrProjectInvalidInput), err)
+	})
+}
+
+func TestTodo_MoveToProject(t *testing.T) {
+	created := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+	projectID := "p1"
+	todo := domain.Todo{ID: "123", Title: "title example", CreatedAt: created, UpdatedAt: created}
+
+	moved := todo.MoveToProject(&projectID, updated)
+	assert.Equal(t, &projectID, moved.ProjectID)
+	assert.Equal(t, updated, moved.UpdatedAt)
+	assert.Nil(t, todo.ProjectID, "the original todo must not change")
+
+	removed := moved.MoveToProject(nil, updated)
+	assert.Nil(t, removed.ProjectID)
+}
@@ -0,0 +1,296 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurrenceFrequency is the unit of time a recurrence repeats in.
+type RecurrenceFrequency string
+
+const (
+	// RecurrenceDaily repeats every INTERVAL days.
+	RecurrenceDaily RecurrenceFrequency = "DAILY"
+	// RecurrenceWeekly repeats every INTERVAL weeks, weeks starting on Monday.
+	RecurrenceWeekly RecurrenceFrequency = "WEEKLY"
+	// RecurrenceMonthly repeats every INTERVAL months.
+	RecurrenceMonthly RecurrenceFrequency = "MONTHLY"
+)
+
+const (
+	// MaxRecurrenceInterval is the largest INTERVAL of a recurrence rule.
+	MaxRecurrenceInterval = 999
+	// maxRecurrenceSearchDays bounds the search of the next occurrence.
+	maxRecurrenceSearchDays = 100 * 366
+)
+
+var recurrenceFrequencies = []RecurrenceFrequency{RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly}
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Recurrence is a subset of an RFC 5545 RRULE: FREQ (DAILY, WEEKLY or MONTHLY),
+// INTERVAL, BYDAY (plain weekdays), COUNT and UNTIL.
+//
+// The rule is anchored on the due date of the todo it belongs to. COUNT is the number
+// of occurrences left including that todo, so it decreases as occurrences are spawned.
+type Recurrence struct {
+	Frequency RecurrenceFrequency
+	Interval  int
+	ByDay     []time.Weekday
+	Count     int
+	Until     *time.Time
+}
+
+// ParseRecurrence parses a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10".
+// An optional "RRULE:" prefix is accepted. UNTIL is either a UTC date-time (20250131T090000Z)
+// or a date (20250131), which includes the whole day.
+//
+// Returns:
+//   - Recurrence: the parsed rule, with Interval 1 when not given
+//   - error: ErrTodoInvalidInput if the rule is malformed or uses unsupported parts
+func ParseRecurrence(rule string) (Recurrence, error) {
+	rule = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(rule)), "RRULE:")
+	r := Recurrence{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(rule, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return Recurrence{}, fmt.Errorf("%w: recurrence part %q must be NAME=VALUE", ErrTodoInvalidInput, part)
+		}
+		if seen[name] {
+			return Recurrence{}, fmt.Errorf("%w: recurrence part %s is repeated", ErrTodoInvalidInput, name)
+		}
+		seen[name] = true
+		var err error
+		switch name {
+		case "FREQ":
+			r.Frequency = RecurrenceFrequency(value)
+			if !slices.Contains(recurrenceFrequencies, r.Frequency) {
+				err = fmt.Errorf("%w: recurrence FREQ must be DAILY, WEEKLY or MONTHLY", ErrTodoInvalidInput)
+			}
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err != nil || r.Interval < 1 || r.Interval > MaxRecurrenceInterval {
+				err = fmt.Errorf("%w: recurrence INTERVAL must be between 1 and %d",
+					ErrTodoInvalidInput, MaxRecurrenceInterval)
+			}
+		case "BYDAY":
+			r.ByDay, err = parseRecurrenceDays(value)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err != nil || r.Count < 1 {
+				err = fmt.Errorf("%w: recurrence COUNT must be a positive integer", ErrTodoInvalidInput)
+			}
+		case "UNTIL":
+			r.Until, err = parseRecurrenceUntil(value)
+		default:
+			err = fmt.Errorf("%w: recurrence part %s is not supported", ErrTodoInvalidInput, name)
+		}
+		if err != nil {
+			return Recurrence{}, err
+		}
+	}
+	if r.Frequency == "" {
+		return Recurrence{}, fmt.Errorf("%w: recurrence FREQ must be DAILY, WEEKLY or MONTHLY", ErrTodoInvalidInput)
+	}
+	if r.Count > 0 && r.Until != nil {
+		return Recurrence{}, fmt.Errorf("%w: recurrence cannot have both COUNT and UNTIL", ErrTodoInvalidInput)
+	}
+	return r, nil
+}
+
+func parseRecurrenceDays(value string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	for _, name := range strings.Split(value, ",") {
+		day, ok := recurrenceWeekdays[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: recurrence BYDAY has an invalid day %q", ErrTodoInvalidInput, name)
+		}
+		days = append(days, day)
+	}
+	// Keep the days in week order, Monday first, so equal rules are written the same way
+	slices.SortFunc(days, func(a, b time.Weekday) int { return weekdayIndex(a) - weekdayIndex(b) })
+	return slices.Compact(days), nil
+}
+
+func parseRecurrenceUntil(value string) (*time.Time, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return &until, nil
+	}
+	if day, err := time.Parse("20060102", value); err == nil {
+		until := day.Add(24*time.Hour - time.Second)
+		return &until, nil
+	}
+	return nil, fmt.Errorf("%w: recurrence UNTIL must be a date (YYYYMMDD) or a UTC date-time (YYYYMMDDTHHMMSSZ)",
+		ErrTodoInvalidInput)
+}
+
+// String formats the rule in its canonical form, the one ParseRecurrence reads back.
+func (r Recurrence) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = strings.ToUpper(day.String()[:2])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the first occurrence of the rule anchored at start that is after now.
+// Occurrences between start and now are skipped, and count against COUNT.
+//
+// Returns:
+//   - time.Time: the next occurrence, with the time of day of start
+//   - Recurrence: the rule of the next occurrence, with COUNT decreased by the occurrences consumed
+//   - bool: false when COUNT or UNTIL leave no occurrence after now
+func (r Recurrence) Next(start, now time.Time) (time.Time, Recurrence, bool) {
+	left := r.Count
+	candidate := start
+	for range maxRecurrenceSearchDays {
+		candidate = candidate.AddDate(0, 0, 1)
+		if !r.matches(start, candidate) {
+			continue
+		}
+		if r.Until != nil && candidate.After(*r.Until) {
+			return time.Time{}, Recurrence{}, false
+		}
+		if left > 0 {
+			if left == 1 {
+				return time.Time{}, Recurrence{}, false
+			}
+			left--
+		}
+		if candidate.After(now) {
+			next := r
+			next.Count = left
+			return candidate, next, true
+		}
+	}
+	return time.Time{}, Recurrence{}, false
+}
+
+// matches reports whether day is an occurrence of the rule anchored at start.
+func (r Recurrence) matches(start, day time.Time) bool {
+	interval := max(r.Interval, 1)
+	if len(r.ByDay) > 0 && !slices.Contains(r.ByDay, day.Weekday()) {
+		return false
+	}
+	switch r.Frequency {
+	case RecurrenceWeekly:
+		if len(r.ByDay) == 0 && day.Weekday() != start.Weekday() {
+			return false
+		}
+		return (daysBetween(weekStart(start), weekStart(day))/7)%interval == 0
+	case RecurrenceMonthly:
+		if len(r.ByDay) == 0 && day.Day() != start.Day() {
+			return false
+		}
+		months := (day.Year()-start.Year())*12 + int(day.Month()) - int(start.Month())
+		return months%interval == 0
+	default:
+		return daysBetween(start, day)%interval == 0
+	}
+}
+
+// weekStart returns the Monday of the week of t.
+func weekStart(t time.Time) time.Time {
+	return t.AddDate(0, 0, -weekdayIndex(t.Weekday()))
+}
+
+// weekdayIndex numbers the weekdays from Monday (0) to Sunday (6).
+func weekdayIndex(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+// daysBetween counts the calendar days from a to b.
+func daysBetween(a, b time.Time) int {
+	da := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	db := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(db.Sub(da).Hours() / 24)
+}
+
+// WithRecurrence sets the recurrence rule of the todo, an empty rule removing it.
+// Like WithPriority it does not touch the timestamps, as it is applied together
+// with NewTodo or Update.
+//
+// Returns:
+//   - Todo: the todo with the new recurrence
+//   - error: ErrTodoInvalidInput if the rule is invalid
+func (t Todo) WithRecurrence(rule string) (Todo, error) {
+	if strings.TrimSpace(rule) == "" {
+		t.Recurrence = nil
+		return t, nil
+	}
+	r, err := ParseRecurrence(rule)
+	if err != nil {
+		return Todo{}, err
+	}
+	t.Recurrence = &r
+	return t, nil
+}
+
+// NextOccurrence returns the pending todo that follows a recurring todo, due on the
+// first occurrence of its rule after now. The rule is anchored on the due date, or on
+// now when the todo has none. It stays in the project of the todo, and the checklist
+// items are copied open and without ids.
+//
+// Returns:
+//   - Todo: the next occurrence, without id
+//   - bool: false when the todo does not recur or its recurrence has ended
+func (t Todo) NextOccurrence(now time.Time) (Todo, bool) {
+	if t.Recurrence == nil {
+		return Todo{}, false
+	}
+	start := now
+	if t.DueDate != nil {
+		start = *t.DueDate
+	}
+	due, recurrence, ok := t.Recurrence.Next(start, now)
+	if !ok {
+		return Todo{}, false
+	}
+	var items []ChecklistItem
+	for _, item := range t.Items {
+		items = append(items, ChecklistItem{Title: item.Title})
+	}
+	var projectID *string
+	if t.ProjectID != nil {
+		id := *t.ProjectID
+		projectID = &id
+	}
+	return Todo{
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      TodoStatusPending,
+		Priority:    t.Priority,
+		Tags:        slices.Clone(t.Tags),
+		Items:       items,
+		Recurrence:  &recurrence,
+		ProjectID:   projectID,
+		DueDate:     &due,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, true
+}
@@ -0,0 +1,277 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	until := time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC)
+	untilTime := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name   string
+		rule   string
+		result domain.Recurrence
+		err    error
+	}{
+		{
+			name:   "should parse a daily rule with the default interval",
+			rule:   "FREQ=DAILY",
+			result: domain.Recurrence{Frequency: domain.RecurrenceDaily, Interval: 1},
+			err:    nil,
+		},
+		{
+			name: "should parse a weekly rule with every part but UNTIL",
+			rule: "RRULE:freq=weekly;interval=2;byday=WE,MO,MO;count=10",
+			result: domain.Recurrence{
+				Frequency: domain.RecurrenceWeekly,
+				Interval:  2,
+				ByDay:     []time.Weekday{time.Monday, time.Wednesday},
+				Count:     10,
+			},
+			err: nil,
+		},
+		{
+			name:   "should parse UNTIL as a whole day",
+			rule:   "FREQ=MONTHLY;UNTIL=20250131",
+			result: domain.Recurrence{Frequency: domain.RecurrenceMonthly, Interval: 1, Until: &until},
+			err:    nil,
+		},
+		{
+			name:   "should parse UNTIL as a date-time",
+			rule:   "FREQ=MONTHLY;UNTIL=20250131T090000Z",
+			result: domain.Recurrence{Frequency: domain.RecurrenceMonthly, Interval: 1, Until: &untilTime},
+			err:    nil,
+		},
+		{
+			name: "should fail when FREQ is missing",
+			rule: "INTERVAL=2",
+			err:  fmt.Errorf("%w: recurrence FREQ must be DAILY, WEEKLY or MONTHLY", domain.ErrTodoInvalidInput),
+		},
+		{
+			name: "should fail when FREQ is not supported",
+			rule: "FREQ=YEARLY",
+			err:  fmt.Errorf("%w: recurrence FREQ must be DAILY, WEEKLY or MONTHLY", domain.ErrTodoInvalidInput),
+		},
+		{
+			name: "should fail when a part is malformed",
+			rule: "FREQ=DAILY;COUNT",
+			err:  fmt.Errorf("%w: recurrence part \"COUNT\" must be NAME=VALUE", domain.ErrTodoInvalidInput),
+		},
+		{
+			name: "should fail when a part is repeated",
+			rule: "FREQ=DAILY;FREQ=WEEKLY",
+			err:  fmt.Errorf("%w: recurrence part FREQ is repeated", domain.ErrTodoInvalidInput),
+		},
+		{
+			name: "should fail when a part is not supported",
+			rule: "FREQ=DAILY;BYMONTH=1",
+			err:  fmt.Errorf("%w: recurrence part BYMONTH is not supported", domain.ErrTodoInvalidInput),
+		},
+		{
+			name: "should fail when INTERVAL is not positive",
+			rule: "FREQ=DAILY;INTERVAL=0",
+			err:  fmt.Errorf("%w: recurrence INTERVAL must be between 1 and 999", domain.ErrTodoInvalidInput),
+		},
+		{
+			name: "should fail when BYDAY has an invalid day",
+			rule: "FREQ=WEEKLY;BYDAY=MO,1TU",
+			err:  fmt.Errorf("%w: recurrence BYDAY has an invalid day \"1TU\"", domain.ErrTodoInvalidInput),
+		},
+		{
+			name: "should fail when COUNT is not positive",
+			rule: "FREQ=DAILY;COUNT=-1",
+			err:  fmt.Errorf("%w: recurrence COUNT must be a positive integer", domain.ErrTodoInvalidInput),
+		},
+		{
+			name: "should fail when UNTIL is invalid",
+			rule: "FREQ=DAILY;UNTIL=2025-01-31",
+			err: fmt.Errorf("%w: recurrence UNTIL must be a date (YYYYMMDD) or a UTC date-time (YYYYMMDDTHHMMSSZ)",
+				domain.ErrTodoInvalidInput),
+		},
+		{
+			name: "should fail when both COUNT and UNTIL are given",
+			rule: "FREQ=DAILY;COUNT=2;UNTIL=20250131",
+			err:  fmt.Errorf("%w: recurrence cannot have both COUNT and UNTIL", domain.ErrTodoInvalidInput),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := domain.ParseRecurrence(tc.rule)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestRecurrence_String(t *testing.T) {
+	for _, rule := range []string{
+		"FREQ=DAILY",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE,SU;COUNT=3",
+		"FREQ=MONTHLY;UNTIL=20250131T235959Z",
+	} {
+		r, err := domain.ParseRecurrence(rule)
+		assert.NoError(t, err)
+		assert.Equal(t, rule, r.String())
+	}
+}
+
+func TestRecurrence_Next(t *testing.T) {
+	// 2025-01-06 is a Monday
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	date := func(month time.Month, day int) time.Time { return time.Date(2025, month, day, 9, 0, 0, 0, time.UTC) }
+	testCases := []struct {
+		name  string
+		rule  string
+		start time.Time
+		now   time.Time
+		next  time.Time
+		count int
+		ok    bool
+	}{
+		{"daily", "FREQ=DAILY", start, start, date(1, 7), 0, true},
+		{"every third day", "FREQ=DAILY;INTERVAL=3", start, start, date(1, 9), 0, true},
+		{"daily on weekdays from a friday", "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", date(1, 10), date(1, 10), date(1, 13), 0, true},
+		{"weekly on the same weekday", "FREQ=WEEKLY", start, start, date(1, 13), 0, true},
+		{"weekly on the next listed day", "FREQ=WEEKLY;BYDAY=MO,TH", start, start, date(1, 9), 0, true},
+		{"every other week", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", date(1, 8), date(1, 8), date(1, 20), 0, true},
+		{"monthly on the same day", "FREQ=MONTHLY", start, start, date(2, 6), 0, true},
+		{"monthly skipping short months", "FREQ=MONTHLY", date(1, 31), date(1, 31), date(3, 31), 0, true},
+		{"quarterly", "FREQ=MONTHLY;INTERVAL=3", start, start, date(4, 6), 0, true},
+		{"monthly on every friday", "FREQ=MONTHLY;BYDAY=FR", start, start, date(1, 10), 0, true},
+		{"skipping missed occurrences", "FREQ=WEEKLY", start, date(1, 21), date(1, 27), 0, true},
+		{"decreasing count", "FREQ=DAILY;COUNT=3", start, start, date(1, 7), 2, true},
+		{"counting missed occurrences", "FREQ=DAILY;COUNT=5", start, date(1, 8), date(1, 9), 2, true},
+		{"last occurrence of count", "FREQ=DAILY;COUNT=1", start, start, time.Time{}, 0, false},
+		{"count used by missed occurrences", "FREQ=DAILY;COUNT=2", start, date(1, 8), time.Time{}, 0, false},
+		{"until the next occurrence", "FREQ=WEEKLY;UNTIL=20250113", start, start, date(1, 13), 0, true},
+		{"after until", "FREQ=WEEKLY;UNTIL=20250112", start, start, time.Time{}, 0, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := domain.ParseRecurrence(tc.rule)
+			assert.NoError(t, err)
+			next, recurrence, ok := r.Next(tc.start, tc.now)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.next, next)
+			if ok {
+				assert.Equal(t, tc.count, recurrence.Count)
+				assert.Equal(t, r.Frequency, recurrence.Frequency)
+			}
+		})
+	}
+}
+
+func TestTodo_WithRecurrence(t *testing.T) {
+	weekly := domain.Recurrence{Frequency: domain.RecurrenceWeekly, Interval: 1}
+	testCases := []struct {
+		name   string
+		todo   domain.Todo
+		rule   string
+		result domain.Todo
+		err    error
+	}{
+		{
+			name:   "should set the recurrence",
+			todo:   domain.Todo{Title: "title example"},
+			rule:   "FREQ=WEEKLY",
+			result: domain.Todo{Title: "title example", Recurrence: &weekly},
+			err:    nil,
+		},
+		{
+			name:   "should remove the recurrence",
+			todo:   domain.Todo{Title: "title example", Recurrence: &weekly},
+			rule:   " ",
+			result: domain.Todo{Title: "title example"},
+			err:    nil,
+		},
+		{
+			name:   "should fail when the rule is invalid",
+			todo:   domain.Todo{Title: "title example"},
+			rule:   "FREQ=HOURLY",
+			result: domain.Todo{},
+			err:    fmt.Errorf("%w: recurrence FREQ must be DAILY, WEEKLY or MONTHLY", domain.ErrTodoInvalidInput),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.todo.WithRecurrence(tc.rule)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestTodo_NextOccurrence(t *testing.T) {
+	created := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+	due := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 1, 6, 18, 0, 0, 0, time.UTC)
+	nextDue := time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)
+	weekly := domain.Recurrence{Frequency: domain.RecurrenceWeekly, Interval: 1, Count: 3}
+	todo := domain.Todo{
+		ID:          "123",
+		Title:       "water the plants",
+		Description: "all of them",
+		Status:      domain.TodoStatusCompleted,
+		Priority:    domain.TodoPriorityHigh,
+		Tags:        []string{"home"},
+		Items:       []domain.ChecklistItem{{ID: "1", Title: "balcony", Done: true}},
+		Recurrence:  &weekly,
+		DueDate:     &due,
+		CreatedAt:   created,
+		UpdatedAt:   now,
+	}
+
+	t.Run("should spawn the next occurrence", func(t *testing.T) {
+		next, ok := todo.NextOccurrence(now)
+		assert.True(t, ok)
+		assert.Equal(t, domain.Todo{
+			Title:       "water the plants",
+			Description: "all of them",
+			Status:      domain.TodoStatusPending,
+			Priority:    domain.TodoPriorityHigh,
+			Tags:        []string{"home"},
+			Items:       []domain.ChecklistItem{{Title: "balcony"}},
+			Recurrence:  &domain.Recurrence{Frequency: domain.RecurrenceWeekly, Interval: 1, Count: 2},
+			DueDate:     &nextDue,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}, next)
+		assert.Equal(t, 3, todo.Recurrence.Count, "the original todo must not change")
+	})
+
+	t.Run("should spawn the next occurrence in the project of the todo", func(t *testing.T) {
+		projectID := "p1"
+		inProject := todo
+		inProject.ProjectID = &projectID
+		next, ok := inProject.NextOccurrence(now)
+		assert.True(t, ok)
+		assert.Equal(t, &projectID, next.ProjectID)
+		projectID = "p2"
+		assert.Equal(t, "p1", *next.ProjectID, "the next occurrence must not share the project of the todo")
+	})
+
+	t.Run("should anchor on now without due date", func(t *testing.T) {
+		withoutDueDate := todo
+		withoutDueDate.DueDate = nil
+		next, ok := withoutDueDate.NextOccurrence(now)
+		assert.True(t, ok)
+		assert.Equal(t, now.AddDate(0, 0, 7), *next.DueDate)
+	})
+
+	t.Run("should not spawn when the recurrence has ended", func(t *testing.T) {
+		last := todo
+		last.Recurrence = &domain.Recurrence{Frequency: domain.RecurrenceWeekly, Interval: 1, Count: 1}
+		_, ok := last.NextOccurrence(now)
+		assert.False(t, ok)
+	})
+
+	t.Run("should not spawn when the todo does not recur", func(t *testing.T) {
+		_, ok := domain.Todo{Title: "once"}.NextOccurrence(now)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// StatusChange records a todo moving from a status to another. The change
+// recorded when a todo is created has no From status.
+type StatusChange struct {
+	TodoID    string
+	From      TodoStatus
+	To        TodoStatus
+	ChangedAt time.Time
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	// MaxTagLength is the maximum number of characters of a tag.
+	MaxTagLength = 32
+	// MaxTodoTags is the maximum number of tags of a todo.
+	MaxTodoTags = 20
+)
+
+// NormalizeTag returns the canonical form of a tag: trimmed, lowercase and with
+// inner whitespace replaced by hyphens, so "Work Items" and "work-items" are the same tag.
+// A tag is made of letters, digits, hyphens and underscores and has at most MaxTagLength characters.
+//
+// Returns:
+//   - string: the normalized tag
+//   - error: ErrTodoInvalidInput if the tag is empty, too long or has other characters
+func NormalizeTag(tag string) (string, error) {
+	tag = strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+	if tag == "" || len([]rune(tag)) > MaxTagLength {
+		return "", fmt.Errorf("%w: tag must have between 1 and %d characters", ErrTodoInvalidInput, MaxTagLength)
+	}
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+			return "", fmt.Errorf("%w: tag %q has invalid characters", ErrTodoInvalidInput, tag)
+		}
+	}
+	return tag, nil
+}
+
+// NormalizeTags normalizes every tag with NormalizeTag and returns them sorted
+// and without duplicates.
+//
+// Returns:
+//   - []string: the normalized tag set, nil when no tag is given
+//   - error: ErrTodoInvalidInput if a tag is invalid
+func NormalizeTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		n, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, n)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// WithTags replaces the tags of the todo with the normalized tag set.
+// Like WithPriority it does not touch the timestamps, as it is applied together
+// with NewTodo or Update.
+//
+// Parameters:
+//   - tags: the new todo tags, possibly empty
+//
+// Returns:
+//   - Todo: the todo with the new tags
+//   - error: ErrTodoInvalidInput if a tag is invalid or there are more than MaxTodoTags
+func (t Todo) WithTags(tags []string) (Todo, error) {
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return Todo{}, err
+	}
+	if len(normalized) > MaxTodoTags {
+		return Todo{}, fmt.Errorf("%w: a todo can have at most %d tags", ErrTodoInvalidInput, MaxTodoTags)
+	}
+	t.Tags = normalized
+	return t, nil
+}
+
+// HasTag reports whether the todo has the given normalized tag.
+func (t Todo) HasTag(tag string) bool {
+	return slices.Contains(t.Tags, tag)
+}
@@ -0,0 +1,161 @@
+package domain_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	testCases := []struct {
+		name   string
+		tag    string
+		result string
+		err    error
+	}{
+		{
+			name:   "should keep a normalized tag",
+			tag:    "work",
+			result: "work",
+			err:    nil,
+		},
+		{
+			name:   "should trim and lowercase the tag",
+			tag:    "  Work ",
+			result: "work",
+			err:    nil,
+		},
+		{
+			name:   "should replace inner whitespace by hyphens",
+			tag:    "Side   Project",
+			result: "side-project",
+			err:    nil,
+		},
+		{
+			name:   "should accept letters, digits, hyphens and underscores",
+			tag:    "q3_café-2024",
+			result: "q3_café-2024",
+			err:    nil,
+		},
+		{
+			name:   "should fail when tag is blank",
+			tag:    "  ",
+			result: "",
+			err:    fmt.Errorf("%w: tag must have between 1 and 32 characters", domain.ErrTodoInvalidInput),
+		},
+		{
+			name:   "should fail when tag is too long",
+			tag:    strings.Repeat("a", 33),
+			result: "",
+			err:    fmt.Errorf("%w: tag must have between 1 and 32 characters", domain.ErrTodoInvalidInput),
+		},
+		{
+			name:   "should fail when tag has invalid characters",
+			tag:    "to#do",
+			result: "",
+			err:    fmt.Errorf("%w: tag \"to#do\" has invalid characters", domain.ErrTodoInvalidInput),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := domain.NormalizeTag(tc.tag)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	testCases := []struct {
+		name   string
+		tags   []string
+		result []string
+		err    error
+	}{
+		{
+			name:   "should return nil when there are no tags",
+			tags:   []string{},
+			result: nil,
+			err:    nil,
+		},
+		{
+			name:   "should sort and remove duplicated tags",
+			tags:   []string{"home", "Work", "work ", "blocked"},
+			result: []string{"blocked", "home", "work"},
+			err:    nil,
+		},
+		{
+			name:   "should fail when a tag is invalid",
+			tags:   []string{"home", ""},
+			result: nil,
+			err:    fmt.Errorf("%w: tag must have between 1 and 32 characters", domain.ErrTodoInvalidInput),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := domain.NormalizeTags(tc.tags)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestTodo_WithTags(t *testing.T) {
+	exampleTodo := domain.Todo{Title: "title example", Status: domain.TodoStatusPending}
+	tooManyTags := make([]string, domain.MaxTodoTags+1)
+	for i := range tooManyTags {
+		tooManyTags[i] = fmt.Sprintf("tag%d", i)
+	}
+	testCases := []struct {
+		name   string
+		todo   domain.Todo
+		tags   []string
+		result domain.Todo
+		err    error
+	}{
+		{
+			name:   "should set the normalized tags",
+			todo:   exampleTodo,
+			tags:   []string{"Work", "home"},
+			result: domain.Todo{Title: "title example", Status: domain.TodoStatusPending, Tags: []string{"home", "work"}},
+			err:    nil,
+		},
+		{
+			name:   "should clear the tags",
+			todo:   domain.Todo{Title: "title example", Status: domain.TodoStatusPending, Tags: []string{"home"}},
+			tags:   nil,
+			result: exampleTodo,
+			err:    nil,
+		},
+		{
+			name:   "should fail when a tag is invalid",
+			todo:   exampleTodo,
+			tags:   []string{"to#do"},
+			result: domain.Todo{},
+			err:    fmt.Errorf("%w: tag \"to#do\" has invalid characters", domain.ErrTodoInvalidInput),
+		},
+		{
+			name:   "should fail when there are too many tags",
+			todo:   exampleTodo,
+			tags:   tooManyTags,
+			result: domain.Todo{},
+			err:    fmt.Errorf("%w: a todo can have at most 20 tags", domain.ErrTodoInvalidInput),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.todo.WithTags(tc.tags)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestTodo_HasTag(t *testing.T) {
+	todo := domain.Todo{Tags: []string{"home", "work"}}
+	assert.True(t, todo.HasTag("work"))
+	assert.False(t, todo.HasTag("blocked"))
+}
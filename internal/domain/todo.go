@@ -11,11 +11,12 @@ import (
 // ErrTodoInvalidInput is returned when the todo input is invalid.
 var ErrTodoInvalidInput = errors.New("todo invalid input")
 
-// TodoStatus represents the current status of a todo.
+// TodoStatus represents the current status of a todo. The valid statuses
+// are the ones of the configured Workflow.
 type TodoStatus string
 
 const (
-	// TodoStatusPending indicates the todo has not been completed yet.
+	// TodoStatusPending indicates the todo has not been completed yet. New todos start pending.
 	TodoStatusPending TodoStatus = "pending"
 	// TodoStatusCompleted indicates the todo has been completed.
 	TodoStatusCompleted TodoStatus = "completed"
@@ -23,20 +24,76 @@ const (
 
 var validStatuses = []TodoStatus{TodoStatusPending, TodoStatusCompleted}
 
-// IsValid checks if the status is a valid TodoStatus.
+// IsValid checks if the status is a valid TodoStatus, one of the statuses every workflow has.
 func (s TodoStatus) IsValid() bool {
 	return slices.Contains(validStatuses, s)
 }
 
+// TodoPriority represents how important a todo is.
+type TodoPriority string
+
+const (
+	// TodoPriorityNone indicates no priority was given to the todo.
+	TodoPriorityNone TodoPriority = "none"
+	// TodoPriorityLow indicates a todo of low importance.
+	TodoPriorityLow TodoPriority = "low"
+	// TodoPriorityMedium indicates a todo of medium importance.
+	TodoPriorityMedium TodoPriority = "medium"
+	// TodoPriorityHigh indicates a todo of high importance.
+	TodoPriorityHigh TodoPriority = "high"
+	// TodoPriorityUrgent indicates a todo that must be done first.
+	TodoPriorityUrgent TodoPriority = "urgent"
+)
+
+// TodoPriorities lists the valid priorities from the least to the most important.
+var TodoPriorities = []TodoPriority{
+	TodoPriorityNone,
+	TodoPriorityLow,
+	TodoPriorityMedium,
+	TodoPriorityHigh,
+	TodoPriorityUrgent,
+}
+
+// IsValid checks if the priority is a valid TodoPriority.
+func (p TodoPriority) IsValid() bool {
+	return slices.Contains(TodoPriorities, p)
+}
+
+// Rank returns the position of the priority in TodoPriorities, so priorities
+// can be compared by importance. Invalid priorities rank as TodoPriorityNone.
+func (p TodoPriority) Rank() int {
+	return max(slices.Index(TodoPriorities, p), 0)
+}
+
 // Todo represents a task or item to be done.
+//
+// Version counts the saved revisions of the todo. It is set by the store, which
+// only saves a todo whose version is still the stored one.
+//
+// CompletedAt is when the todo was last moved to completed, nil while it is not completed.
+//
+// ArchivedAt is when the todo was archived, nil while it is not. Archiving is independent
+// of the status: it only hides the todo from the lists that do not ask for archived todos.
+//
+// DeletedAt is when the todo was moved to the trash, nil while it is not there.
+// A todo in the trash is left out of every store operation but the trash ones.
 type Todo struct {
 	ID          string
 	Title       string
 	Description string
 	Status      TodoStatus
+	Priority    TodoPriority
+	Tags        []string
+	Items       []ChecklistItem
+	Recurrence  *Recurrence
+	ProjectID   *string
 	DueDate     *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CompletedAt *time.Time
+	ArchivedAt  *time.Time
+	Version     int
+	DeletedAt   *time.Time
 }
 
 // validateTodoInput validates the todo input fields.
@@ -79,6 +136,7 @@ func NewTodo(title, description string, date time.Time, dueDate *time.Time) (Tod
 		Title:       title,
 		Description: description,
 		Status:      TodoStatusPending,
+		Priority:    TodoPriorityNone,
 		DueDate:     dueDate,
 		CreatedAt:   date,
 		UpdatedAt:   date,
@@ -111,7 +169,28 @@ func (t Todo) Update(title, description string, date time.Time, dueDate *time.Ti
 	return t, nil
 }
 
-// MarkAsCompleted marks the todo as completed with the given date.
+// WithPriority sets the priority of the todo.
+// An empty priority means TodoPriorityNone. It does not touch the timestamps, as it is
+// applied together with NewTodo or Update.
+//
+// Parameters:
+//   - priority: the new todo priority
+//
+// Returns:
+//   - Todo: the todo with the new priority
+//   - error: ErrTodoInvalidInput if the priority is not valid
+func (t Todo) WithPriority(priority TodoPriority) (Todo, error) {
+	if priority == "" {
+		priority = TodoPriorityNone
+	}
+	if !priority.IsValid() {
+		return Todo{}, fmt.Errorf("%w: priority", ErrTodoInvalidInput)
+	}
+	t.Priority = priority
+	return t, nil
+}
+
+// MarkAsCompleted marks the todo as completed with the given date, which becomes its CompletedAt.
 //
 // Parameters:
 //   - date: the current timestamp
@@ -121,10 +200,11 @@ func (t Todo) Update(title, description string, date time.Time, dueDate *time.Ti
 func (t Todo) MarkAsCompleted(date time.Time) Todo {
 	t.Status = TodoStatusCompleted
 	t.UpdatedAt = date
+	t.CompletedAt = &date
 	return t
 }
 
-// MarkAsPending marks the todo as pending with the given date.
+// MarkAsPending marks the todo as pending with the given date, clearing its CompletedAt.
 //
 // Parameters:
 //   - date: the current timestamp
@@ -134,5 +214,81 @@ func (t Todo) MarkAsCompleted(date time.Time) Todo {
 func (t Todo) MarkAsPending(date time.Time) Todo {
 	t.Status = TodoStatusPending
 	t.UpdatedAt = date
+	t.CompletedAt = nil
+	return t
+}
+
+// Transition moves the todo to another status of the workflow with the given date, like
+// MarkAsCompleted and MarkAsPending for those statuses. Any other status clears its CompletedAt.
+// The todo is left untouched when it already has the status. A todo with a status
+// the workflow no longer has can move to any status, so it is never stuck.
+//
+// Parameters:
+//   - workflow: the workflow with the statuses and the allowed transitions
+//   - status: the new todo status
+//   - date: the current timestamp
+//
+// Returns:
+//   - Todo: the todo with the new status
+//   - error: ErrTodoInvalidInput if the status is not in the workflow,
+//     or ErrTodoInvalidTransition if the workflow does not allow the move
+func (t Todo) Transition(workflow Workflow, status TodoStatus, date time.Time) (Todo, error) {
+	if !workflow.HasStatus(status) {
+		return Todo{}, fmt.Errorf("%w: status must be %s", ErrTodoInvalidInput, workflow.DescribeStatuses())
+	}
+	if status == t.Status {
+		return t, nil
+	}
+	if workflow.HasStatus(t.Status) && !workflow.Allows(t.Status, status) {
+		return Todo{}, fmt.Errorf("%w: cannot move a todo from %s to %s", ErrTodoInvalidTransition, t.Status, status)
+	}
+	switch status {
+	case TodoStatusCompleted:
+		return t.MarkAsCompleted(date), nil
+	case TodoStatusPending:
+		return t.MarkAsPending(date), nil
+	}
+	t.Status = status
+	t.UpdatedAt = date
+	t.CompletedAt = nil
+	return t, nil
+}
+
+// IsArchived reports whether the todo is archived.
+func (t Todo) IsArchived() bool {
+	return t.ArchivedAt != nil
+}
+
+// Archive archives the todo with the given date.
+// The todo is left untouched when it is already archived.
+//
+// Parameters:
+//   - date: the current timestamp
+//
+// Returns:
+//   - Todo: the archived todo
+func (t Todo) Archive(date time.Time) Todo {
+	if t.IsArchived() {
+		return t
+	}
+	t.ArchivedAt = &date
+	t.UpdatedAt = date
+	return t
+}
+
+// Unarchive takes the todo out of the archive with the given date.
+// The todo is left untouched when it is not archived.
+//
+// Parameters:
+//   - date: the current timestamp
+//
+// Returns:
+//   - Todo: the todo no longer archived
+func (t Todo) Unarchive(date time.Time) Todo {
+	if !t.IsArchived() {
+		return t
+	}
+	t.ArchivedAt = nil
+	t.UpdatedAt = date
 	return t
 }
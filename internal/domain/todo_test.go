@@ -18,6 +18,7 @@ func TestNewTodo(t *testing.T) {
 		Title:       exampleTitle,
 		Description: exampleDescription,
 		Status:      domain.TodoStatusPending,
+		Priority:    domain.TodoPriorityNone,
 		DueDate:     nil,
 		CreatedAt:   exampleDate,
 		UpdatedAt:   exampleDate,
@@ -95,6 +96,7 @@ func TestNewTodo(t *testing.T) {
 				Title:       exampleTitle,
 				Description: exampleDescription,
 				Status:      domain.TodoStatusPending,
+				Priority:    domain.TodoPriorityNone,
 				DueDate:     &exampleDateUpdated,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -251,6 +253,7 @@ func TestTodo_MarkAsCompleted(t *testing.T) {
 		DueDate:     nil,
 		CreatedAt:   exampleDate,
 		UpdatedAt:   exampleDateUpdated,
+		CompletedAt: &exampleDateUpdated,
 	}
 	testCases := []struct {
 		name   string
@@ -264,6 +267,16 @@ func TestTodo_MarkAsCompleted(t *testing.T) {
 			date:   exampleDateUpdated,
 			result: exampleTodoCompleted,
 		},
+		{
+			name: "should complete again a completed todo at the new date",
+			todo: func() domain.Todo {
+				todo := exampleTodoCompleted
+				todo.CompletedAt = &exampleDate
+				return todo
+			}(),
+			date:   exampleDateUpdated,
+			result: exampleTodoCompleted,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -285,6 +298,7 @@ func TestTodo_MarkAsPending(t *testing.T) {
 		DueDate:     nil,
 		CreatedAt:   exampleDate,
 		UpdatedAt:   exampleDate,
+		CompletedAt: &exampleDate,
 	}
 	exampleTodoPending := domain.Todo{
 		Title:       exampleTitle,
@@ -301,7 +315,7 @@ func TestTodo_MarkAsPending(t *testing.T) {
 		result domain.Todo
 	}{
 		{
-			name:   "should mark todo as pending",
+			name:   "should mark todo as pending, clearing its completion date",
 			todo:   exampleTodo,
 			date:   exampleDateUpdated,
 			result: exampleTodoPending,
@@ -350,3 +364,275 @@ func TestTodoStatus_IsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestTodo_WithPriority(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleTodo := domain.Todo{
+		Title:     "title example",
+		Status:    domain.TodoStatusPending,
+		Priority:  domain.TodoPriorityNone,
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDate,
+	}
+	exampleTodoHigh := exampleTodo
+	exampleTodoHigh.Priority = domain.TodoPriorityHigh
+	testCases := []struct {
+		name     string
+		todo     domain.Todo
+		priority domain.TodoPriority
+		result   domain.Todo
+		err      error
+	}{
+		{
+			name:     "should set the priority",
+			todo:     exampleTodo,
+			priority: domain.TodoPriorityHigh,
+			result:   exampleTodoHigh,
+			err:      nil,
+		},
+		{
+			name:     "should set no priority when priority is empty",
+			todo:     exampleTodoHigh,
+			priority: "",
+			result:   exampleTodo,
+			err:      nil,
+		},
+		{
+			name:     "should fail when priority is invalid",
+			todo:     exampleTodo,
+			priority: domain.TodoPriority("invalid"),
+			result:   domain.Todo{},
+			err:      fmt.Errorf("%w: priority", domain.ErrTodoInvalidInput),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.todo.WithPriority(tc.priority)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestTodoPriority_IsValid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		priority domain.TodoPriority
+		result   bool
+	}{
+		{
+			name:     "should return true for none priority",
+			priority: domain.TodoPriorityNone,
+			result:   true,
+		},
+		{
+			name:     "should return true for urgent priority",
+			priority: domain.TodoPriorityUrgent,
+			result:   true,
+		},
+		{
+			name:     "should return false for invalid priority",
+			priority: domain.TodoPriority("invalid"),
+			result:   false,
+		},
+		{
+			name:     "should return false for empty priority",
+			priority: domain.TodoPriority(""),
+			result:   false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.priority.IsValid()
+			assert.Equal(t, tc.result, got)
+		})
+	}
+}
+
+func TestTodoPriority_Rank(t *testing.T) {
+	testCases := []struct {
+		name     string
+		priority domain.TodoPriority
+		result   int
+	}{
+		{
+			name:     "should rank none priority first",
+			priority: domain.TodoPriorityNone,
+			result:   0,
+		},
+		{
+			name:     "should rank medium priority in the middle",
+			priority: domain.TodoPriorityMedium,
+			result:   2,
+		},
+		{
+			name:     "should rank urgent priority last",
+			priority: domain.TodoPriorityUrgent,
+			result:   4,
+		},
+		{
+			name:     "should rank invalid priority as none",
+			priority: domain.TodoPriority("invalid"),
+			result:   0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.priority.Rank()
+			assert.Equal(t, tc.result, got)
+		})
+	}
+}
+
+func TestTodo_Transition(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	workflow, _ := domain.NewWorkflow(
+		[]domain.TodoStatus{"pending", "in_progress", "blocked", "completed"},
+		map[domain.TodoStatus][]domain.TodoStatus{
+			"pending":     {"in_progress"},
+			"in_progress": {"blocked", "completed"},
+			"blocked":     {"in_progress"},
+		})
+	testCases := []struct {
+		name     string
+		todo     domain.Todo
+		workflow domain.Workflow
+		status   domain.TodoStatus
+		result   domain.Todo
+		err      error
+	}{
+		{
+			name:     "should complete the todo with the default workflow",
+			todo:     domain.Todo{Title: "title example", Status: domain.TodoStatusPending, UpdatedAt: exampleDate},
+			workflow: domain.DefaultWorkflow(),
+			status:   domain.TodoStatusCompleted,
+			result: domain.Todo{
+				Title:       "title example",
+				Status:      domain.TodoStatusCompleted,
+				UpdatedAt:   exampleDateUpdated,
+				CompletedAt: &exampleDateUpdated,
+			},
+			err: nil,
+		},
+		{
+			name: "should mark the todo as pending with the default workflow",
+			todo: domain.Todo{
+				Title:       "title example",
+				Status:      domain.TodoStatusCompleted,
+				UpdatedAt:   exampleDate,
+				CompletedAt: &exampleDate,
+			},
+			workflow: domain.DefaultWorkflow(),
+			status:   domain.TodoStatusPending,
+			result:   domain.Todo{Title: "title example", Status: domain.TodoStatusPending, UpdatedAt: exampleDateUpdated},
+			err:      nil,
+		},
+		{
+			name:     "should move the todo along an allowed transition",
+			todo:     domain.Todo{Title: "title example", Status: "in_progress", UpdatedAt: exampleDate},
+			workflow: workflow,
+			status:   "blocked",
+			result:   domain.Todo{Title: "title example", Status: "blocked", UpdatedAt: exampleDateUpdated},
+			err:      nil,
+		},
+		{
+			name:     "should keep the todo with the same status",
+			todo:     domain.Todo{Title: "title example", Status: domain.TodoStatusPending, UpdatedAt: exampleDate},
+			workflow: workflow,
+			status:   domain.TodoStatusPending,
+			result:   domain.Todo{Title: "title example", Status: domain.TodoStatusPending, UpdatedAt: exampleDate},
+			err:      nil,
+		},
+		{
+			name:     "should move a todo out of a status the workflow no longer has",
+			todo:     domain.Todo{Title: "title example", Status: "cancelled", UpdatedAt: exampleDate},
+			workflow: workflow,
+			status:   domain.TodoStatusPending,
+			result:   domain.Todo{Title: "title example", Status: domain.TodoStatusPending, UpdatedAt: exampleDateUpdated},
+			err:      nil,
+		},
+		{
+			name:     "should fail when the workflow does not allow the transition",
+			todo:     domain.Todo{Title: "title example", Status: domain.TodoStatusPending, UpdatedAt: exampleDate},
+			workflow: workflow,
+			status:   domain.TodoStatusCompleted,
+			result:   domain.Todo{},
+			err: fmt.Errorf("%w: cannot move a todo from pending to completed",
+				domain.ErrTodoInvalidTransition),
+		},
+		{
+			name:     "should fail when the status is not in the workflow",
+			todo:     domain.Todo{Title: "title example", Status: domain.TodoStatusPending, UpdatedAt: exampleDate},
+			workflow: domain.DefaultWorkflow(),
+			status:   "in_progress",
+			result:   domain.Todo{},
+			err:      fmt.Errorf("%w: status must be 'pending' or 'completed'", domain.ErrTodoInvalidInput),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.todo.Transition(tc.workflow, tc.status, exampleDateUpdated)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestTodo_Archive(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	archivedTodo := domain.Todo{Title: "title example", ArchivedAt: &exampleDate, UpdatedAt: exampleDate}
+	testCases := []struct {
+		name   string
+		todo   domain.Todo
+		result domain.Todo
+	}{
+		{
+			name:   "should archive the todo",
+			todo:   domain.Todo{Title: "title example", UpdatedAt: exampleDate},
+			result: domain.Todo{Title: "title example", ArchivedAt: &exampleDateUpdated, UpdatedAt: exampleDateUpdated},
+		},
+		{
+			name:   "should keep an archived todo as it is",
+			todo:   archivedTodo,
+			result: archivedTodo,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.todo.Archive(exampleDateUpdated)
+			assert.Equal(t, tc.result, result)
+			assert.True(t, result.IsArchived())
+		})
+	}
+}
+
+func TestTodo_Unarchive(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	todo := domain.Todo{Title: "title example", UpdatedAt: exampleDate}
+	testCases := []struct {
+		name   string
+		todo   domain.Todo
+		result domain.Todo
+	}{
+		{
+			name:   "should take the todo out of the archive",
+			todo:   domain.Todo{Title: "title example", ArchivedAt: &exampleDate, UpdatedAt: exampleDate},
+			result: domain.Todo{Title: "title example", UpdatedAt: exampleDateUpdated},
+		},
+		{
+			name:   "should keep a todo that is not archived as it is",
+			todo:   todo,
+			result: todo,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.todo.Unarchive(exampleDateUpdated)
+			assert.Equal(t, tc.result, result)
+			assert.False(t, result.IsArchived())
+		})
+	}
+}
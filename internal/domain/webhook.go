@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrWebhookInvalidInput is returned when the webhook input is invalid.
+var ErrWebhookInvalidInput = errors.New("webhook invalid input")
+
+// Webhook is a subscription of an external URL to the domain events of some types,
+// each one delivered to it in a request signed with its secret.
+type Webhook struct {
+	ID     string
+	URL    string
+	Secret string
+	// Events are the types of the events delivered to the webhook, in the order of EventTypes
+	Events    []EventType
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// validateWebhookInput checks the URL is an absolute http or https URL, the secret is not blank,
+// every event type is known and the date is not zero. It returns the event types without duplicates,
+// in the order of EventTypes.
+func validateWebhookInput(rawURL, secret string, events []EventType, date time.Time) ([]EventType, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http or https URL", ErrWebhookInvalidInput)
+	}
+	if strings.TrimSpace(secret) == "" {
+		return nil, fmt.Errorf("%w: secret must not be empty", ErrWebhookInvalidInput)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: events must not be empty", ErrWebhookInvalidInput)
+	}
+	for _, event := range events {
+		if !event.IsValid() {
+			return nil, fmt.Errorf("%w: unknown event %q", ErrWebhookInvalidInput, event)
+		}
+	}
+	if date.IsZero() {
+		return nil, fmt.Errorf("%w: date", ErrWebhookInvalidInput)
+	}
+	subscribed := make([]EventType, 0, len(events))
+	for _, event := range EventTypes {
+		if slices.Contains(events, event) {
+			subscribed = append(subscribed, event)
+		}
+	}
+	return subscribed, nil
+}
+
+// NewWebhook creates a new Webhook with the given parameters.
+//
+// Parameters:
+//   - url: the absolute http or https URL the events are delivered to (required)
+//   - secret: the key the deliveries are signed with (required)
+//   - events: the types of the events delivered (required, at least one)
+//   - date: the current timestamp (required, must not be zero)
+//
+// Returns:
+//   - Webhook: the created webhook instance
+//   - error: ErrWebhookInvalidInput if validation fails
+func NewWebhook(url, secret string, events []EventType, date time.Time) (Webhook, error) {
+	subscribed, err := validateWebhookInput(url, secret, events, date)
+	if err != nil {
+		return Webhook{}, err
+	}
+	return Webhook{
+		URL:       url,
+		Secret:    secret,
+		Events:    subscribed,
+		CreatedAt: date,
+		UpdatedAt: date,
+	}, nil
+}
+
+// Update modifies the webhook with new values.
+//
+// Parameters:
+//   - url: the new URL the events are delivered to (required)
+//   - secret: the new key the deliveries are signed with, empty to keep the current one
+//   - events: the new types of the events delivered (required, at least one)
+//   - date: the current timestamp (required, must not be zero)
+//
+// Returns:
+//   - Webhook: the updated webhook instance
+//   - error: ErrWebhookInvalidInput if validation fails
+func (w Webhook) Update(url, secret string, events []EventType, date time.Time) (Webhook, error) {
+	if secret == "" {
+		secret = w.Secret
+	}
+	subscribed, err := validateWebhookInput(url, secret, events, date)
+	if err != nil {
+		return Webhook{}, err
+	}
+	w.URL = url
+	w.Secret = secret
+	w.Events = subscribed
+	w.UpdatedAt = date
+	return w, nil
+}
+
+// Subscribes checks if the events of the type are delivered to the webhook.
+func (w Webhook) Subscribes(event EventType) bool {
+	return slices.Contains(w.Events, event)
+}
+
+// WebhookDeliveryStatus tells whether a delivery reached its webhook.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is a delivery not attempted yet or waiting for its next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliverySucceeded is a delivery the webhook answered with a 2xx status.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed is a delivery given up after its last attempt failed.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is the delivery of a domain event to a webhook, attempted until
+// it succeeds or is given up.
+type WebhookDelivery struct {
+	ID        string
+	WebhookID string
+	EventID   string
+	EventType EventType
+	// Payload is the JSON body sent to the webhook
+	Payload json.RawMessage
+	Status  WebhookDeliveryStatus
+	// Attempts is the number of times the delivery was sent
+	Attempts int
+	// ResponseStatus is the HTTP status of the response to the last attempt, 0 when there was none
+	ResponseStatus int
+	// LastError tells why the last attempt failed, empty when it succeeded
+	LastError string
+	// NextAttemptAt is when a pending delivery is attempted next, nil once it succeeded or failed
+	NextAttemptAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewWebhookDelivery creates the pending delivery of the event to the webhook, to be attempted at date.
+func NewWebhookDelivery(webhookID string, event Event, payload json.RawMessage, date time.Time) WebhookDelivery {
+	return WebhookDelivery{
+		WebhookID:     webhookID,
+		EventID:       event.ID,
+		EventType:     event.Type,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: &date,
+		CreatedAt:     date,
+		UpdatedAt:     date,
+	}
+}
+
+// Succeed records an attempt the webhook answered with the 2xx responseStatus at date.
+func (d WebhookDelivery) Succeed(responseStatus int, date time.Time) WebhookDelivery {
+	d.Status = WebhookDeliverySucceeded
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.LastError = ""
+	d.NextAttemptAt = nil
+	d.UpdatedAt = date
+	return d
+}
+
+// Fail records an attempt that failed at date for the reason, with the responseStatus of the
+// webhook or 0 when it did not answer. The delivery stays pending until retryAt, or is given up
+// when retryAt is nil.
+func (d WebhookDelivery) Fail(responseStatus int, reason string, date time.Time, retryAt *time.Time) WebhookDelivery {
+	d.Status = WebhookDeliveryPending
+	if retryAt == nil {
+		d.Status = WebhookDeliveryFailed
+	}
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.LastError = reason
+	d.NextAttemptAt = retryAt
+	d.UpdatedAt = date
+	return d
+}
@@ -0,0 +1,175 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestNewWebhook(t *testing.T) {
+	date := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	urlErr := fmt.Errorf("%w: url must be an absolute http or https URL", domain.ErrWebhookInvalidInput)
+	testCases := []struct {
+		name   string
+		url    string
+		secret string
+		events []domain.EventType
+		date   time.Time
+		result domain.Webhook
+		err    error
+	}{
+		{
+			name:   "should create a webhook with its events without duplicates in order",
+			url:    "https://example.com/hooks",
+			secret: "s3cret",
+			events: []domain.EventType{domain.EventTodoDeleted, domain.EventTodoCreated, domain.EventTodoDeleted},
+			date:   date,
+			result: domain.Webhook{
+				URL:       "https://example.com/hooks",
+				Secret:    "s3cret",
+				Events:    []domain.EventType{domain.EventTodoCreated, domain.EventTodoDeleted},
+				CreatedAt: date,
+				UpdatedAt: date,
+			},
+			err: nil,
+		},
+		{
+			name:   "should fail when url is relative",
+			url:    "/hooks",
+			secret: "s3cret",
+			events: []domain.EventType{domain.EventTodoCreated},
+			date:   date,
+			err:    urlErr,
+		},
+		{
+			name:   "should fail when url is not http",
+			url:    "ftp://example.com/hooks",
+			secret: "s3cret",
+			events: []domain.EventType{domain.EventTodoCreated},
+			date:   date,
+			err:    urlErr,
+		},
+		{
+			name:   "should fail when secret is blank",
+			url:    "https://example.com/hooks",
+			secret: " ",
+			events: []domain.EventType{domain.EventTodoCreated},
+			date:   date,
+			err:    fmt.Errorf("%w: secret must not be empty", domain.ErrWebhookInvalidInput),
+		},
+		{
+			name:   "should fail when there are no events",
+			url:    "https://example.com/hooks",
+			secret: "s3cret",
+			date:   date,
+			err:    fmt.Errorf("%w: events must not be empty", domain.ErrWebhookInvalidInput),
+		},
+		{
+			name:   "should fail when an event is unknown",
+			url:    "https://example.com/hooks",
+			secret: "s3cret",
+			events: []domain.EventType{"todo.archived"},
+			date:   date,
+			err:    fmt.Errorf("%w: unknown event %q", domain.ErrWebhookInvalidInput, "todo.archived"),
+		},
+		{
+			name:   "should fail when date is zero",
+			url:    "https://example.com/hooks",
+			secret: "s3cret",
+			events: []domain.EventType{domain.EventTodoCreated},
+			err:    fmt.Errorf("%w: date", domain.ErrWebhookInvalidInput),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := domain.NewWebhook(tc.url, tc.secret, tc.events, tc.date)
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestWebhook_Update(t *testing.T) {
+	date := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	later := date.Add(time.Hour)
+	webhook, _ := domain.NewWebhook("https://example.com/hooks", "s3cret", []domain.EventType{domain.EventTodoCreated}, date)
+
+	t.Run("should keep the secret when none is given", func(t *testing.T) {
+		updated, err := webhook.Update("https://example.com/other", "",
+			[]domain.EventType{domain.EventTodoCompleted}, later)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Webhook{
+			URL:       "https://example.com/other",
+			Secret:    "s3cret",
+			Events:    []domain.EventType{domain.EventTodoCompleted},
+			CreatedAt: date,
+			UpdatedAt: later,
+		}, updated)
+	})
+
+	t.Run("should replace the secret", func(t *testing.T) {
+		updated, err := webhook.Update(webhook.URL, "n3w", webhook.Events, later)
+		assert.NoError(t, err)
+		assert.Equal(t, "n3w", updated.Secret)
+	})
+
+	t.Run("should fail when the input is invalid", func(t *testing.T) {
+		_, err := webhook.Update(webhook.URL, "", nil, later)
+		assert.ErrorIs(t, err, domain.ErrWebhookInvalidInput)
+	})
+}
+
+func TestWebhook_Subscribes(t *testing.T) {
+	webhook := domain.Webhook{Events: []domain.EventType{domain.EventTodoCreated, domain.EventTodoDeleted}}
+	assert.True(t, webhook.Subscribes(domain.EventTodoDeleted))
+	assert.False(t, webhook.Subscribes(domain.EventTodoUpdated))
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	date := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	retryAt := date.Add(time.Minute)
+	event := domain.Event{ID: "e1", Type: domain.EventTodoCreated, TodoID: "t1"}
+	delivery := domain.NewWebhookDelivery("w1", event, json.RawMessage(`{}`), date)
+
+	t.Run("should create a pending delivery due at once", func(t *testing.T) {
+		assert.Equal(t, domain.WebhookDelivery{
+			WebhookID:     "w1",
+			EventID:       "e1",
+			EventType:     domain.EventTodoCreated,
+			Payload:       json.RawMessage(`{}`),
+			Status:        domain.WebhookDeliveryPending,
+			NextAttemptAt: &date,
+			CreatedAt:     date,
+			UpdatedAt:     date,
+		}, delivery)
+	})
+
+	t.Run("should stay pending when a failed attempt is retried", func(t *testing.T) {
+		failed := delivery.Fail(500, "unexpected status 500", date, &retryAt)
+		assert.Equal(t, domain.WebhookDeliveryPending, failed.Status)
+		assert.Equal(t, 1, failed.Attempts)
+		assert.Equal(t, 500, failed.ResponseStatus)
+		assert.Equal(t, "unexpected status 500", failed.LastError)
+		assert.Equal(t, &retryAt, failed.NextAttemptAt)
+	})
+
+	t.Run("should be given up when a failed attempt is not retried", func(t *testing.T) {
+		failed := delivery.Fail(0, "connection refused", date, nil)
+		assert.Equal(t, domain.WebhookDeliveryFailed, failed.Status)
+		assert.Nil(t, failed.NextAttemptAt)
+	})
+
+	t.Run("should succeed after a failed attempt", func(t *testing.T) {
+		succeeded := delivery.Fail(500, "unexpected status 500", date, &retryAt).Succeed(204, retryAt)
+		assert.Equal(t, domain.WebhookDeliverySucceeded, succeeded.Status)
+		assert.Equal(t, 2, succeeded.Attempts)
+		assert.Equal(t, 204, succeeded.ResponseStatus)
+		assert.Empty(t, succeeded.LastError)
+		assert.Nil(t, succeeded.NextAttemptAt)
+		assert.Equal(t, retryAt, succeeded.UpdatedAt)
+	})
+}
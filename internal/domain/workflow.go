@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// MaxStatusLength is the maximum length of a workflow status.
+const MaxStatusLength = 32
+
+var statusPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Workflow defines the statuses a todo can have and the transitions allowed between them.
+// The zero value has no statuses; use DefaultWorkflow or NewWorkflow.
+type Workflow struct {
+	statuses    []TodoStatus
+	transitions map[TodoStatus][]TodoStatus
+}
+
+// DefaultWorkflow returns the workflow used when none is configured, where a todo
+// moves freely between pending and completed.
+func DefaultWorkflow() Workflow {
+	return Workflow{
+		statuses: []TodoStatus{TodoStatusPending, TodoStatusCompleted},
+		transitions: map[TodoStatus][]TodoStatus{
+			TodoStatusPending:   {TodoStatusCompleted},
+			TodoStatusCompleted: {TodoStatusPending},
+		},
+	}
+}
+
+// NewWorkflow creates a workflow with the given statuses and transitions.
+// The statuses must include pending, the status of a new todo, and completed,
+// which the completion of a todo and the filters of completed todos rely on.
+//
+// Parameters:
+//   - statuses: the statuses a todo can have, in the order they are listed
+//   - transitions: the statuses each status can move to
+//
+// Returns:
+//   - Workflow: the created workflow
+//   - error: ErrInvalidWorkflow if a status is not valid or a transition refers to an unknown status
+func NewWorkflow(statuses []TodoStatus, transitions map[TodoStatus][]TodoStatus) (Workflow, error) {
+	for i, status := range statuses {
+		if len(status) > MaxStatusLength || !statusPattern.MatchString(string(status)) {
+			return Workflow{}, fmt.Errorf(
+				"%w: status %q must have up to %d lowercase letters, digits or underscores, starting with a letter",
+				ErrInvalidWorkflow, status, MaxStatusLength)
+		}
+		if slices.Contains(statuses[:i], status) {
+			return Workflow{}, fmt.Errorf("%w: status %q is repeated", ErrInvalidWorkflow, status)
+		}
+	}
+	for _, required := range []TodoStatus{TodoStatusPending, TodoStatusCompleted} {
+		if !slices.Contains(statuses, required) {
+			return Workflow{}, fmt.Errorf("%w: the statuses must include %q", ErrInvalidWorkflow, required)
+		}
+	}
+	workflow := Workflow{
+		statuses:    slices.Clone(statuses),
+		transitions: make(map[TodoStatus][]TodoStatus, len(transitions)),
+	}
+	for from, targets := range transitions {
+		if !slices.Contains(statuses, from) {
+			return Workflow{}, fmt.Errorf("%w: transition from unknown status %q", ErrInvalidWorkflow, from)
+		}
+		for _, to := range targets {
+			if !slices.Contains(statuses, to) {
+				return Workflow{}, fmt.Errorf("%w: transition from %q to unknown status %q", ErrInvalidWorkflow, from, to)
+			}
+		}
+		workflow.transitions[from] = slices.Clone(targets)
+	}
+	return workflow, nil
+}
+
+// Statuses returns the statuses of the workflow.
+func (w Workflow) Statuses() []TodoStatus {
+	return slices.Clone(w.statuses)
+}
+
+// HasStatus checks if the status belongs to the workflow.
+func (w Workflow) HasStatus(status TodoStatus) bool {
+	return slices.Contains(w.statuses, status)
+}
+
+// Allows checks if a todo can move from a status to another.
+func (w Workflow) Allows(from, to TodoStatus) bool {
+	return slices.Contains(w.transitions[from], to)
+}
+
+// DescribeStatuses lists the statuses of the workflow for messages, as in "'pending' or 'completed'".
+func (w Workflow) DescribeStatuses() string {
+	quoted := make([]string, len(w.statuses))
+	for i, status := range w.statuses {
+		quoted[i] = "'" + string(status) + "'"
+	}
+	if len(quoted) < 2 {
+		return strings.Join(quoted, "")
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
@@ -0,0 +1,96 @@
+package domain_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestNewWorkflow(t *testing.T) {
+	testCases := []struct {
+		name        string
+		statuses    []domain.TodoStatus
+		transitions map[domain.TodoStatus][]domain.TodoStatus
+		err         error
+	}{
+		{
+			name:     "should create a workflow",
+			statuses: []domain.TodoStatus{"pending", "in_progress", "blocked", "completed", "cancelled"},
+			transitions: map[domain.TodoStatus][]domain.TodoStatus{
+				"pending":     {"in_progress", "cancelled"},
+				"in_progress": {"blocked", "completed"},
+				"blocked":     {"in_progress"},
+			},
+			err: nil,
+		},
+		{
+			name:     "should fail when a status has invalid characters",
+			statuses: []domain.TodoStatus{"pending", "In Progress", "completed"},
+			err: fmt.Errorf("%w: status %q must have up to 32 lowercase letters, digits or underscores, starting with a letter",
+				domain.ErrInvalidWorkflow, "In Progress"),
+		},
+		{
+			name:     "should fail when a status is too long",
+			statuses: []domain.TodoStatus{"pending", domain.TodoStatus(strings.Repeat("a", 33)), "completed"},
+			err: fmt.Errorf("%w: status %q must have up to 32 lowercase letters, digits or underscores, starting with a letter",
+				domain.ErrInvalidWorkflow, strings.Repeat("a", 33)),
+		},
+		{
+			name:     "should fail when a status is repeated",
+			statuses: []domain.TodoStatus{"pending", "completed", "pending"},
+			err:      fmt.Errorf("%w: status %q is repeated", domain.ErrInvalidWorkflow, "pending"),
+		},
+		{
+			name:     "should fail when completed is missing",
+			statuses: []domain.TodoStatus{"pending", "done"},
+			err:      fmt.Errorf("%w: the statuses must include %q", domain.ErrInvalidWorkflow, "completed"),
+		},
+		{
+			name:        "should fail when a transition starts at an unknown status",
+			statuses:    []domain.TodoStatus{"pending", "completed"},
+			transitions: map[domain.TodoStatus][]domain.TodoStatus{"blocked": {"pending"}},
+			err:         fmt.Errorf("%w: transition from unknown status %q", domain.ErrInvalidWorkflow, "blocked"),
+		},
+		{
+			name:        "should fail when a transition ends at an unknown status",
+			statuses:    []domain.TodoStatus{"pending", "completed"},
+			transitions: map[domain.TodoStatus][]domain.TodoStatus{"pending": {"blocked"}},
+			err: fmt.Errorf("%w: transition from %q to unknown status %q",
+				domain.ErrInvalidWorkflow, "pending", "blocked"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			workflow, err := domain.NewWorkflow(tc.statuses, tc.transitions)
+			assert.Equal(t, tc.err, err)
+			if err == nil {
+				assert.Equal(t, tc.statuses, workflow.Statuses())
+			}
+		})
+	}
+}
+
+func TestWorkflow_Allows(t *testing.T) {
+	workflow, _ := domain.NewWorkflow(
+		[]domain.TodoStatus{"pending", "in_progress", "completed"},
+		map[domain.TodoStatus][]domain.TodoStatus{
+			"pending":     {"in_progress"},
+			"in_progress": {"completed"},
+		})
+	assert.True(t, workflow.Allows("pending", "in_progress"))
+	assert.True(t, workflow.Allows("in_progress", "completed"))
+	assert.False(t, workflow.Allows("pending", "completed"))
+	assert.False(t, workflow.Allows("completed", "pending"))
+	assert.True(t, domain.DefaultWorkflow().Allows("completed", "pending"))
+}
+
+func TestWorkflow_DescribeStatuses(t *testing.T) {
+	workflow, _ := domain.NewWorkflow([]domain.TodoStatus{"pending", "in_progress", "completed"}, nil)
+	assert.Equal(t, "'pending', 'in_progress' or 'completed'", workflow.DescribeStatuses())
+	assert.Equal(t, "'pending' or 'completed'", domain.DefaultWorkflow().DescribeStatuses())
+	assert.True(t, workflow.HasStatus("in_progress"))
+	assert.False(t, domain.DefaultWorkflow().HasStatus("in_progress"))
+}
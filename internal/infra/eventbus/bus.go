@@ -0,0 +1,123 @@
+package eventbus
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// AsyncQueueSize is how many events an asynchronous subscriber can fall behind before the
+// events published for it are dropped.
+const AsyncQueueSize = 256
+
+type (
+	// Handler reacts to a domain event. Its errors are logged, the change of the event being
+	// already committed.
+	Handler func(context.Context, domain.Event) error
+	// Subscriber registers the handlers of the domain events.
+	Subscriber interface {
+		// Subscribe runs handler for every event, before Publish returns
+		Subscribe(handler Handler)
+		// SubscribeAsync runs handler for every event in the background, one event at a time
+		// and in the order they are published
+		SubscribeAsync(handler Handler)
+	}
+	delivery struct {
+		ctx   context.Context
+		event domain.Event
+	}
+	bus struct {
+		mu     sync.RWMutex
+		sync   []Handler
+		queues []chan delivery
+		closed bool
+		wg     sync.WaitGroup
+	}
+)
+
+// NewBus returns an in-process event bus, which publishes the events to its subscribers.
+func NewBus() *bus {
+	return &bus{}
+}
+
+func (b *bus) Subscribe(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sync = append(b.sync, handler)
+}
+
+func (b *bus) SubscribeAsync(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	queue := make(chan delivery, AsyncQueueSize)
+	b.queues = append(b.queues, queue)
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		for d := range queue {
+			handle(d.ctx, handler, d.event)
+		}
+	}()
+}
+
+// Publish gives the event an ID and runs the synchronous handlers, then queues it for the
+// asynchronous ones. The asynchronous handlers get ctx without its cancellation, as they may
+// run after the request that made the change is over. Publish never waits for them: the event
+// is dropped, with a log line, for a handler AsyncQueueSize events behind. Events published
+// once the bus is closed are only given to the synchronous handlers.
+func (b *bus) Publish(ctx context.Context, event domain.Event) {
+	event.ID = uuid.New().String()
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, handler := range b.sync {
+		handle(ctx, handler, event)
+	}
+	if b.closed {
+		return
+	}
+	detached := context.WithoutCancel(ctx)
+	for _, queue := range b.queues {
+		select {
+		case queue <- delivery{ctx: detached, event: event}:
+		default:
+			log.Printf("Dropping the %s event %s of todo %s: an asynchronous handler is %d events behind",
+				event.Type, event.ID, event.TodoID, AsyncQueueSize)
+		}
+	}
+}
+
+// Close stops the bus, waiting for the asynchronous handlers to handle the events already
+// published until ctx is done.
+func (b *bus) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		for _, queue := range b.queues {
+			close(queue)
+		}
+	}
+	b.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func handle(ctx context.Context, handler Handler, event domain.Event) {
+	if err := handler(ctx, event); err != nil {
+		log.Printf("Error handling the %s event %s of todo %s: %v", event.Type, event.ID, event.TodoID, err)
+	}
+}
@@ -0,0 +1,121 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestBus(t *testing.T) {
+	t.Run("should run the synchronous handlers before publish returns", func(t *testing.T) {
+		bus := NewBus()
+		var handled []domain.Event
+		bus.Subscribe(func(_ context.Context, event domain.Event) error {
+			handled = append(handled, event)
+			return nil
+		})
+		bus.Publish(context.Background(), domain.Event{Type: domain.EventTodoCreated, TodoID: "1"})
+		assert.Len(t, handled, 1)
+		assert.Equal(t, domain.EventTodoCreated, handled[0].Type)
+		assert.NotEmpty(t, handled[0].ID)
+	})
+
+	t.Run("should keep running the handlers when one of them fails", func(t *testing.T) {
+		bus := NewBus()
+		calls := 0
+		bus.Subscribe(func(context.Context, domain.Event) error {
+			calls++
+			return assert.AnError
+		})
+		bus.Subscribe(func(context.Context, domain.Event) error {
+			calls++
+			return nil
+		})
+		bus.Publish(context.Background(), domain.Event{Type: domain.EventTodoUpdated})
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should run the asynchronous handlers in order until the bus is closed", func(t *testing.T) {
+		bus := NewBus()
+		var handled []string
+		bus.SubscribeAsync(func(_ context.Context, event domain.Event) error {
+			handled = append(handled, event.TodoID)
+			return nil
+		})
+		for _, id := range []string{"1", "2", "3"} {
+			bus.Publish(context.Background(), domain.Event{Type: domain.EventTodoCreated, TodoID: id})
+		}
+		assert.Nil(t, bus.Close(context.Background()))
+		assert.Equal(t, []string{"1", "2", "3"}, handled)
+	})
+
+	t.Run("should run the asynchronous handlers after the context of publish is cancelled", func(t *testing.T) {
+		bus := NewBus()
+		errs := make(chan error, 1)
+		bus.SubscribeAsync(func(ctx context.Context, _ domain.Event) error {
+			errs <- ctx.Err()
+			return nil
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		bus.Publish(ctx, domain.Event{Type: domain.EventTodoDeleted})
+		cancel()
+		assert.Nil(t, bus.Close(context.Background()))
+		assert.Nil(t, <-errs)
+	})
+
+	t.Run("should only run the synchronous handlers once the bus is closed", func(t *testing.T) {
+		bus := NewBus()
+		syncCalls, asyncCalls := 0, 0
+		bus.Subscribe(func(context.Context, domain.Event) error {
+			syncCalls++
+			return nil
+		})
+		bus.SubscribeAsync(func(context.Context, domain.Event) error {
+			asyncCalls++
+			return nil
+		})
+		assert.Nil(t, bus.Close(context.Background()))
+		bus.Publish(context.Background(), domain.Event{Type: domain.EventTodoCompleted})
+		assert.Equal(t, 1, syncCalls)
+		assert.Equal(t, 0, asyncCalls)
+	})
+
+	t.Run("should stop waiting for the asynchronous handlers when the context is done", func(t *testing.T) {
+		bus := NewBus()
+		release := make(chan struct{})
+		bus.SubscribeAsync(func(context.Context, domain.Event) error {
+			<-release
+			return nil
+		})
+		bus.Publish(context.Background(), domain.Event{Type: domain.EventTodoReopened})
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.Equal(t, context.DeadlineExceeded, bus.Close(ctx))
+		close(release)
+	})
+	t.Run("should drop the events of an asynchronous handler that is too far behind", func(t *testing.T) {
+		bus := NewBus()
+		release := make(chan struct{})
+		started := make(chan struct{})
+		handled := 0
+		bus.SubscribeAsync(func(context.Context, domain.Event) error {
+			if handled == 0 {
+				close(started)
+				<-release
+			}
+			handled++
+			return nil
+		})
+		bus.Publish(context.Background(), domain.Event{Type: domain.EventTodoCreated})
+		<-started
+		for range AsyncQueueSize + 10 {
+			bus.Publish(context.Background(), domain.Event{Type: domain.EventTodoUpdated})
+		}
+		close(release)
+		assert.Nil(t, bus.Close(context.Background()))
+		assert.Equal(t, 1+AsyncQueueSize, handled)
+	})
+}
@@ -0,0 +1,181 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// SubscriberQueueSize is how many messages a subscriber can fall behind before it is dropped.
+const SubscriberQueueSize = 64
+
+var (
+	// ErrClosed is returned when subscribing to a closed stream.
+	ErrClosed = errors.New("event stream closed")
+	// ErrUnknownID is returned when resuming from a message the stream did not publish, such as
+	// one of a stream before a restart, or from one so old that the messages after it are no
+	// longer retained.
+	ErrUnknownID = errors.New("event stream message unknown")
+)
+
+type (
+	// Message is a domain event numbered in the order it was published, after the start of its
+	// stream in microseconds since the Unix epoch, so that the numbers of a stream are never the
+	// ones of the streams before it.
+	Message struct {
+		ID    uint64
+		Event domain.Event
+	}
+	// Filter selects the events of the todos with a status or in a project, any of them when empty.
+	Filter struct {
+		Status    string
+		ProjectID string
+	}
+	// Subscription is the delivery of the messages of a stream to one subscriber.
+	Subscription struct {
+		// Replay are the retained messages published after the one the subscriber resumes from
+		Replay []Message
+		// Messages are the messages published after it subscribed. It is closed when the stream is
+		// closed or when the subscriber fell behind, which can resume from the last message it got.
+		Messages <-chan Message
+		cancel   func()
+	}
+	// Stream keeps the last published domain events and pushes the new ones to its subscribers.
+	Stream interface {
+		// Subscribe returns the subscription to the events matching filter, replaying the retained
+		// ones after the message lastID, none when it is 0. It fails with ErrUnknownID when the
+		// stream did not publish the message lastID or no longer retains the one right after it.
+		Subscribe(lastID uint64, filter Filter) (*Subscription, error)
+	}
+	subscriber struct {
+		filter   Filter
+		messages chan Message
+	}
+	stream struct {
+		mu          sync.Mutex
+		buffer      []Message
+		next        int
+		firstID     uint64
+		lastID      uint64
+		subscribers map[*subscriber]struct{}
+		closed      bool
+	}
+)
+
+// NewStream returns a stream started at start, retaining the last size events for the subscribers
+// resuming from an earlier one.
+func NewStream(size int, start time.Time) *stream {
+	epoch := uint64(max(start.UnixMicro(), 0))
+	return &stream{
+		buffer:      make([]Message, 0, size),
+		firstID:     epoch + 1,
+		lastID:      epoch,
+		subscribers: map[*subscriber]struct{}{},
+	}
+}
+
+// Matches checks if the event is selected by the filter.
+func (f Filter) Matches(event domain.Event) bool {
+	if f.Status != "" && string(event.Todo.Status) != f.Status {
+		return false
+	}
+	if f.ProjectID != "" && (event.Todo.ProjectID == nil || *event.Todo.ProjectID != f.ProjectID) {
+		return false
+	}
+	return true
+}
+
+// Close ends the subscription. It does nothing for a subscription not made by a stream.
+func (s *Subscription) Close() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+// Publish numbers the event, retains it in place of the oldest one once the buffer is full, and
+// pushes it to the subscribers whose filter matches it. It never waits for them: a subscriber whose queue is
+// full is dropped instead.
+func (s *stream) Publish(_ context.Context, event domain.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.lastID++
+	message := Message{ID: s.lastID, Event: event}
+	if len(s.buffer) < cap(s.buffer) {
+		s.buffer = append(s.buffer, message)
+	} else if len(s.buffer) > 0 {
+		s.buffer[s.next] = message
+		s.next = (s.next + 1) % len(s.buffer)
+	}
+	for sub := range s.subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+		select {
+		case sub.messages <- message:
+		default:
+			s.drop(sub)
+		}
+	}
+	return nil
+}
+
+func (s *stream) Subscribe(lastID uint64, filter Filter) (*Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, ErrClosed
+	}
+	var replay []Message
+	if lastID > 0 {
+		if lastID < s.firstID || lastID > s.lastID || lastID+1 < s.oldestID() {
+			return nil, ErrUnknownID
+		}
+		for i := range s.buffer {
+			message := s.buffer[(s.next+i)%len(s.buffer)]
+			if message.ID > lastID && filter.Matches(message.Event) {
+				replay = append(replay, message)
+			}
+		}
+	}
+	sub := &subscriber{filter: filter, messages: make(chan Message, SubscriberQueueSize)}
+	s.subscribers[sub] = struct{}{}
+	return &Subscription{
+		Replay:   replay,
+		Messages: sub.messages,
+		cancel: func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.drop(sub)
+		},
+	}, nil
+}
+
+// oldestID is the id of the oldest retained message, the next one to publish when none is.
+// It must be called holding mu.
+func (s *stream) oldestID() uint64 {
+	return s.lastID + 1 - uint64(len(s.buffer))
+}
+
+// Close ends every subscription and refuses the new ones.
+func (s *stream) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for sub := range s.subscribers {
+		s.drop(sub)
+	}
+}
+
+// drop unsubscribes sub and closes its messages. It must be called holding mu.
+func (s *stream) drop(sub *subscriber) {
+	if _, ok := s.subscribers[sub]; ok {
+		delete(s.subscribers, sub)
+		close(sub.messages)
+	}
+}
@@ -0,0 +1,148 @@
+package eventstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// epoch starts the streams numbering their events from 1
+var epoch = time.Unix(0, 0)
+
+func todoEvent(eventType domain.EventType, todoID string, status domain.TodoStatus) domain.Event {
+	return domain.Event{Type: eventType, TodoID: todoID, Todo: domain.Todo{ID: todoID, Status: status}}
+}
+
+func messageIDs(messages []Message) []uint64 {
+	ids := []uint64{}
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+	return ids
+}
+
+func TestStream(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should push the published events to the subscribers in order", func(t *testing.T) {
+		stream := NewStream(10, epoch)
+		subscription, err := stream.Subscribe(0, Filter{})
+		assert.NoError(t, err)
+		_ = stream.Publish(ctx, todoEvent(domain.EventTodoCreated, "1", domain.TodoStatusPending))
+		_ = stream.Publish(ctx, todoEvent(domain.EventTodoCompleted, "1", domain.TodoStatusCompleted))
+
+		assert.Empty(t, subscription.Replay)
+		first, second := <-subscription.Messages, <-subscription.Messages
+		assert.Equal(t, Message{ID: 1, Event: todoEvent(domain.EventTodoCreated, "1", domain.TodoStatusPending)}, first)
+		assert.Equal(t, uint64(2), second.ID)
+		assert.Equal(t, domain.EventTodoCompleted, second.Event.Type)
+	})
+
+	t.Run("should replay the retained events after the last one received", func(t *testing.T) {
+		stream := NewStream(3, epoch)
+		for _, id := range []string{"1", "2", "3", "4", "5"} {
+			_ = stream.Publish(ctx, todoEvent(domain.EventTodoCreated, id, domain.TodoStatusPending))
+		}
+
+		resumed, _ := stream.Subscribe(3, Filter{})
+		assert.Equal(t, []uint64{4, 5}, messageIDs(resumed.Replay))
+		oldest, _ := stream.Subscribe(2, Filter{})
+		assert.Equal(t, []uint64{3, 4, 5}, messageIDs(oldest.Replay))
+		upToDate, _ := stream.Subscribe(5, Filter{})
+		assert.Empty(t, upToDate.Replay)
+	})
+
+	t.Run("should number the events after the start of the stream", func(t *testing.T) {
+		stream := NewStream(3, time.UnixMicro(1000))
+		_ = stream.Publish(ctx, todoEvent(domain.EventTodoCreated, "1", domain.TodoStatusPending))
+		_ = stream.Publish(ctx, todoEvent(domain.EventTodoCreated, "2", domain.TodoStatusPending))
+
+		subscription, _ := stream.Subscribe(1001, Filter{})
+		assert.Equal(t, []uint64{1002}, messageIDs(subscription.Replay))
+	})
+
+	t.Run("should refuse to resume from a message the stream did not publish", func(t *testing.T) {
+		stream := NewStream(3, time.UnixMicro(1000))
+		_ = stream.Publish(ctx, todoEvent(domain.EventTodoCreated, "1", domain.TodoStatusPending))
+
+		for _, lastID := range []uint64{3, 1000, 1002} {
+			_, err := stream.Subscribe(lastID, Filter{})
+			assert.Equal(t, ErrUnknownID, err, lastID)
+		}
+	})
+
+	t.Run("should refuse to resume from a message whose next ones are no longer retained", func(t *testing.T) {
+		stream := NewStream(3, epoch)
+		for _, id := range []string{"1", "2", "3", "4", "5", "6"} {
+			_ = stream.Publish(ctx, todoEvent(domain.EventTodoCreated, id, domain.TodoStatusPending))
+		}
+
+		for _, lastID := range []uint64{1, 2} {
+			_, err := stream.Subscribe(lastID, Filter{})
+			assert.Equal(t, ErrUnknownID, err, lastID)
+		}
+		resumed, err := stream.Subscribe(3, Filter{})
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{4, 5, 6}, messageIDs(resumed.Replay))
+	})
+
+	t.Run("should only deliver the events matching the filter", func(t *testing.T) {
+		stream := NewStream(10, epoch)
+		project := "p1"
+		inProject := todoEvent(domain.EventTodoUpdated, "2", domain.TodoStatusCompleted)
+		inProject.Todo.ProjectID = &project
+		_ = stream.Publish(ctx, todoEvent(domain.EventTodoCreated, "0", domain.TodoStatusPending))
+		_ = stream.Publish(ctx, todoEvent(domain.EventTodoCompleted, "1", domain.TodoStatusCompleted))
+		_ = stream.Publish(ctx, inProject)
+		_ = stream.Publish(ctx, todoEvent(domain.EventTodoCreated, "3", domain.TodoStatusPending))
+
+		completed, _ := stream.Subscribe(1, Filter{Status: "completed"})
+		assert.Equal(t, []uint64{2, 3}, messageIDs(completed.Replay))
+		inP1, _ := stream.Subscribe(1, Filter{Status: "completed", ProjectID: "p1"})
+		assert.Equal(t, []uint64{3}, messageIDs(inP1.Replay))
+
+		_ = stream.Publish(ctx, todoEvent(domain.EventTodoCreated, "4", domain.TodoStatusPending))
+		_ = stream.Publish(ctx, inProject)
+		assert.Equal(t, uint64(6), (<-inP1.Messages).ID)
+	})
+
+	t.Run("should drop a subscriber which fell behind", func(t *testing.T) {
+		stream := NewStream(10, epoch)
+		subscription, _ := stream.Subscribe(0, Filter{})
+		for i := 0; i <= SubscriberQueueSize; i++ {
+			_ = stream.Publish(ctx, todoEvent(domain.EventTodoUpdated, "1", domain.TodoStatusPending))
+		}
+
+		received := 0
+		for range subscription.Messages {
+			received++
+		}
+		assert.Equal(t, SubscriberQueueSize, received)
+	})
+
+	t.Run("should stop pushing events to a closed subscription", func(t *testing.T) {
+		stream := NewStream(10, epoch)
+		subscription, _ := stream.Subscribe(0, Filter{})
+		subscription.Close()
+		_ = stream.Publish(ctx, todoEvent(domain.EventTodoCreated, "1", domain.TodoStatusPending))
+
+		_, open := <-subscription.Messages
+		assert.False(t, open)
+	})
+
+	t.Run("should end the subscriptions and refuse the new ones once closed", func(t *testing.T) {
+		stream := NewStream(10, epoch)
+		subscription, _ := stream.Subscribe(0, Filter{})
+		stream.Close()
+		stream.Close()
+
+		_, open := <-subscription.Messages
+		assert.False(t, open)
+		_, err := stream.Subscribe(0, Filter{})
+		assert.Equal(t, ErrClosed, err)
+		subscription.Close()
+	})
+}
@@ -0,0 +1,43 @@
+package gorm
+
+import (
+	"context"
+
+	auditUC "github.com/wellingtonlope/todo-api/internal/app/usecase/audit"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+type auditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) *auditRepository {
+	return &auditRepository{db: db}
+}
+
+// Record appends the record to the audit log, as part of the transaction carried by ctx.
+func (r *auditRepository) Record(ctx context.Context, record domain.AuditRecord) error {
+	model := auditRecordFromDomain(record)
+	return conn(ctx, r.db).Create(&model).Error
+}
+
+// List returns the records of the audit log matching the query, the oldest first.
+func (r *auditRepository) List(ctx context.Context, q auditUC.ListQuery) ([]domain.AuditRecord, error) {
+	query := conn(ctx, r.db)
+	if q.TodoID != "" {
+		query = query.Where("todo_id = ?", q.TodoID)
+	}
+	if q.Since != nil {
+		query = query.Where("created_at >= ?", *q.Since)
+	}
+	var models []AuditRecordModel
+	if err := query.Order("created_at").Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	records := make([]domain.AuditRecord, len(models))
+	for i, m := range models {
+		records[i] = auditRecordToDomain(m)
+	}
+	return records, nil
+}
@@ -0,0 +1,41 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// AuditRecordModel is a row of the append-only audit log. It is kept when its todo is deleted.
+type AuditRecordModel struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	TodoID    string    `gorm:"not null;index"`
+	Actor     string    `gorm:"not null"`
+	Operation string    `gorm:"not null;size:20"`
+	Diff      string    `gorm:"not null;type:text"`
+	CreatedAt time.Time `gorm:"not null;index;autoCreateTime:false"`
+}
+
+func (AuditRecordModel) TableName() string {
+	return "audit_log"
+}
+
+func auditRecordToDomain(m AuditRecordModel) domain.AuditRecord {
+	return domain.AuditRecord{
+		TodoID:    m.TodoID,
+		Actor:     m.Actor,
+		Operation: domain.AuditOperation(m.Operation),
+		Diff:      []byte(m.Diff),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func auditRecordFromDomain(r domain.AuditRecord) AuditRecordModel {
+	return AuditRecordModel{
+		TodoID:    r.TodoID,
+		Actor:     r.Actor,
+		Operation: string(r.Operation),
+		Diff:      string(r.Diff),
+		CreatedAt: r.CreatedAt,
+	}
+}
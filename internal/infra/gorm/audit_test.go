@@ -0,0 +1,55 @@
+package gorm
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	auditUC "github.com/wellingtonlope/todo-api/internal/app/usecase/audit"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestAuditRepository(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	record := func(todoID string, operation domain.AuditOperation, createdAt time.Time) domain.AuditRecord {
+		return domain.AuditRecord{
+			TodoID:    todoID,
+			Actor:     "alice",
+			Operation: operation,
+			Diff:      json.RawMessage(`{"title":{"before":null,"after":"x"}}`),
+			CreatedAt: createdAt,
+		}
+	}
+	created := record("1", domain.AuditOperationCreate, date)
+	updated := record("1", domain.AuditOperationUpdate, date.Add(2*time.Hour))
+	other := record("2", domain.AuditOperationCreate, date.Add(time.Hour))
+
+	repo := NewAuditRepository(setupTestDB(t))
+	for _, r := range []domain.AuditRecord{updated, created, other} {
+		assert.NoError(t, repo.Record(ctx, r))
+	}
+
+	testCases := []struct {
+		name   string
+		query  auditUC.ListQuery
+		result []domain.AuditRecord
+	}{
+		{"should list every record, the oldest first", auditUC.ListQuery{}, []domain.AuditRecord{created, other, updated}},
+		{"should filter by todo", auditUC.ListQuery{TodoID: "1"}, []domain.AuditRecord{created, updated}},
+		{"should keep the records since a date", auditUC.ListQuery{Since: &other.CreatedAt}, []domain.AuditRecord{other, updated}},
+		{"should return no records of an unknown todo", auditUC.ListQuery{TodoID: "3"}, []domain.AuditRecord{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			records, err := repo.List(ctx, tc.query)
+			assert.NoError(t, err)
+			for i := range records {
+				records[i].CreatedAt = records[i].CreatedAt.UTC()
+			}
+			assert.Equal(t, tc.result, records)
+		})
+	}
+}
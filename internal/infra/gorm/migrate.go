@@ -0,0 +1,90 @@
+package gorm
+
+import (
+	"log"
+	"strings"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+// Migrate creates or updates the database schema, including the full-text
+// search index of the current dialect.
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&TodoModel{}, &TagModel{}, &ChecklistItemModel{}, &StatusChangeModel{}, &AuditRecordModel{},
+		&ProjectModel{}, &WebhookModel{}, &WebhookDeliveryModel{}); err != nil {
+		return err
+	}
+	if err := migrateCompletedAt(db); err != nil {
+		return err
+	}
+	return migrateSearchIndex(db)
+}
+
+// migrateCompletedAt sets when the todos completed before completed_at was recorded were
+// completed, taken as when they were last updated, so that completed_at alone tells the
+// completed todos apart.
+func migrateCompletedAt(db *gorm.DB) error {
+	return db.Model(&TodoModel{}).Unscoped().
+		Where("status = ? AND completed_at IS NULL", string(domain.TodoStatusCompleted)).
+		UpdateColumn("completed_at", gorm.Expr("updated_at")).Error
+}
+
+// migrateSearchIndex creates the full-text index used by Search.
+//
+// On MySQL it is a FULLTEXT index over title and description. On SQLite it is an
+// FTS5 table kept in sync with todos by triggers. The binary is built with the
+// sqlite_fts5 tag for it; a driver built without it gets no table, which is logged,
+// and Search falls back to pattern matching.
+func migrateSearchIndex(db *gorm.DB) error {
+	switch db.Dialector.Name() {
+	case "mysql":
+		if db.Migrator().HasIndex(&TodoModel{}, mysqlFullTextIndex) {
+			return nil
+		}
+		return db.Exec("CREATE FULLTEXT INDEX " + mysqlFullTextIndex + " ON todos (title, description)").Error
+	case "sqlite":
+		if db.Migrator().HasTable(sqliteSearchTable) {
+			return nil
+		}
+		if err := db.Exec(sqliteCreateSearchTable).Error; err != nil {
+			if strings.Contains(err.Error(), "no such module: fts5") {
+				log.Printf("Searching the todos by pattern: SQLite has no FTS5 without the sqlite_fts5 build tag")
+				return nil
+			}
+			return err
+		}
+		for _, stmt := range sqliteSearchTriggers {
+			if err := db.Exec(stmt).Error; err != nil {
+				return err
+			}
+		}
+		return db.Exec("INSERT INTO " + sqliteSearchTable + "(" + sqliteSearchTable + ") VALUES ('rebuild')").Error
+	default:
+		return nil
+	}
+}
+
+const (
+	mysqlFullTextIndex = "idx_todos_full_text"
+	sqliteSearchTable  = "todos_fts"
+
+	sqliteCreateSearchTable = `CREATE VIRTUAL TABLE todos_fts USING fts5(
+		title, description, content='todos', content_rowid='rowid'
+	)`
+)
+
+var sqliteSearchTriggers = []string{
+	`CREATE TRIGGER todos_fts_insert AFTER INSERT ON todos BEGIN
+		INSERT INTO todos_fts(rowid, title, description) VALUES (new.rowid, new.title, new.description);
+	END`,
+	`CREATE TRIGGER todos_fts_delete AFTER DELETE ON todos BEGIN
+		INSERT INTO todos_fts(todos_fts, rowid, title, description)
+		VALUES ('delete', old.rowid, old.title, old.description);
+	END`,
+	`CREATE TRIGGER todos_fts_update AFTER UPDATE ON todos BEGIN
+		INSERT INTO todos_fts(todos_fts, rowid, title, description)
+		VALUES ('delete', old.rowid, old.title, old.description);
+		INSERT INTO todos_fts(rowid, title, description) VALUES (new.rowid, new.title, new.description);
+	END`,
+}
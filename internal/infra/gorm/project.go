@@ -0,0 +1,100 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+type projectRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectRepository(db *gorm.DB) *projectRepository {
+	return &projectRepository{db: db}
+}
+
+func (r *projectRepository) Create(ctx context.Context, p domain.Project) (domain.Project, error) {
+	p.ID = uuid.New().String()
+	model := projectFromDomain(p)
+	if err := conn(ctx, r.db).Create(&model).Error; err != nil {
+		return domain.Project{}, err
+	}
+	return projectToDomain(model), nil
+}
+
+// List returns every project ordered by name.
+func (r *projectRepository) List(ctx context.Context) ([]domain.Project, error) {
+	var models []ProjectModel
+	if err := conn(ctx, r.db).Order("name").Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	projects := make([]domain.Project, len(models))
+	for i, m := range models {
+		projects[i] = projectToDomain(m)
+	}
+	return projects, nil
+}
+
+// GetByID returns the project with the id. Inside a transaction the project stays locked until it
+// ends, so it cannot be deleted in between.
+func (r *projectRepository) GetByID(ctx context.Context, id string) (domain.Project, error) {
+	var model ProjectModel
+	if err := forUpdate(ctx, conn(ctx, r.db)).First(&model, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return domain.Project{}, domain.ErrProjectNotFound
+		}
+		return domain.Project{}, err
+	}
+	return projectToDomain(model), nil
+}
+
+func (r *projectRepository) Update(ctx context.Context, p domain.Project) (domain.Project, error) {
+	model := projectFromDomain(p)
+	result := conn(ctx, r.db).Model(&model).Select("*").Where("id = ?", p.ID).Updates(&model)
+	if result.Error != nil {
+		return domain.Project{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.Project{}, domain.ErrProjectNotFound
+	}
+	return projectToDomain(model), nil
+}
+
+// CountTodos counts the todos of the project.
+func (r *projectRepository) CountTodos(ctx context.Context, projectID string) (int, error) {
+	var count int64
+	if err := conn(ctx, r.db).Model(&TodoModel{}).Where("project_id = ?", projectID).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// ListTodoIDs returns the ids of the todos of the project that are not in the trash, the oldest first.
+func (r *projectRepository) ListTodoIDs(ctx context.Context, projectID string) ([]string, error) {
+	var ids []string
+	if err := conn(ctx, r.db).Model(&TodoModel{}).Where("project_id = ?", projectID).
+		Order("created_at").Order("id").Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// DeleteByID removes the project, keeping the todos still in it, the ones in the trash too,
+// without a project.
+func (r *projectRepository) DeleteByID(ctx context.Context, id string) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&ProjectModel{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrProjectNotFound
+		}
+		return tx.Unscoped().Model(&TodoModel{}).Where("project_id = ?", id).
+			UpdateColumns(map[string]any{"project_id": nil, "version": gorm.Expr("version + 1")}).Error
+	})
+}
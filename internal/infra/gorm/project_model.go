@@ -0,0 +1,40 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// ProjectModel is a project grouping the todos whose ProjectID is its ID.
+type ProjectModel struct {
+	ID          string `gorm:"primaryKey"`
+	Name        string `gorm:"not null;size:100"`
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time `gorm:"autoUpdateTime:false"`
+}
+
+func (ProjectModel) TableName() string {
+	return "projects"
+}
+
+func projectToDomain(m ProjectModel) domain.Project {
+	return domain.Project{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func projectFromDomain(p domain.Project) ProjectModel {
+	return ProjectModel{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
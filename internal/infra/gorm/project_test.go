@@ -0,0 +1,93 @@
+package gorm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestProjectRepository(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+
+	t.Run("should create, list, get and update projects", func(t *testing.T) {
+		repo := NewProjectRepository(setupTestDB(t))
+		work, _ := domain.NewProject("Work", "", date)
+		home, _ := domain.NewProject("Home", "chores", date)
+		createdWork, err := repo.Create(ctx, work)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, createdWork.ID)
+		createdHome, err := repo.Create(ctx, home)
+		assert.NoError(t, err)
+
+		projects, err := repo.List(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Project{createdHome, createdWork}, projects)
+
+		updated, _ := createdWork.Update("Office", "", date.Add(time.Hour))
+		_, err = repo.Update(ctx, updated)
+		assert.NoError(t, err)
+		retrieved, err := repo.GetByID(ctx, createdWork.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, updated, retrieved)
+	})
+
+	t.Run("should return not found for unknown projects", func(t *testing.T) {
+		repo := NewProjectRepository(setupTestDB(t))
+		_, err := repo.GetByID(ctx, "unknown")
+		assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+		_, err = repo.Update(ctx, domain.Project{ID: "unknown", Name: "x", CreatedAt: date, UpdatedAt: date})
+		assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+		assert.ErrorIs(t, repo.DeleteByID(ctx, "unknown"), domain.ErrProjectNotFound)
+	})
+
+	setup := func(t *testing.T) (*projectRepository, *todoRepository, domain.Project, domain.Todo, domain.Todo) {
+		db := setupTestDB(t)
+		projects, todos := NewProjectRepository(db), NewTodoRepository(db)
+		project, _ := domain.NewProject("Home", "", date)
+		project, _ = projects.Create(ctx, project)
+		inProject, _ := domain.NewTodo("in project", "", date, nil)
+		inProject, _ = inProject.WithTags([]string{"home"})
+		inProject.ProjectID = &project.ID
+		inProject, _ = inProject.AddItem("first", date)
+		inProject, _ = todos.Create(ctx, inProject)
+		loose, _ := domain.NewTodo("loose", "", date, nil)
+		loose, _ = todos.Create(ctx, loose)
+		return projects, todos, project, inProject, loose
+	}
+
+	t.Run("should count and list the todos of a project", func(t *testing.T) {
+		projects, todos, project, inProject, _ := setup(t)
+		trashed, _ := domain.NewTodo("trashed", "", date.Add(time.Hour), nil)
+		trashed.ProjectID = &project.ID
+		trashed, _ = todos.Create(ctx, trashed)
+		_, _ = todos.Trash(ctx, trashed.ID, nil, date)
+		count, err := projects.CountTodos(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+		ids, err := projects.ListTodoIDs(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{inProject.ID}, ids)
+		listed, err := todos.List(ctx, todoUC.ListQuery{Filter: todoUC.ListFilter{ProjectID: &project.ID}})
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Todo{inProject}, listed)
+	})
+
+	t.Run("should keep the todos without project", func(t *testing.T) {
+		projects, todos, project, inProject, _ := setup(t)
+		_, err := todos.Trash(ctx, inProject.ID, nil, date)
+		assert.NoError(t, err)
+		assert.NoError(t, projects.DeleteByID(ctx, project.ID))
+		_, err = projects.GetByID(ctx, project.ID)
+		assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+		trash, err := todos.ListTrash(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, trash, 1)
+		assert.Nil(t, trash[0].ProjectID)
+		assert.Len(t, trash[0].Items, 1)
+	})
+}
@@ -2,8 +2,11 @@ package gorm
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
 	"github.com/wellingtonlope/todo-api/internal/domain"
 	"gorm.io/gorm"
 )
@@ -18,18 +21,31 @@ func NewTodoRepository(db *gorm.DB) *todoRepository {
 
 func (r *todoRepository) Create(ctx context.Context, t domain.Todo) (domain.Todo, error) {
 	t.ID = uuid.New().String()
-	model := fromDomain(t)
-	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+	t.Version = 1
+	model := fromDomain(withItemIDs(t))
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model).Error; err != nil {
+			return err
+		}
+		return recordStatusChange(tx, model.ID, "", model.Status, model.CreatedAt)
+	})
+	if err != nil {
 		return domain.Todo{}, err
 	}
 	return toDomain(model), nil
 }
 
-func (r *todoRepository) List(ctx context.Context, status *domain.TodoStatus) ([]domain.Todo, error) {
+// List returns the todos matching the query in the requested order.
+// Pages are selected with a keyset condition on (sort field, id) instead of an offset.
+func (r *todoRepository) List(ctx context.Context, q todoUC.ListQuery) ([]domain.Todo, error) {
 	var models []TodoModel
-	query := r.db.WithContext(ctx)
-	if status != nil {
-		query = query.Where("status = ?", string(*status))
+	query := applyFilter(preloadAssociations(conn(ctx, r.db)), q.Filter, q.Now)
+	if q.After != nil {
+		query = applyKeyset(query, q.Sort, *q.After)
+	}
+	query = applySort(query, q.Sort)
+	if q.Limit > 0 {
+		query = query.Limit(q.Limit)
 	}
 	if err := query.Find(&models).Error; err != nil {
 		return nil, err
@@ -41,9 +57,10 @@ func (r *todoRepository) List(ctx context.Context, status *domain.TodoStatus) ([
 	return todos, nil
 }
 
+// GetByID returns the todo with the id. Inside a transaction the todo stays locked until it ends.
 func (r *todoRepository) GetByID(ctx context.Context, id string) (domain.Todo, error) {
 	var model TodoModel
-	if err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error; err != nil {
+	if err := preloadAssociations(forUpdate(ctx, conn(ctx, r.db))).First(&model, "id = ?", id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return domain.Todo{}, domain.ErrTodoNotFound
 		}
@@ -52,25 +69,223 @@ func (r *todoRepository) GetByID(ctx context.Context, id string) (domain.Todo, e
 	return toDomain(model), nil
 }
 
-func (r *todoRepository) DeleteByID(ctx context.Context, id string) error {
-	result := r.db.WithContext(ctx).Delete(&TodoModel{}, "id = ?", id)
-	if result.Error != nil {
-		return result.Error
+// Trash moves the todo to the trash, deleted at date, and returns it as it was before. When
+// versions are given the todo is only moved if it is still at one of them. It keeps its tags
+// and checklist items.
+func (r *todoRepository) Trash(ctx context.Context, id string, versions []int, date time.Time) (domain.Todo, error) {
+	var model TodoModel
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := findForRemoval(ctx, tx, id, versions, &model); err != nil {
+			return err
+		}
+		return tx.Model(&TodoModel{}).Where("id = ?", id).
+			UpdateColumns(map[string]any{"deleted_at": date, "version": gorm.Expr("version + 1")}).Error
+	})
+	if err != nil {
+		return domain.Todo{}, err
 	}
-	if result.RowsAffected == 0 {
-		return domain.ErrTodoNotFound
+	return toDomain(model), nil
+}
+
+// DeleteByID removes the todo together with its tag links and checklist items, whether
+// it is in the trash or not, and returns it as it was before. When versions are given the
+// todo is only removed if it is still at one of them.
+func (r *todoRepository) DeleteByID(ctx context.Context, id string, versions []int) (domain.Todo, error) {
+	var model TodoModel
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		tx = tx.Unscoped().Session(&gorm.Session{})
+		if err := findForRemoval(ctx, tx, id, versions, &model); err != nil {
+			return err
+		}
+		if err := tx.Delete(&TodoModel{}, "id = ?", id).Error; err != nil {
+			return err
+		}
+		return deleteAssociations(tx, []string{id})
+	})
+	if err != nil {
+		return domain.Todo{}, err
 	}
-	return nil
+	return toDomain(model), nil
 }
 
-func (r *todoRepository) Update(ctx context.Context, todo domain.Todo) (domain.Todo, error) {
-	model := fromDomain(todo)
-	result := r.db.WithContext(ctx).Model(&model).Where("id = ?", todo.ID).Updates(&model)
-	if result.Error != nil {
-		return domain.Todo{}, result.Error
+// findForRemoval reads and locks the todo about to be removed, which must still be at one of
+// the versions when they are given.
+func findForRemoval(ctx context.Context, tx *gorm.DB, id string, versions []int, model *TodoModel) error {
+	query := preloadAssociations(forUpdate(ctx, tx)).Where("id = ?", id)
+	if len(versions) > 0 {
+		query = query.Where("version IN ?", versions)
+	}
+	err := query.First(model).Error
+	if err == gorm.ErrRecordNotFound {
+		return missingTodoError(tx, id)
+	}
+	return err
+}
+
+// Restore takes the todo out of the trash, updated at date, and returns it as it was in the trash.
+func (r *todoRepository) Restore(ctx context.Context, id string, date time.Time) (domain.Todo, error) {
+	var model TodoModel
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := preloadAssociations(forUpdate(ctx, tx.Unscoped())).
+			First(&model, "id = ? AND deleted_at IS NOT NULL", id).Error
+		if err == gorm.ErrRecordNotFound {
+			return domain.ErrTodoNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return tx.Unscoped().Model(&TodoModel{}).Where("id = ?", id).
+			UpdateColumns(map[string]any{"deleted_at": nil, "updated_at": date, "version": gorm.Expr("version + 1")}).Error
+	})
+	if err != nil {
+		return domain.Todo{}, err
+	}
+	return toDomain(model), nil
+}
+
+// ListTrash returns the todos in the trash, the most recently deleted first.
+func (r *todoRepository) ListTrash(ctx context.Context) ([]domain.Todo, error) {
+	var models []TodoModel
+	err := preloadAssociations(conn(ctx, r.db).Unscoped()).Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").Order("id").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	todos := make([]domain.Todo, len(models))
+	for i, m := range models {
+		todos[i] = toDomain(m)
+	}
+	return todos, nil
+}
+
+// ListTrashedBefore returns the todos moved to the trash before deletedBefore, the oldest first.
+func (r *todoRepository) ListTrashedBefore(ctx context.Context, deletedBefore time.Time) ([]domain.Todo, error) {
+	var models []TodoModel
+	err := preloadAssociations(conn(ctx, r.db).Unscoped()).Where("deleted_at < ?", deletedBefore).
+		Order("deleted_at").Order("id").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	todos := make([]domain.Todo, len(models))
+	for i, m := range models {
+		todos[i] = toDomain(m)
+	}
+	return todos, nil
+}
+
+// ListCompletedBefore returns the completed todos that are not archived and were completed before
+// completedBefore, the oldest first. Todos completed before completed_at was recorded are taken as
+// completed when they were last updated.
+func (r *todoRepository) ListCompletedBefore(ctx context.Context, completedBefore time.Time) ([]domain.Todo, error) {
+	var models []TodoModel
+	err := preloadAssociations(conn(ctx, r.db)).
+		Where("status = ? AND archived_at IS NULL AND COALESCE(completed_at, updated_at) < ?",
+			string(domain.TodoStatusCompleted), completedBefore).
+		Order("created_at").Order("id").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	todos := make([]domain.Todo, len(models))
+	for i, m := range models {
+		todos[i] = toDomain(m)
 	}
-	if result.RowsAffected == 0 {
-		return domain.Todo{}, domain.ErrTodoNotFound
+	return todos, nil
+}
+
+// deleteAssociations removes the tag links, checklist items and status history of the todos,
+// given as a list of ids or a subquery selecting them.
+func deleteAssociations(tx *gorm.DB, todoIDs any) error {
+	if err := tx.Table(todoTagsTable).Where("todo_id IN (?)", todoIDs).Delete(nil).Error; err != nil {
+		return err
+	}
+	if err := tx.Delete(&StatusChangeModel{}, "todo_id IN (?)", todoIDs).Error; err != nil {
+		return err
+	}
+	return tx.Delete(&ChecklistItemModel{}, "todo_id IN (?)", todoIDs).Error
+}
+
+// Update saves every todo field, including the empty ones, and replaces its tags and checklist items.
+// The todo is only saved if the stored one is still at its version, which is then incremented.
+// A change of status is appended to the status history, changed at the update date.
+func (r *todoRepository) Update(ctx context.Context, todo domain.Todo) (domain.Todo, error) {
+	model := fromDomain(withItemIDs(todo))
+	model.Version = todo.Version + 1
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var previous []string
+		err := tx.Model(&TodoModel{}).Where("id = ? AND version = ?", todo.ID, todo.Version).
+			Pluck("status", &previous).Error
+		if err != nil {
+			return err
+		}
+		if len(previous) == 0 {
+			return missingTodoError(tx, todo.ID)
+		}
+		result := tx.Model(&TodoModel{}).Select("*").Omit("Tags", "Items").
+			Where("id = ? AND version = ?", todo.ID, todo.Version).Updates(&model)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return missingTodoError(tx, todo.ID)
+		}
+		if previous[0] != model.Status {
+			if err := recordStatusChange(tx, todo.ID, previous[0], model.Status, model.UpdatedAt); err != nil {
+				return err
+			}
+		}
+		if err := replaceItems(tx, todo.ID, model.Items); err != nil {
+			return err
+		}
+		if len(model.Tags) == 0 {
+			return tx.Model(&model).Association("Tags").Clear()
+		}
+		return tx.Model(&model).Association("Tags").Replace(model.Tags)
+	})
+	if err != nil {
+		return domain.Todo{}, err
 	}
 	return toDomain(model), nil
 }
+
+// missingTodoError tells why a conditional write on the todo matched no row:
+// either the todo does not exist or it is at another version.
+func missingTodoError(tx *gorm.DB, id string) error {
+	var count int64
+	if err := tx.Model(&TodoModel{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.ErrTodoNotFound
+	}
+	return domain.ErrTodoVersionConflict
+}
+
+// replaceItems replaces the checklist items of the todo, saving their current positions.
+func replaceItems(tx *gorm.DB, todoID string, items []ChecklistItemModel) error {
+	if err := tx.Delete(&ChecklistItemModel{}, "todo_id = ?", todoID).Error; err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return tx.Create(&items).Error
+}
+
+// preloadAssociations loads the tags and checklist items of the queried todos.
+func preloadAssociations(db *gorm.DB) *gorm.DB {
+	return db.Preload("Tags").Preload("Items")
+}
+
+// withItemIDs assigns an id to the checklist items added since the todo was loaded.
+func withItemIDs(t domain.Todo) domain.Todo {
+	if !slices.ContainsFunc(t.Items, func(item domain.ChecklistItem) bool { return item.ID == "" }) {
+		return t
+	}
+	t.Items = slices.Clone(t.Items)
+	for i := range t.Items {
+		if t.Items[i].ID == "" {
+			t.Items[i].ID = uuid.New().String()
+		}
+	}
+	return t
+}
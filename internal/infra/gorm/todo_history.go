@@ -0,0 +1,33 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+// StatusHistory returns the status changes of the todo, the oldest first.
+func (r *todoRepository) StatusHistory(ctx context.Context, todoID string) ([]domain.StatusChange, error) {
+	var models []StatusChangeModel
+	err := conn(ctx, r.db).Where("todo_id = ?", todoID).Order("changed_at").Order("id").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]domain.StatusChange, len(models))
+	for i, m := range models {
+		changes[i] = domain.StatusChange{
+			TodoID:    m.TodoID,
+			From:      domain.TodoStatus(m.FromStatus),
+			To:        domain.TodoStatus(m.ToStatus),
+			ChangedAt: m.ChangedAt,
+		}
+	}
+	return changes, nil
+}
+
+// recordStatusChange appends a change of status to the history of the todo.
+func recordStatusChange(tx *gorm.DB, todoID, from, to string, date time.Time) error {
+	return tx.Create(&StatusChangeModel{TodoID: todoID, FromStatus: from, ToStatus: to, ChangedAt: date}).Error
+}
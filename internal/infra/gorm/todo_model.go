@@ -1,34 +1,93 @@
 package gorm
 
 import (
+	"cmp"
+	"slices"
 	"time"
 
 	"github.com/wellingtonlope/todo-api/internal/domain"
+	"gorm.io/gorm"
 )
 
 type TodoModel struct {
 	ID          string `gorm:"primaryKey"`
 	Title       string `gorm:"not null"`
 	Description string
-	Status      string `gorm:"default:'pending'"`
+	Status      string               `gorm:"default:'pending'"`
+	Priority    string               `gorm:"not null;default:'none'"`
+	Tags        []TagModel           `gorm:"many2many:todo_tags;joinForeignKey:TodoID;joinReferences:TagName"`
+	Items       []ChecklistItemModel `gorm:"foreignKey:TodoID"`
+	Recurrence  string
+	ProjectID   *string `gorm:"index"`
 	DueDate     *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CompletedAt *time.Time
+	ArchivedAt  *time.Time `gorm:"index"`
+	Version     int        `gorm:"not null;default:1"`
+	// DeletedAt is set while the todo is in the trash, which leaves it out of the queries
+	// that are not Unscoped
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 func (TodoModel) TableName() string {
 	return "todos"
 }
 
+// TagModel is a tag shared by the todos labelled with it through the todo_tags table.
+type TagModel struct {
+	Name string `gorm:"primaryKey;size:32"`
+}
+
+func (TagModel) TableName() string {
+	return "tags"
+}
+
+// ChecklistItemModel is a checklist item of a todo, Position keeping the items in order.
+type ChecklistItemModel struct {
+	ID       string `gorm:"primaryKey"`
+	TodoID   string `gorm:"not null;index"`
+	Position int    `gorm:"not null"`
+	Title    string `gorm:"not null"`
+	Done     bool   `gorm:"not null"`
+}
+
+func (ChecklistItemModel) TableName() string {
+	return "todo_items"
+}
+
+// StatusChangeModel is a row of the append-only status history of a todo, FromStatus
+// being empty for the status the todo was created with.
+type StatusChangeModel struct {
+	ID         uint      `gorm:"primaryKey;autoIncrement"`
+	TodoID     string    `gorm:"not null;index"`
+	FromStatus string    `gorm:"not null"`
+	ToStatus   string    `gorm:"not null"`
+	ChangedAt  time.Time `gorm:"not null"`
+}
+
+func (StatusChangeModel) TableName() string {
+	return "todo_status_history"
+}
+
 func toDomain(m TodoModel) domain.Todo {
 	return domain.Todo{
 		ID:          m.ID,
 		Title:       m.Title,
 		Description: m.Description,
 		Status:      domain.TodoStatus(m.Status),
+		Priority:    domain.TodoPriority(m.Priority),
+		Tags:        tagNames(m.Tags),
+		Items:       checklistItems(m.Items),
+		Recurrence:  recurrence(m.Recurrence),
+		ProjectID:   m.ProjectID,
 		DueDate:     m.DueDate,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+		CompletedAt: m.CompletedAt,
+		ArchivedAt:  m.ArchivedAt,
+		Version:     m.Version,
+		DeletedAt:   deletedAt(m.DeletedAt),
 	}
 }
 
@@ -38,8 +97,103 @@ func fromDomain(t domain.Todo) TodoModel {
 		Title:       t.Title,
 		Description: t.Description,
 		Status:      string(t.Status),
+		Priority:    string(t.Priority),
+		Tags:        tagModels(t.Tags),
+		Items:       checklistItemModels(t.ID, t.Items),
+		Recurrence:  recurrenceRule(t.Recurrence),
+		ProjectID:   t.ProjectID,
 		DueDate:     t.DueDate,
 		CreatedAt:   t.CreatedAt,
 		UpdatedAt:   t.UpdatedAt,
+		CompletedAt: t.CompletedAt,
+		ArchivedAt:  t.ArchivedAt,
+		Version:     t.Version,
+		DeletedAt:   gormDeletedAt(t.DeletedAt),
+	}
+}
+
+// deletedAt returns when the todo was moved to the trash, nil when it is not there.
+func deletedAt(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
+
+func gormDeletedAt(t *time.Time) gorm.DeletedAt {
+	if t == nil {
+		return gorm.DeletedAt{}
+	}
+	return gorm.DeletedAt{Time: *t, Valid: true}
+}
+
+// tagNames returns the sorted names of the tags, nil when there are none.
+func tagNames(tags []TagModel) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	slices.Sort(names)
+	return names
+}
+
+func tagModels(names []string) []TagModel {
+	if len(names) == 0 {
+		return nil
+	}
+	tags := make([]TagModel, len(names))
+	for i, name := range names {
+		tags[i] = TagModel{Name: name}
+	}
+	return tags
+}
+
+// checklistItems returns the items in their position order, nil when there are none.
+func checklistItems(models []ChecklistItemModel) []domain.ChecklistItem {
+	if len(models) == 0 {
+		return nil
+	}
+	models = slices.Clone(models)
+	slices.SortFunc(models, func(a, b ChecklistItemModel) int {
+		return cmp.Compare(a.Position, b.Position)
+	})
+	items := make([]domain.ChecklistItem, len(models))
+	for i, m := range models {
+		items[i] = domain.ChecklistItem{ID: m.ID, Title: m.Title, Done: m.Done}
+	}
+	return items
+}
+
+func checklistItemModels(todoID string, items []domain.ChecklistItem) []ChecklistItemModel {
+	if len(items) == 0 {
+		return nil
+	}
+	models := make([]ChecklistItemModel, len(items))
+	for i, item := range items {
+		models[i] = ChecklistItemModel{ID: item.ID, TodoID: todoID, Position: i, Title: item.Title, Done: item.Done}
+	}
+	return models
+}
+
+// recurrence parses the stored rule, nil when the todo does not recur. Rules are
+// validated before they are saved, so one that fails to parse is treated as none.
+func recurrence(rule string) *domain.Recurrence {
+	if rule == "" {
+		return nil
+	}
+	r, err := domain.ParseRecurrence(rule)
+	if err != nil {
+		return nil
+	}
+	return &r
+}
+
+func recurrenceRule(r *domain.Recurrence) string {
+	if r == nil {
+		return ""
 	}
+	return r.String()
 }
@@ -29,15 +29,24 @@ func TestToDomain(t *testing.T) {
 				Title:       "Test Title",
 				Description: "Test Description",
 				Status:      "completed",
-				DueDate:     &exampleDueDate,
-				CreatedAt:   exampleDate,
-				UpdatedAt:   exampleDate,
+				Priority:    "high",
+				Recurrence:  "FREQ=DAILY;COUNT=2",
+				Items: []ChecklistItemModel{
+					{ID: "2", TodoID: "123", Position: 1, Title: "second"},
+					{ID: "1", TodoID: "123", Position: 0, Title: "first", Done: true},
+				},
+				DueDate:   &exampleDueDate,
+				CreatedAt: exampleDate,
+				UpdatedAt: exampleDate,
 			},
 			output: domain.Todo{
 				ID:          "123",
 				Title:       "Test Title",
 				Description: "Test Description",
 				Status:      domain.TodoStatusCompleted,
+				Priority:    domain.TodoPriorityHigh,
+				Recurrence:  &domain.Recurrence{Frequency: domain.RecurrenceDaily, Interval: 1, Count: 2},
+				Items:       []domain.ChecklistItem{{ID: "1", Title: "first", Done: true}, {ID: "2", Title: "second"}},
 				DueDate:     &exampleDueDate,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -111,6 +120,9 @@ func TestFromDomain(t *testing.T) {
 				Title:       "Test Title",
 				Description: "Test Description",
 				Status:      domain.TodoStatusCompleted,
+				Priority:    domain.TodoPriorityHigh,
+				Recurrence:  &domain.Recurrence{Frequency: domain.RecurrenceDaily, Interval: 1, Count: 2},
+				Items:       []domain.ChecklistItem{{ID: "1", Title: "first", Done: true}, {ID: "2", Title: "second"}},
 				DueDate:     &exampleDueDate,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -120,9 +132,15 @@ func TestFromDomain(t *testing.T) {
 				Title:       "Test Title",
 				Description: "Test Description",
 				Status:      "completed",
-				DueDate:     &exampleDueDate,
-				CreatedAt:   exampleDate,
-				UpdatedAt:   exampleDate,
+				Priority:    "high",
+				Recurrence:  "FREQ=DAILY;COUNT=2",
+				Items: []ChecklistItemModel{
+					{ID: "1", TodoID: "123", Position: 0, Title: "first", Done: true},
+					{ID: "2", TodoID: "123", Position: 1, Title: "second"},
+				},
+				DueDate:   &exampleDueDate,
+				CreatedAt: exampleDate,
+				UpdatedAt: exampleDate,
 			},
 		},
 		{
@@ -0,0 +1,135 @@
+package gorm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+const todoTagsTable = "todo_tags"
+
+var sortColumns = map[todoUC.ListSortField]string{
+	todoUC.ListSortByDueDate:   "due_date",
+	todoUC.ListSortByCreatedAt: "created_at",
+	todoUC.ListSortByUpdatedAt: "updated_at",
+	todoUC.ListSortByTitle:     "title",
+	todoUC.ListSortByPriority:  priorityRank(),
+}
+
+// priorityRank returns the SQL expression of the priority rank, so priorities are
+// ordered by importance instead of alphabetically.
+func priorityRank() string {
+	var expr strings.Builder
+	expr.WriteString("(CASE priority")
+	for _, priority := range domain.TodoPriorities {
+		fmt.Fprintf(&expr, " WHEN '%s' THEN %d", priority, priority.Rank())
+	}
+	expr.WriteString(" ELSE 0 END)")
+	return expr.String()
+}
+
+// applyFilter translates the list filter into SQL conditions.
+// now is the reference instant of the overdue condition.
+func applyFilter(query *gorm.DB, filter todoUC.ListFilter, now time.Time) *gorm.DB {
+	if filter.Status != nil {
+		query = query.Where("status = ?", string(*filter.Status))
+	}
+	if filter.Priority != nil {
+		query = query.Where("priority = ?", string(*filter.Priority))
+	}
+	if len(filter.Tags) > 0 {
+		tagged := query.Session(&gorm.Session{NewDB: true}).Table(todoTagsTable).
+			Select("todo_id").Where("tag_name IN ?", filter.Tags)
+		if filter.TagMode == todoUC.TagMatchAll {
+			tagged = tagged.Group("todo_id").Having("COUNT(*) = ?", len(filter.Tags))
+		}
+		query = query.Where("id IN (?)", tagged)
+	}
+	if filter.ProjectID != nil {
+		query = query.Where("project_id = ?", *filter.ProjectID)
+	}
+	if filter.DueBefore != nil {
+		query = query.Where("due_date < ?", *filter.DueBefore)
+	}
+	if filter.DueAfter != nil {
+		query = query.Where("due_date > ?", *filter.DueAfter)
+	}
+	if filter.Overdue {
+		query = query.Where("due_date < ? AND completed_at IS NULL", now)
+	}
+	if filter.NoDueDate {
+		query = query.Where("due_date IS NULL")
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at > ?", *filter.CreatedAfter)
+	}
+	if filter.UpdatedSince != nil {
+		query = query.Where("updated_at >= ?", *filter.UpdatedSince)
+	}
+	if !filter.IncludeArchived {
+		query = query.Where("archived_at IS NULL")
+	}
+	return query
+}
+
+// sortColumn returns the column of the sort field, defaulting to the creation date.
+func sortColumn(field todoUC.ListSortField) string {
+	if column, ok := sortColumns[field]; ok {
+		return column
+	}
+	return sortColumns[todoUC.DefaultListSort.Field]
+}
+
+// sortOperators returns the SQL direction and the keyset comparison operator of a sort.
+func sortOperators(sort todoUC.ListSort) (string, string) {
+	if sort.Direction == todoUC.SortDescending {
+		return "DESC", "<"
+	}
+	return "ASC", ">"
+}
+
+// applySort orders the query by the sort field using id as tie-breaker.
+// Null due dates are placed last in both directions.
+func applySort(query *gorm.DB, sort todoUC.ListSort) *gorm.DB {
+	direction, _ := sortOperators(sort)
+	column := sortColumn(sort.Field)
+	if sort.Field == todoUC.ListSortByDueDate {
+		query = query.Order("due_date IS NULL")
+	}
+	return query.Order(column + " " + direction).Order("id " + direction)
+}
+
+// applyKeyset restricts the query to the todos placed after the cursor in the given sort.
+func applyKeyset(query *gorm.DB, sort todoUC.ListSort, after todoUC.ListCursor) *gorm.DB {
+	_, op := sortOperators(sort)
+	if sort.Field == todoUC.ListSortByDueDate {
+		if after.DueDate == nil {
+			return query.Where("(due_date IS NULL AND id "+op+" ?)", after.ID)
+		}
+		return query.Where(
+			"((due_date IS NOT NULL AND (due_date "+op+" ? OR (due_date = ? AND id "+op+" ?))) OR due_date IS NULL)",
+			*after.DueDate, *after.DueDate, after.ID)
+	}
+	column := sortColumn(sort.Field)
+	value := cursorValue(sort.Field, after)
+	return query.Where("("+column+" "+op+" ? OR ("+column+" = ? AND id "+op+" ?))",
+		value, value, after.ID)
+}
+
+// cursorValue returns the cursor key matching the sort field.
+func cursorValue(field todoUC.ListSortField, after todoUC.ListCursor) any {
+	switch field {
+	case todoUC.ListSortByUpdatedAt:
+		return after.UpdatedAt
+	case todoUC.ListSortByTitle:
+		return after.Title
+	case todoUC.ListSortByPriority:
+		return after.Priority.Rank()
+	default:
+		return after.CreatedAt
+	}
+}
@@ -0,0 +1,103 @@
+package gorm
+
+import (
+	"context"
+	"strings"
+
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Search returns the todos that are not archived with a word starting with any of the terms,
+// best matches first. It uses the full-text index created by Migrate and falls back to pattern
+// matching when the database has none.
+func (r *todoRepository) Search(ctx context.Context, q todoUC.SearchQuery) ([]domain.Todo, error) {
+	db := conn(ctx, r.db)
+	query := preloadAssociations(db.Model(&TodoModel{})).Where("todos.archived_at IS NULL")
+	if q.Status != nil {
+		query = query.Where("todos.status = ?", string(*q.Status))
+	}
+	switch {
+	case db.Dialector.Name() == "mysql":
+		query = mysqlFullTextSearch(query, q.Terms)
+	case db.Dialector.Name() == "sqlite" && db.Migrator().HasTable(sqliteSearchTable):
+		query = sqliteFullTextSearch(query, q.Terms)
+	default:
+		query = patternSearch(query, q.Terms)
+	}
+	if q.Limit > 0 {
+		query = query.Limit(q.Limit)
+	}
+	var models []TodoModel
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+	todos := make([]domain.Todo, len(models))
+	for i, m := range models {
+		todos[i] = toDomain(m)
+	}
+	return todos, nil
+}
+
+// sqliteFullTextSearch matches the terms as prefixes in the FTS5 table, ranked by bm25
+// with title matches weighted above description matches.
+func sqliteFullTextSearch(query *gorm.DB, terms []string) *gorm.DB {
+	prefixes := make([]string, len(terms))
+	for i, term := range terms {
+		prefixes[i] = `"` + term + `"*`
+	}
+	return query.
+		Joins("JOIN todos_fts ON todos_fts.rowid = todos.rowid").
+		Where("todos_fts MATCH ?", strings.Join(prefixes, " OR ")).
+		Clauses(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "bm25(todos_fts, 10.0, 1.0), todos.created_at, todos.id",
+			WithoutParentheses: true,
+		}})
+}
+
+// mysqlFullTextSearch matches the terms as prefixes against the FULLTEXT index,
+// ranked by MySQL relevance.
+func mysqlFullTextSearch(query *gorm.DB, terms []string) *gorm.DB {
+	prefixes := make([]string, len(terms))
+	for i, term := range terms {
+		prefixes[i] = term + "*"
+	}
+	against := strings.Join(prefixes, " ")
+	return query.
+		Where("MATCH(title, description) AGAINST (? IN BOOLEAN MODE)", against).
+		Clauses(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "MATCH(title, description) AGAINST (? IN BOOLEAN MODE) DESC, created_at, id",
+			Vars:               []any{against},
+			WithoutParentheses: true,
+		}})
+}
+
+// patternSearch is the SQLite search without FTS5. It matches the terms at the start of a word of
+// title or description, the terms being made of letters and digits only, and ranks by the number
+// of matching terms, a title match counting twice.
+func patternSearch(query *gorm.DB, terms []string) *gorm.DB {
+	const (
+		inTitle       = "(' ' || LOWER(todos.title)) GLOB ?"
+		inDescription = "(' ' || LOWER(todos.description)) GLOB ?"
+	)
+	conditions := make([]string, 0, len(terms))
+	scores := make([]string, 0, len(terms))
+	var conditionVars, scoreVars []any
+	for _, term := range terms {
+		pattern := "*[^a-z0-9]" + term + "*"
+		conditions = append(conditions, inTitle+" OR "+inDescription)
+		conditionVars = append(conditionVars, pattern, pattern)
+		scores = append(scores,
+			"CASE WHEN "+inTitle+" THEN 2 ELSE 0 END + CASE WHEN "+inDescription+" THEN 1 ELSE 0 END")
+		scoreVars = append(scoreVars, pattern, pattern)
+	}
+	return query.
+		Where("("+strings.Join(conditions, " OR ")+")", conditionVars...).
+		Clauses(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "(" + strings.Join(scores, " + ") + ") DESC, todos.created_at, todos.id",
+			Vars:               scoreVars,
+			WithoutParentheses: true,
+		}})
+}
@@ -0,0 +1,22 @@
+package gorm
+
+import (
+	"context"
+
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+// ListTags counts the todos linked to each tag. Tags without todos, or only with todos
+// in the trash, are left out.
+func (r *todoRepository) ListTags(ctx context.Context) ([]todoUC.TagUsage, error) {
+	var tags []todoUC.TagUsage
+	err := conn(ctx, r.db).Table(todoTagsTable).
+		Joins("JOIN todos ON todos.id = " + todoTagsTable + ".todo_id AND todos.deleted_at IS NULL").
+		Select("tag_name AS name, COUNT(*) AS count").
+		Group("tag_name").
+		Scan(&tags).Error
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
@@ -0,0 +1,100 @@
+package gorm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestTags(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	ctx := context.Background()
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var created []domain.Todo
+	for i, td := range []domain.Todo{
+		{Title: "Write report", Tags: []string{"urgent", "work"}},
+		{Title: "Fix sink", Tags: []string{"home"}},
+		{Title: "Plan trip", Tags: []string{"home", "work"}},
+		{Title: "Read book"},
+	} {
+		td.Status = domain.TodoStatusPending
+		td.CreatedAt = date.Add(time.Duration(i) * time.Hour)
+		td.UpdatedAt = td.CreatedAt
+		c, err := repo.Create(ctx, td)
+		assert.NoError(t, err)
+		created = append(created, c)
+	}
+
+	t.Run("should load the tags of a todo", func(t *testing.T) {
+		got, err := repo.GetByID(ctx, created[2].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"home", "work"}, got.Tags)
+	})
+
+	t.Run("should filter by tags", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			filter todoUC.ListFilter
+			titles []string
+		}{
+			{"any tag", todoUC.ListFilter{Tags: []string{"home", "urgent"}}, []string{"Write report", "Fix sink", "Plan trip"}},
+			{"all tags", todoUC.ListFilter{Tags: []string{"home", "work"}, TagMode: todoUC.TagMatchAll}, []string{"Plan trip"}},
+			{"unknown tag", todoUC.ListFilter{Tags: []string{"garden"}}, []string{}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				todos, err := repo.List(ctx, todoUC.ListQuery{Filter: tc.filter})
+				assert.NoError(t, err)
+				titles := make([]string, 0, len(todos))
+				for _, td := range todos {
+					titles = append(titles, td.Title)
+				}
+				assert.Equal(t, tc.titles, titles)
+			})
+		}
+	})
+
+	t.Run("should count the todos of each tag", func(t *testing.T) {
+		tags, err := repo.ListTags(ctx)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []todoUC.TagUsage{
+			{Name: "home", Count: 2},
+			{Name: "urgent", Count: 1},
+			{Name: "work", Count: 2},
+		}, tags)
+	})
+
+	t.Run("should replace the tags on update", func(t *testing.T) {
+		updated := created[0]
+		updated.Tags = []string{"blocked", "work"}
+		updated, err := repo.Update(ctx, updated)
+		assert.NoError(t, err)
+		got, err := repo.GetByID(ctx, updated.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"blocked", "work"}, got.Tags)
+
+		updated.Tags = nil
+		_, err = repo.Update(ctx, updated)
+		assert.NoError(t, err)
+		got, err = repo.GetByID(ctx, updated.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, got.Tags)
+	})
+
+	t.Run("should unlink the tags of a deleted todo", func(t *testing.T) {
+		_, err := repo.DeleteByID(ctx, created[1].ID, nil)
+		assert.NoError(t, err)
+		tags, err := repo.ListTags(ctx)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []todoUC.TagUsage{
+			{Name: "home", Count: 1},
+			{Name: "work", Count: 1},
+		}, tags)
+	})
+}
@@ -2,10 +2,12 @@ package gorm
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
 	"github.com/wellingtonlope/todo-api/internal/domain"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -14,7 +16,7 @@ import (
 func setupTestDB(t *testing.T) *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	assert.NoError(t, err)
-	err = db.AutoMigrate(&TodoModel{})
+	err = Migrate(db)
 	assert.NoError(t, err)
 	return db
 }
@@ -45,7 +47,7 @@ func TestList(t *testing.T) {
 	repo := NewTodoRepository(db)
 
 	// Test empty list
-	todos, err := repo.List(context.Background(), nil)
+	todos, err := repo.List(context.Background(), todoUC.ListQuery{})
 	assert.Nil(t, err)
 	assert.Len(t, todos, 0)
 
@@ -57,7 +59,7 @@ func TestList(t *testing.T) {
 	created2, _ := repo.Create(context.Background(), todo2)
 
 	// Test list all
-	todos, err = repo.List(context.Background(), nil)
+	todos, err = repo.List(context.Background(), todoUC.ListQuery{})
 	assert.Nil(t, err)
 	assert.Len(t, todos, 2)
 	titles := make([]string, len(todos))
@@ -68,25 +70,176 @@ func TestList(t *testing.T) {
 	assert.Contains(t, titles, "Todo 2")
 
 	// Complete one todo
-	completedTodo := created1.MarkAsCompleted(time.Now().UTC())
+	completedTodo, _ := created1.Transition(domain.DefaultWorkflow(), domain.TodoStatusCompleted, time.Now().UTC())
 	_, err = repo.Update(context.Background(), completedTodo)
 	assert.Nil(t, err)
 
 	// Test filter by pending status
 	pendingStatus := domain.TodoStatusPending
-	pendingTodos, err := repo.List(context.Background(), &pendingStatus)
+	pendingTodos, err := repo.List(context.Background(), todoUC.ListQuery{Filter: todoUC.ListFilter{Status: &pendingStatus}})
 	assert.Nil(t, err)
 	assert.Len(t, pendingTodos, 1)
 	assert.Equal(t, created2.ID, pendingTodos[0].ID)
 
 	// Test filter by completed status
 	completedStatus := domain.TodoStatusCompleted
-	completedTodos, err := repo.List(context.Background(), &completedStatus)
+	completedTodos, err := repo.List(context.Background(), todoUC.ListQuery{Filter: todoUC.ListFilter{Status: &completedStatus}})
 	assert.Nil(t, err)
 	assert.Len(t, completedTodos, 1)
 	assert.Equal(t, created1.ID, completedTodos[0].ID)
 }
 
+func TestListFilter(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondCreated := date.Add(time.Hour)
+	now := date.Add(10 * 24 * time.Hour)
+	past := now.Add(-24 * time.Hour)
+	future := now.Add(24 * time.Hour)
+	pendingStatus := domain.TodoStatusPending
+	highPriority := domain.TodoPriorityHigh
+	inputs := []domain.Todo{
+		{Title: "Overdue pending", Status: domain.TodoStatusPending, Priority: domain.TodoPriorityHigh, DueDate: &past},
+		{Title: "Overdue completed", Status: domain.TodoStatusCompleted, Priority: domain.TodoPriorityHigh, DueDate: &past, CompletedAt: &past},
+		{Title: "Due soon", Status: domain.TodoStatusPending, Priority: domain.TodoPriorityLow, DueDate: &future},
+		{Title: "No due date", Status: domain.TodoStatusPending, Priority: domain.TodoPriorityNone},
+		{Title: "Archived", Status: domain.TodoStatusCompleted, Priority: domain.TodoPriorityNone, ArchivedAt: &past},
+	}
+	for i, td := range inputs {
+		td.CreatedAt = date.Add(time.Duration(i) * time.Hour)
+		td.UpdatedAt = td.CreatedAt
+		_, err := repo.Create(context.Background(), td)
+		assert.Nil(t, err)
+	}
+
+	testCases := []struct {
+		name   string
+		filter todoUC.ListFilter
+		titles []string
+	}{
+		{"due before", todoUC.ListFilter{DueBefore: &now}, []string{"Overdue pending", "Overdue completed"}},
+		{"due after", todoUC.ListFilter{DueAfter: &now}, []string{"Due soon"}},
+		{"overdue", todoUC.ListFilter{Overdue: true}, []string{"Overdue pending"}},
+		{"no due date", todoUC.ListFilter{NoDueDate: true}, []string{"No due date"}},
+		{"created after", todoUC.ListFilter{CreatedAfter: &secondCreated}, []string{"Due soon", "No due date"}},
+		{"updated since", todoUC.ListFilter{UpdatedSince: &secondCreated}, []string{"Overdue completed", "Due soon", "No due date"}},
+		{"priority", todoUC.ListFilter{Priority: &highPriority}, []string{"Overdue pending", "Overdue completed"}},
+		{"combined", todoUC.ListFilter{Status: &pendingStatus, DueBefore: &now}, []string{"Overdue pending"}},
+		{"include archived", todoUC.ListFilter{NoDueDate: true, IncludeArchived: true}, []string{"No due date", "Archived"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			todos, err := repo.List(context.Background(), todoUC.ListQuery{Filter: tc.filter, Now: now})
+			assert.Nil(t, err)
+			titles := make([]string, 0, len(todos))
+			for _, td := range todos {
+				titles = append(titles, td.Title)
+			}
+			assert.Equal(t, tc.titles, titles)
+		})
+	}
+}
+
+func TestListPagination(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	date := time.Now().UTC()
+
+	// Two todos share the same creation date to exercise the id tie-breaker
+	var created []domain.Todo
+	for i, offset := range []time.Duration{0, time.Second, time.Second, 2 * time.Second} {
+		todo, _ := domain.NewTodo(fmt.Sprintf("Todo %d", i), "", date.Add(offset), nil)
+		c, err := repo.Create(context.Background(), todo)
+		assert.Nil(t, err)
+		created = append(created, c)
+	}
+	if created[2].ID < created[1].ID {
+		created[1], created[2] = created[2], created[1]
+	}
+
+	var ids []string
+	query := todoUC.ListQuery{Limit: 2}
+	for {
+		page, err := repo.List(context.Background(), query)
+		assert.Nil(t, err)
+		for _, td := range page {
+			ids = append(ids, td.ID)
+		}
+		if len(page) < query.Limit {
+			break
+		}
+		last := page[len(page)-1]
+		query.After = &todoUC.ListCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+	assert.Equal(t, []string{created[0].ID, created[1].ID, created[2].ID, created[3].ID}, ids)
+}
+
+func TestListSort(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dueSoon := date.Add(24 * time.Hour)
+	dueLater := date.Add(48 * time.Hour)
+	for _, td := range []domain.Todo{
+		{Title: "Charlie", Priority: domain.TodoPriorityLow, DueDate: &dueLater, CreatedAt: date, UpdatedAt: date.Add(3 * time.Hour)},
+		{Title: "Alpha", Priority: domain.TodoPriorityUrgent, CreatedAt: date.Add(time.Hour), UpdatedAt: date.Add(time.Hour)},
+		{Title: "Bravo", DueDate: &dueSoon, CreatedAt: date.Add(2 * time.Hour), UpdatedAt: date.Add(2 * time.Hour)},
+	} {
+		_, err := repo.Create(context.Background(), td)
+		assert.Nil(t, err)
+	}
+
+	testCases := []struct {
+		name   string
+		sort   todoUC.ListSort
+		titles []string
+	}{
+		{"due date ascending with null last", todoUC.ListSort{Field: todoUC.ListSortByDueDate, Direction: todoUC.SortAscending}, []string{"Bravo", "Charlie", "Alpha"}},
+		{"due date descending with null last", todoUC.ListSort{Field: todoUC.ListSortByDueDate, Direction: todoUC.SortDescending}, []string{"Charlie", "Bravo", "Alpha"}},
+		{"created at ascending", todoUC.ListSort{Field: todoUC.ListSortByCreatedAt, Direction: todoUC.SortAscending}, []string{"Charlie", "Alpha", "Bravo"}},
+		{"created at descending", todoUC.ListSort{Field: todoUC.ListSortByCreatedAt, Direction: todoUC.SortDescending}, []string{"Bravo", "Alpha", "Charlie"}},
+		{"updated at ascending", todoUC.ListSort{Field: todoUC.ListSortByUpdatedAt, Direction: todoUC.SortAscending}, []string{"Alpha", "Bravo", "Charlie"}},
+		{"updated at descending", todoUC.ListSort{Field: todoUC.ListSortByUpdatedAt, Direction: todoUC.SortDescending}, []string{"Charlie", "Bravo", "Alpha"}},
+		{"title ascending", todoUC.ListSort{Field: todoUC.ListSortByTitle, Direction: todoUC.SortAscending}, []string{"Alpha", "Bravo", "Charlie"}},
+		{"title descending", todoUC.ListSort{Field: todoUC.ListSortByTitle, Direction: todoUC.SortDescending}, []string{"Charlie", "Bravo", "Alpha"}},
+		{"priority ascending", todoUC.ListSort{Field: todoUC.ListSortByPriority, Direction: todoUC.SortAscending}, []string{"Bravo", "Charlie", "Alpha"}},
+		{"priority descending", todoUC.ListSort{Field: todoUC.ListSortByPriority, Direction: todoUC.SortDescending}, []string{"Alpha", "Charlie", "Bravo"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			all, err := repo.List(context.Background(), todoUC.ListQuery{Sort: tc.sort})
+			assert.Nil(t, err)
+			var titles []string
+			for _, td := range all {
+				titles = append(titles, td.Title)
+			}
+			assert.Equal(t, tc.titles, titles)
+
+			// Paginating one todo at a time must yield the same order
+			var paged []string
+			query := todoUC.ListQuery{Sort: tc.sort, Limit: 1}
+			for range len(tc.titles) + 1 {
+				page, err := repo.List(context.Background(), query)
+				assert.Nil(t, err)
+				if len(page) == 0 {
+					break
+				}
+				paged = append(paged, page[0].Title)
+				query.After = &todoUC.ListCursor{
+					ID:        page[0].ID,
+					Title:     page[0].Title,
+					Priority:  page[0].Priority,
+					DueDate:   page[0].DueDate,
+					CreatedAt: page[0].CreatedAt,
+					UpdatedAt: page[0].UpdatedAt,
+				}
+			}
+			assert.Equal(t, tc.titles, paged)
+		})
+	}
+}
+
 func TestGetByID(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewTodoRepository(db)
@@ -123,13 +276,189 @@ func TestDeleteByID(t *testing.T) {
 	todo, _ := domain.NewTodo("Test", "", date, nil)
 	created, _ := repo.Create(context.Background(), todo)
 
-	err := repo.DeleteByID(context.Background(), created.ID)
+	stale := 2
+	_, err := repo.DeleteByID(context.Background(), created.ID, []int{stale})
+	assert.Equal(t, domain.ErrTodoVersionConflict, err)
+
+	deleted, err := repo.DeleteByID(context.Background(), created.ID, []int{created.Version})
 	assert.Nil(t, err)
+	assert.Equal(t, created, deleted)
 	_, err = repo.GetByID(context.Background(), created.ID)
 	assert.Equal(t, domain.ErrTodoNotFound, err)
 
-	err = repo.DeleteByID(context.Background(), "999") // non-existing
+	_, err = repo.DeleteByID(context.Background(), "999", nil) // non-existing
+	assert.Equal(t, domain.ErrTodoNotFound, err)
+}
+
+func TestTrash(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	ctx := context.Background()
+	date := time.Now().UTC().Truncate(time.Second)
+	todo, _ := domain.NewTodo("Test", "", date, nil)
+	todo.Tags = []string{"work"}
+	todo, _ = todo.AddItem("step", date)
+	created, _ := repo.Create(ctx, todo)
+	kept, _ := repo.Create(ctx, todo)
+
+	stale := 2
+	_, err := repo.Trash(ctx, created.ID, []int{stale}, date)
+	assert.Equal(t, domain.ErrTodoVersionConflict, err)
+	_, err = repo.Trash(ctx, "999", nil, date)
+	assert.Equal(t, domain.ErrTodoNotFound, err)
+
+	deletedAt := date.Add(time.Hour)
+	trashed, err := repo.Trash(ctx, created.ID, []int{created.Version}, deletedAt)
+	assert.Nil(t, err)
+	assert.Equal(t, created.ID, trashed.ID)
+	assert.Nil(t, trashed.DeletedAt)
+	assert.Equal(t, []string{"work"}, trashed.Tags)
+	_, err = repo.GetByID(ctx, created.ID)
+	assert.Equal(t, domain.ErrTodoNotFound, err)
+	todos, _ := repo.List(ctx, todoUC.ListQuery{})
+	assert.Len(t, todos, 1)
+	tags, _ := repo.ListTags(ctx)
+	assert.Equal(t, []todoUC.TagUsage{{Name: "work", Count: 1}}, tags)
+	_, err = repo.Trash(ctx, created.ID, nil, deletedAt)
+	assert.Equal(t, domain.ErrTodoNotFound, err)
+
+	trash, err := repo.ListTrash(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, trash, 1)
+	assert.Equal(t, created.ID, trash[0].ID)
+	assert.Equal(t, deletedAt, trash[0].DeletedAt.UTC())
+	assert.Equal(t, 2, trash[0].Version)
+
+	restoredAt := date.Add(2 * time.Hour)
+	trashed, err = repo.Restore(ctx, created.ID, restoredAt)
+	assert.Nil(t, err)
+	assert.Equal(t, deletedAt, trashed.DeletedAt.UTC())
+	assert.Equal(t, 2, trashed.Version)
+	restored, err := repo.GetByID(ctx, created.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, restored.DeletedAt)
+	assert.Equal(t, restoredAt, restored.UpdatedAt.UTC())
+	assert.Equal(t, 3, restored.Version)
+	assert.Equal(t, []string{"work"}, restored.Tags)
+	assert.Len(t, restored.Items, 1)
+	_, err = repo.Restore(ctx, created.ID, restoredAt)
 	assert.Equal(t, domain.ErrTodoNotFound, err)
+	_, err = repo.Restore(ctx, kept.ID, restoredAt)
+	assert.Equal(t, domain.ErrTodoNotFound, err)
+
+	t.Run("should delete a todo in the trash for good", func(t *testing.T) {
+		_, err := repo.Trash(ctx, kept.ID, nil, date)
+		assert.Nil(t, err)
+		deleted, err := repo.DeleteByID(ctx, kept.ID, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, date, deleted.DeletedAt.UTC())
+		trash, _ := repo.ListTrash(ctx)
+		assert.Len(t, trash, 0)
+		var items int64
+		db.Model(&ChecklistItemModel{}).Where("todo_id = ?", kept.ID).Count(&items)
+		assert.Zero(t, items)
+	})
+}
+
+func TestListTrashedBefore(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	ctx := context.Background()
+	date := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	deletedAt := map[string]time.Time{
+		"Deleted recently":    date,
+		"Deleted long ago":    date.Add(-72 * time.Hour),
+		"Deleted a while ago": date.Add(-48 * time.Hour),
+	}
+	for _, title := range []string{"Deleted recently", "Deleted long ago", "Deleted a while ago", "Kept"} {
+		created, err := repo.Create(ctx, domain.Todo{Title: title, Status: domain.TodoStatusPending, CreatedAt: date})
+		assert.Nil(t, err)
+		if at, ok := deletedAt[title]; ok {
+			_, err = repo.Trash(ctx, created.ID, nil, at)
+			assert.Nil(t, err)
+		}
+	}
+
+	todos, err := repo.ListTrashedBefore(ctx, date.Add(-24*time.Hour))
+	assert.Nil(t, err)
+	titles := make([]string, len(todos))
+	for i, td := range todos {
+		titles[i] = td.Title
+	}
+	assert.Equal(t, []string{"Deleted long ago", "Deleted a while ago"}, titles)
+	assert.Equal(t, 2, todos[0].Version)
+}
+
+func TestListCompletedBefore(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	ctx := context.Background()
+	date := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	old := date.Add(-48 * time.Hour)
+	inputs := []domain.Todo{
+		{Title: "Completed long ago", Status: domain.TodoStatusCompleted, UpdatedAt: old},
+		{Title: "Completed recently", Status: domain.TodoStatusCompleted, UpdatedAt: date},
+		{Title: "Pending", Status: domain.TodoStatusPending, UpdatedAt: old},
+		{Title: "Archived", Status: domain.TodoStatusCompleted, UpdatedAt: old, ArchivedAt: &old},
+		{Title: "Completed recently, edited", Status: domain.TodoStatusCompleted, UpdatedAt: date, CompletedAt: &old},
+		{Title: "Completed long ago, edited", Status: domain.TodoStatusCompleted, UpdatedAt: old, CompletedAt: &date},
+	}
+	for i, td := range inputs {
+		td.CreatedAt = old.Add(time.Duration(i) * time.Minute)
+		_, err := repo.Create(ctx, td)
+		assert.Nil(t, err)
+	}
+
+	todos, err := repo.ListCompletedBefore(ctx, date.Add(-24*time.Hour))
+	assert.Nil(t, err)
+	titles := make([]string, len(todos))
+	for i, td := range todos {
+		titles[i] = td.Title
+	}
+	assert.Equal(t, []string{"Completed long ago", "Completed recently, edited"}, titles)
+	assert.Equal(t, 1, todos[0].Version)
+	assert.Nil(t, todos[0].ArchivedAt)
+}
+
+func TestStatusHistory(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	ctx := context.Background()
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	completedDate := date.Add(time.Hour)
+	todo, _ := domain.NewTodo("Test", "", date, nil)
+	created, _ := repo.Create(ctx, todo)
+
+	renamed := created
+	renamed.Title = "Renamed"
+	renamed.UpdatedAt = date.Add(time.Minute)
+	renamed, err := repo.Update(ctx, renamed)
+	assert.Nil(t, err)
+	completed, _ := renamed.Transition(domain.DefaultWorkflow(), domain.TodoStatusCompleted, completedDate)
+	completed, err = repo.Update(ctx, completed)
+	assert.Nil(t, err)
+	assert.Equal(t, completedDate, completed.CompletedAt.UTC())
+
+	history, err := repo.StatusHistory(ctx, created.ID)
+	assert.Nil(t, err)
+	assert.Len(t, history, 2)
+	assert.Equal(t, domain.TodoStatus(""), history[0].From)
+	assert.Equal(t, domain.TodoStatusPending, history[0].To)
+	assert.Equal(t, date, history[0].ChangedAt.UTC())
+	assert.Equal(t, domain.TodoStatusPending, history[1].From)
+	assert.Equal(t, domain.TodoStatusCompleted, history[1].To)
+	assert.Equal(t, completedDate, history[1].ChangedAt.UTC())
+
+	// A stale update saves nothing, so it records no change either
+	_, err = repo.Update(ctx, renamed)
+	assert.Equal(t, domain.ErrTodoVersionConflict, err)
+	history, _ = repo.StatusHistory(ctx, created.ID)
+	assert.Len(t, history, 2)
+
+	_, err = repo.DeleteByID(ctx, created.ID, nil)
+	assert.Nil(t, err)
+	history, _ = repo.StatusHistory(ctx, created.ID)
+	assert.Empty(t, history)
 }
 
 func TestUpdate(t *testing.T) {
@@ -155,3 +484,100 @@ func TestUpdate(t *testing.T) {
 	_, err = repo.Update(context.Background(), domain.Todo{ID: "999", Title: "Non-existing"})
 	assert.Equal(t, domain.ErrTodoNotFound, err)
 }
+
+func TestUpdateVersion(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	todo, _ := domain.NewTodo("Original", "", time.Now().UTC(), nil)
+	created, _ := repo.Create(context.Background(), todo)
+	assert.Equal(t, 1, created.Version)
+
+	first := created
+	first.Title = "First"
+	updated, err := repo.Update(context.Background(), first)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, updated.Version)
+
+	// A second writer still holding the first version must not overwrite the update
+	second := created
+	second.Title = "Second"
+	_, err = repo.Update(context.Background(), second)
+	assert.Equal(t, domain.ErrTodoVersionConflict, err)
+	retrieved, _ := repo.GetByID(context.Background(), created.ID)
+	assert.Equal(t, "First", retrieved.Title)
+	assert.Equal(t, 2, retrieved.Version)
+}
+
+func TestChecklistItems(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	ctx := context.Background()
+	date := time.Now().UTC()
+	todo, _ := domain.NewTodo("Paint the room", "", date, nil)
+	todo, _ = todo.AddItem("buy paint", date)
+	created, err := repo.Create(ctx, todo)
+	assert.NoError(t, err)
+	assert.Len(t, created.Items, 1)
+	assert.NotEmpty(t, created.Items[0].ID)
+
+	t.Run("should save added, toggled and reordered items", func(t *testing.T) {
+		updated, _ := created.AddItem("paint", date)
+		updated, _ = updated.AddItem("clean up", date)
+		updated, err := repo.Update(ctx, updated)
+		assert.NoError(t, err)
+		updated, _ = updated.ToggleItem(updated.Items[0].ID, date)
+		updated, _ = updated.ReorderItems([]string{updated.Items[2].ID, updated.Items[0].ID, updated.Items[1].ID}, date)
+		_, err = repo.Update(ctx, updated)
+		assert.NoError(t, err)
+
+		got, err := repo.GetByID(ctx, created.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, updated.Items, got.Items)
+		assert.Equal(t, []string{"clean up", "buy paint", "paint"},
+			[]string{got.Items[0].Title, got.Items[1].Title, got.Items[2].Title})
+		assert.True(t, got.Items[1].Done)
+	})
+
+	t.Run("should remove items", func(t *testing.T) {
+		got, _ := repo.GetByID(ctx, created.ID)
+		for _, item := range got.Items {
+			got, _ = got.RemoveItem(item.ID, date)
+		}
+		_, err := repo.Update(ctx, got)
+		assert.NoError(t, err)
+		got, _ = repo.GetByID(ctx, created.ID)
+		assert.Nil(t, got.Items)
+	})
+
+	t.Run("should delete the items with the todo", func(t *testing.T) {
+		withItem, _ := todo.AddItem("paint", date)
+		c, _ := repo.Create(ctx, withItem)
+		_, err := repo.DeleteByID(ctx, c.ID, nil)
+		assert.NoError(t, err)
+		var count int64
+		db.Model(&ChecklistItemModel{}).Where("todo_id = ?", c.ID).Count(&count)
+		assert.Zero(t, count)
+	})
+}
+
+func TestRecurrence(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewTodoRepository(db)
+	ctx := context.Background()
+	date := time.Now().UTC()
+	todo, _ := domain.NewTodo("Water the plants", "", date, nil)
+	todo, _ = todo.WithRecurrence("FREQ=WEEKLY;BYDAY=MO,TH;COUNT=4")
+	created, err := repo.Create(ctx, todo)
+	assert.NoError(t, err)
+
+	got, err := repo.GetByID(ctx, created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, todo.Recurrence, got.Recurrence)
+
+	got, _ = got.WithRecurrence("")
+	_, err = repo.Update(ctx, got)
+	assert.NoError(t, err)
+	got, err = repo.GetByID(ctx, created.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, got.Recurrence)
+}
@@ -0,0 +1,47 @@
+package gorm
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type (
+	txContextKey struct{}
+	transactor   struct {
+		db *gorm.DB
+	}
+)
+
+func NewTransactor(db *gorm.DB) *transactor {
+	return &transactor{db: db}
+}
+
+// Transaction runs fn inside a database transaction carried by the context it receives,
+// so the repositories called with that context share it. The todos read by the transaction
+// are locked until it ends. A transaction started inside another one becomes a savepoint
+// of the outer transaction.
+func (t *transactor) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return conn(ctx, t.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// conn returns the transaction carried by ctx, or db bound to ctx when there is none.
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return db.WithContext(ctx)
+}
+
+// forUpdate locks the rows read by the query until the end of the transaction carried by ctx,
+// with SELECT ... FOR UPDATE on MySQL. SQLite has no row locks: it runs a single write
+// transaction at a time, so it is left as it is, as are queries outside a transaction.
+func forUpdate(ctx context.Context, query *gorm.DB) *gorm.DB {
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); !ok || query.Dialector.Name() != "mysql" {
+		return query
+	}
+	return query.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate})
+}
@@ -0,0 +1,85 @@
+package gorm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestTransaction(t *testing.T) {
+	date := time.Now().UTC()
+	newTodo := func(title string) domain.Todo {
+		todo, _ := domain.NewTodo(title, "", date, nil)
+		return todo
+	}
+
+	t.Run("should commit the changes when the work succeeds", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewTodoRepository(db)
+		var created domain.Todo
+		err := NewTransactor(db).Transaction(context.Background(), func(ctx context.Context) error {
+			var err error
+			created, err = repo.Create(ctx, newTodo("Committed"))
+			return err
+		})
+		assert.Nil(t, err)
+		got, err := repo.GetByID(context.Background(), created.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, "Committed", got.Title)
+	})
+
+	t.Run("should roll back every change when the work fails", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewTodoRepository(db)
+		existing, _ := repo.Create(context.Background(), newTodo("Existing"))
+		var created domain.Todo
+		err := NewTransactor(db).Transaction(context.Background(), func(ctx context.Context) error {
+			created, _ = repo.Create(ctx, newTodo("Rolled back"))
+			if _, err := repo.DeleteByID(ctx, existing.ID, nil); err != nil {
+				return err
+			}
+			return assert.AnError
+		})
+		assert.Equal(t, assert.AnError, err)
+		_, err = repo.GetByID(context.Background(), created.ID)
+		assert.Equal(t, domain.ErrTodoNotFound, err)
+		got, err := repo.GetByID(context.Background(), existing.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, "Existing", got.Title)
+	})
+}
+
+func TestForUpdate(t *testing.T) {
+	mysqlDB, err := gorm.Open(mysql.New(mysql.Config{
+		DSN:                       "user:password@tcp(localhost:3306)/todo",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.NoError(t, err)
+	sqliteDB := setupTestDB(t)
+	toSQL := func(ctx context.Context, db *gorm.DB) string {
+		return db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			var model TodoModel
+			return forUpdate(ctx, conn(ctx, tx)).First(&model, "id = ?", "123")
+		})
+	}
+	inTransaction := func(db *gorm.DB) context.Context {
+		return context.WithValue(context.Background(), txContextKey{}, db)
+	}
+
+	t.Run("should lock the rows read in a MySQL transaction", func(t *testing.T) {
+		assert.Contains(t, toSQL(inTransaction(mysqlDB), mysqlDB), "FOR UPDATE")
+	})
+
+	t.Run("should not lock the rows read outside a transaction", func(t *testing.T) {
+		assert.NotContains(t, toSQL(context.Background(), mysqlDB), "FOR UPDATE")
+	})
+
+	t.Run("should not lock the rows read with SQLite", func(t *testing.T) {
+		assert.NotContains(t, toSQL(inTransaction(sqliteDB), sqliteDB), "FOR UPDATE")
+	})
+}
@@ -0,0 +1,126 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) *webhookRepository {
+	return &webhookRepository{db: db}
+}
+
+func (r *webhookRepository) Create(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	w.ID = uuid.New().String()
+	model := webhookFromDomain(w)
+	if err := conn(ctx, r.db).Create(&model).Error; err != nil {
+		return domain.Webhook{}, err
+	}
+	return webhookToDomain(model), nil
+}
+
+// List returns every webhook, the oldest first.
+func (r *webhookRepository) List(ctx context.Context) ([]domain.Webhook, error) {
+	var models []WebhookModel
+	if err := conn(ctx, r.db).Order("created_at").Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	webhooks := make([]domain.Webhook, len(models))
+	for i, m := range models {
+		webhooks[i] = webhookToDomain(m)
+	}
+	return webhooks, nil
+}
+
+func (r *webhookRepository) GetByID(ctx context.Context, id string) (domain.Webhook, error) {
+	var model WebhookModel
+	if err := conn(ctx, r.db).First(&model, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return domain.Webhook{}, domain.ErrWebhookNotFound
+		}
+		return domain.Webhook{}, err
+	}
+	return webhookToDomain(model), nil
+}
+
+func (r *webhookRepository) Update(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	model := webhookFromDomain(w)
+	result := conn(ctx, r.db).Model(&model).Select("*").Where("id = ?", w.ID).Updates(&model)
+	if result.Error != nil {
+		return domain.Webhook{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.Webhook{}, domain.ErrWebhookNotFound
+	}
+	return webhookToDomain(model), nil
+}
+
+// DeleteByID removes the webhook together with its deliveries.
+func (r *webhookRepository) DeleteByID(ctx context.Context, id string) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&WebhookModel{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrWebhookNotFound
+		}
+		return tx.Delete(&WebhookDeliveryModel{}, "webhook_id = ?", id).Error
+	})
+}
+
+func (r *webhookRepository) CreateDelivery(
+	ctx context.Context, d domain.WebhookDelivery,
+) (domain.WebhookDelivery, error) {
+	d.ID = uuid.New().String()
+	model := webhookDeliveryFromDomain(d)
+	if err := conn(ctx, r.db).Create(&model).Error; err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+	return webhookDeliveryToDomain(model), nil
+}
+
+// UpdateDelivery saves the outcome of an attempt of the delivery. The delivery of a webhook
+// deleted in the meantime is gone, and is left as it is.
+func (r *webhookRepository) UpdateDelivery(
+	ctx context.Context, d domain.WebhookDelivery,
+) (domain.WebhookDelivery, error) {
+	model := webhookDeliveryFromDomain(d)
+	if err := conn(ctx, r.db).Model(&model).Select("*").Where("id = ?", d.ID).Updates(&model).Error; err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+	return webhookDeliveryToDomain(model), nil
+}
+
+// ListDeliveries returns the deliveries of the webhook, the newest first.
+func (r *webhookRepository) ListDeliveries(ctx context.Context, webhookID string) ([]domain.WebhookDelivery, error) {
+	return r.findDeliveries(conn(ctx, r.db).Where("webhook_id = ?", webhookID).
+		Order("created_at DESC").Order("id DESC"))
+}
+
+// ListDueDeliveries returns the pending deliveries whose next attempt is at or before date,
+// the longest due first.
+func (r *webhookRepository) ListDueDeliveries(ctx context.Context, date time.Time) ([]domain.WebhookDelivery, error) {
+	return r.findDeliveries(conn(ctx, r.db).
+		Where("status = ? AND next_attempt_at <= ?", string(domain.WebhookDeliveryPending), date).
+		Order("next_attempt_at").Order("id"))
+}
+
+func (r *webhookRepository) findDeliveries(query *gorm.DB) ([]domain.WebhookDelivery, error) {
+	var models []WebhookDeliveryModel
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+	deliveries := make([]domain.WebhookDelivery, len(models))
+	for i, m := range models {
+		deliveries[i] = webhookDeliveryToDomain(m)
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,108 @@
+package gorm
+
+import (
+	"strings"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// WebhookModel is a webhook subscription. Events holds its event types separated by commas.
+type WebhookModel struct {
+	ID        string `gorm:"primaryKey"`
+	URL       string `gorm:"not null;size:2048"`
+	Secret    string `gorm:"not null"`
+	Events    string `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time `gorm:"autoUpdateTime:false"`
+}
+
+func (WebhookModel) TableName() string {
+	return "webhooks"
+}
+
+// WebhookDeliveryModel is a delivery of a domain event to a webhook, deleted with its webhook.
+type WebhookDeliveryModel struct {
+	ID             string     `gorm:"primaryKey"`
+	WebhookID      string     `gorm:"not null;index"`
+	EventID        string     `gorm:"not null"`
+	EventType      string     `gorm:"not null;size:20"`
+	Payload        string     `gorm:"not null;type:text"`
+	Status         string     `gorm:"not null;size:20;index:idx_webhook_deliveries_due,priority:1"`
+	Attempts       int        `gorm:"not null"`
+	ResponseStatus int        `gorm:"not null"`
+	LastError      string     `gorm:"type:text"`
+	NextAttemptAt  *time.Time `gorm:"index:idx_webhook_deliveries_due,priority:2"`
+	CreatedAt      time.Time  `gorm:"not null;autoCreateTime:false"`
+	UpdatedAt      time.Time  `gorm:"not null;autoUpdateTime:false"`
+}
+
+func (WebhookDeliveryModel) TableName() string {
+	return "webhook_deliveries"
+}
+
+func webhookToDomain(m WebhookModel) domain.Webhook {
+	var events []domain.EventType
+	for event := range strings.SplitSeq(m.Events, ",") {
+		if event != "" {
+			events = append(events, domain.EventType(event))
+		}
+	}
+	return domain.Webhook{
+		ID:        m.ID,
+		URL:       m.URL,
+		Secret:    m.Secret,
+		Events:    events,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func webhookFromDomain(w domain.Webhook) WebhookModel {
+	events := make([]string, len(w.Events))
+	for i, event := range w.Events {
+		events[i] = string(event)
+	}
+	return WebhookModel{
+		ID:        w.ID,
+		URL:       w.URL,
+		Secret:    w.Secret,
+		Events:    strings.Join(events, ","),
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func webhookDeliveryToDomain(m WebhookDeliveryModel) domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		ID:             m.ID,
+		WebhookID:      m.WebhookID,
+		EventID:        m.EventID,
+		EventType:      domain.EventType(m.EventType),
+		Payload:        []byte(m.Payload),
+		Status:         domain.WebhookDeliveryStatus(m.Status),
+		Attempts:       m.Attempts,
+		ResponseStatus: m.ResponseStatus,
+		LastError:      m.LastError,
+		NextAttemptAt:  m.NextAttemptAt,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func webhookDeliveryFromDomain(d domain.WebhookDelivery) WebhookDeliveryModel {
+	return WebhookDeliveryModel{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventID:        d.EventID,
+		EventType:      string(d.EventType),
+		Payload:        string(d.Payload),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
@@ -0,0 +1,91 @@
+package gorm
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestWebhookRepository(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+
+	t.Run("should create, list, get and update webhooks", func(t *testing.T) {
+		repo := NewWebhookRepository(setupTestDB(t))
+		first, _ := domain.NewWebhook("https://example.com/first", "s3cret",
+			[]domain.EventType{domain.EventTodoCreated, domain.EventTodoDeleted}, date)
+		second, _ := domain.NewWebhook("https://example.com/second", "0ther",
+			[]domain.EventType{domain.EventTodoCompleted}, date.Add(time.Minute))
+		createdFirst, err := repo.Create(ctx, first)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, createdFirst.ID)
+		createdSecond, err := repo.Create(ctx, second)
+		assert.NoError(t, err)
+
+		webhooks, err := repo.List(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Webhook{createdFirst, createdSecond}, webhooks)
+
+		updated, _ := createdFirst.Update("https://example.com/moved", "",
+			[]domain.EventType{domain.EventTodoReopened}, date.Add(time.Hour))
+		_, err = repo.Update(ctx, updated)
+		assert.NoError(t, err)
+		retrieved, err := repo.GetByID(ctx, createdFirst.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, updated, retrieved)
+	})
+
+	t.Run("should return not found for unknown webhooks", func(t *testing.T) {
+		repo := NewWebhookRepository(setupTestDB(t))
+		_, err := repo.GetByID(ctx, "unknown")
+		assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+		_, err = repo.Update(ctx, domain.Webhook{ID: "unknown", CreatedAt: date, UpdatedAt: date})
+		assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+		assert.ErrorIs(t, repo.DeleteByID(ctx, "unknown"), domain.ErrWebhookNotFound)
+	})
+
+	t.Run("should record the deliveries and list the due ones", func(t *testing.T) {
+		repo := NewWebhookRepository(setupTestDB(t))
+		webhook, _ := domain.NewWebhook("https://example.com/hooks", "s3cret",
+			[]domain.EventType{domain.EventTodoCreated}, date)
+		webhook, _ = repo.Create(ctx, webhook)
+		event := domain.Event{ID: "e1", Type: domain.EventTodoCreated, TodoID: "t1"}
+		due, err := repo.CreateDelivery(ctx, domain.NewWebhookDelivery(webhook.ID, event, json.RawMessage(`{"id":"e1"}`), date))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, due.ID)
+		retryAt := date.Add(time.Hour)
+		later, _ := repo.CreateDelivery(ctx, domain.NewWebhookDelivery(webhook.ID, event, json.RawMessage(`{}`), date))
+		later, err = repo.UpdateDelivery(ctx, later.Fail(500, "unexpected status 500", date, &retryAt))
+		assert.NoError(t, err)
+		done, _ := repo.CreateDelivery(ctx, domain.NewWebhookDelivery(webhook.ID, event, json.RawMessage(`{}`), date))
+		done, _ = repo.UpdateDelivery(ctx, done.Succeed(200, date.Add(time.Second)))
+
+		dueNow, err := repo.ListDueDeliveries(ctx, date.Add(time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.WebhookDelivery{due}, dueNow)
+		dueLater, err := repo.ListDueDeliveries(ctx, retryAt)
+		assert.NoError(t, err)
+		assert.Len(t, dueLater, 2)
+
+		deliveries, err := repo.ListDeliveries(ctx, webhook.ID)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []domain.WebhookDelivery{due, later, done}, deliveries)
+	})
+
+	t.Run("should delete the deliveries with their webhook", func(t *testing.T) {
+		repo := NewWebhookRepository(setupTestDB(t))
+		webhook, _ := domain.NewWebhook("https://example.com/hooks", "s3cret",
+			[]domain.EventType{domain.EventTodoCreated}, date)
+		webhook, _ = repo.Create(ctx, webhook)
+		_, _ = repo.CreateDelivery(ctx, domain.NewWebhookDelivery(webhook.ID, domain.Event{}, json.RawMessage(`{}`), date))
+
+		assert.NoError(t, repo.DeleteByID(ctx, webhook.ID))
+		due, err := repo.ListDueDeliveries(ctx, date)
+		assert.NoError(t, err)
+		assert.Empty(t, due)
+	})
+}
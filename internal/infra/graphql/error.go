@@ -0,0 +1,41 @@
+package graphql
+
+import (
+	"slices"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+)
+
+var errorTypes = []usecase.ErrorType{
+	usecase.ErrorTypeInternalError,
+	usecase.ErrorTypeBadRequest,
+	usecase.ErrorTypeNotFound,
+	usecase.ErrorTypeConflict,
+	usecase.ErrorTypePreconditionFailed,
+	usecase.ErrorTypeFailedDependency,
+}
+
+// Error is the error of a resolver, its usecase error type being the code of its extensions.
+type Error struct {
+	Message string
+	Type    usecase.ErrorType
+}
+
+func (e Error) Error() string {
+	return e.Message
+}
+
+// Extensions are the extensions of the error in the GraphQL response.
+func (e Error) Extensions() map[string]any {
+	return map[string]any{"code": string(e.Type)}
+}
+
+// resolverError converts the error of a usecase to the error of a resolver. Errors that are not
+// usecase errors of a known type are hidden behind an internal error.
+func resolverError(err error) error {
+	errUC, ok := err.(usecase.Error)
+	if !ok || !slices.Contains(errorTypes, errUC.Type) {
+		return Error{Message: "internal server error", Type: usecase.ErrorTypeInternalError}
+	}
+	return Error{Message: errUC.Message, Type: errUC.Type}
+}
@@ -0,0 +1,80 @@
+package graphql
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+// Schema is the GraphQL schema of the todos
+//
+//go:embed schema.graphql
+var Schema string
+
+type (
+	// graphqlRequest is a GraphQL operation posted to the endpoint
+	graphqlRequest struct {
+		Query         string         `json:"query"`
+		OperationName string         `json:"operationName,omitempty"`
+		Variables     map[string]any `json:"variables,omitempty"`
+	}
+	// TodoHandler serves the GraphQL schema of the todos through the todo usecases.
+	TodoHandler struct {
+		schema *graphql.Schema
+	}
+)
+
+func NewTodoHandler(
+	create todo.Create,
+	list todo.List,
+	getByID todo.GetByID,
+	update todo.Update,
+	complete todo.Complete,
+	markAsPending todo.MarkAsPending,
+	deleteByID todo.DeleteByID,
+) *TodoHandler {
+	return &TodoHandler{
+		schema: graphql.MustParseSchema(Schema, &resolver{
+			create:        create,
+			list:          list,
+			getByID:       getByID,
+			update:        update,
+			complete:      complete,
+			markAsPending: markAsPending,
+			deleteByID:    deleteByID,
+		}, graphql.UseStringDescriptions()),
+	}
+}
+
+// @Summary Run a GraphQL operation
+// @Description Run a query or a mutation of the GraphQL schema of the todos, selecting the fields
+// @Description of the response. The response has the data of the operation and its errors, each
+// @Description with the usecase error type as the code of its extensions.
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param X-Actor header string false "Who makes the changes of the mutations"
+// @Param operation body graphqlRequest true "GraphQL operation"
+// @Success 200 {object} map[string]interface{} "GraphQL response with its data and errors"
+// @Failure 400 {object} handler.ErrorResponse
+// @Router /graphql [post]
+func (h *TodoHandler) Handle(c echo.Context) error {
+	var request graphqlRequest
+	if err := c.Bind(&request); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	response := h.schema.Exec(c.Request().Context(), request.Query, request.OperationName, request.Variables)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *TodoHandler) Path() string {
+	return "/graphql"
+}
+
+func (h *TodoHandler) Method() string {
+	return http.MethodPost
+}
@@ -0,0 +1,316 @@
+package graphql_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	graphqlHandler "github.com/wellingtonlope/todo-api/internal/infra/graphql"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+type todoHandlerMocks struct {
+	create        *todoCreateMock
+	list          *todoListMock
+	getByID       *todoGetByIDMock
+	update        *todoUpdateMock
+	complete      *todoCompleteMock
+	markAsPending *todoMarkAsPendingMock
+	deleteByID    *todoDeleteByIDMock
+}
+
+func newTodoHandlerMocks() todoHandlerMocks {
+	return todoHandlerMocks{
+		create:        new(todoCreateMock),
+		list:          new(todoListMock),
+		getByID:       new(todoGetByIDMock),
+		update:        new(todoUpdateMock),
+		complete:      new(todoCompleteMock),
+		markAsPending: new(todoMarkAsPendingMock),
+		deleteByID:    new(todoDeleteByIDMock),
+	}
+}
+
+func (m todoHandlerMocks) assertExpectations(t *testing.T) {
+	m.create.AssertExpectations(t)
+	m.list.AssertExpectations(t)
+	m.getByID.AssertExpectations(t)
+	m.update.AssertExpectations(t)
+	m.complete.AssertExpectations(t)
+	m.markAsPending.AssertExpectations(t)
+	m.deleteByID.AssertExpectations(t)
+}
+
+func (m todoHandlerMocks) handler() *graphqlHandler.TodoHandler {
+	return graphqlHandler.NewTodoHandler(m.create, m.list, m.getByID, m.update, m.complete,
+		m.markAsPending, m.deleteByID)
+}
+
+func TestTodoHandler_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	projectID := "p1"
+	version := 2
+	priority := domain.TodoPriorityHigh
+	todoStatus := domain.TodoStatus("pending")
+	exampleOutput := todo.TodoOutput{
+		ID:        "1",
+		Title:     "Write report",
+		Status:    string(domain.TodoStatusPending),
+		Priority:  string(domain.TodoPriorityHigh),
+		Tags:      []string{"work"},
+		Items:     []todo.ChecklistItemOutput{{ID: "i1", Title: "Outline", Done: true}},
+		ProjectID: &projectID,
+		DueDate:   &exampleDate,
+		Version:   3,
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDate,
+	}
+	testCases := []struct {
+		name     string
+		mocks    func() todoHandlerMocks
+		body     string
+		status   int
+		response string
+	}{
+		{
+			name: "should get a todo with the selected fields",
+			mocks: func() todoHandlerMocks {
+				m := newTodoHandlerMocks()
+				m.getByID.On("Handle", mock.Anything, "1").Return(exampleOutput, nil).Once()
+				return m
+			},
+			body:   `{"query":"{ todo(id: \"1\") { id title tags items { title done } projectId dueDate completedAt version } }"}`,
+			status: http.StatusOK,
+			response: `{"data":{"todo":{"id":"1","title":"Write report","tags":["work"],` +
+				`"items":[{"title":"Outline","done":true}],"projectId":"p1","dueDate":"2024-01-01T00:00:00Z",` +
+				`"completedAt":null,"version":3}}}`,
+		},
+		{
+			name: "should list the todos matching the filter",
+			mocks: func() todoHandlerMocks {
+				m := newTodoHandlerMocks()
+				m.list.On("Handle", mock.Anything, todo.ListInput{
+					Filter: todo.ListFilter{
+						Status:    &todoStatus,
+						Priority:  &priority,
+						Tags:      []string{"work"},
+						ProjectID: &projectID,
+						DueBefore: &exampleDate,
+						Overdue:   true,
+					},
+					Sort:  todo.ListSort{Field: todo.ListSortField("due_date"), Direction: todo.SortDirection("desc")},
+					Limit: 10,
+				}).Return(todo.ListOutput{Todos: []todo.TodoOutput{exampleOutput}, NextCursor: "next"}, nil).Once()
+				return m
+			},
+			body: `{"query":"query List($filter: TodoFilter) { todos(filter: $filter, sort: \"due_date\", order: \"desc\", limit: 10) ` +
+				`{ todos { id } nextCursor } }","variables":{"filter":{"status":"pending","priority":"high","tags":["work"],` +
+				`"projectId":"p1","dueBefore":"2024-01-01T00:00:00Z","overdue":true}}}`,
+			status:   http.StatusOK,
+			response: `{"data":{"todos":{"todos":[{"id":"1"}],"nextCursor":"next"}}}`,
+		},
+		{
+			name: "should create a todo",
+			mocks: func() todoHandlerMocks {
+				m := newTodoHandlerMocks()
+				m.create.On("Handle", mock.Anything, todo.CreateInput{
+					Title: "Write report", Priority: domain.TodoPriorityHigh, Tags: []string{"work"}, DueDate: &exampleDate,
+				}).Return(exampleOutput, nil).Once()
+				return m
+			},
+			body: `{"query":"mutation { createTodo(input: {title: \"Write report\", priority: \"high\", tags: [\"work\"], ` +
+				`dueDate: \"2024-01-01T00:00:00Z\"}) { id status } }"}`,
+			status:   http.StatusOK,
+			response: `{"data":{"createTodo":{"id":"1","status":"pending"}}}`,
+		},
+		{
+			name: "should update a todo at its version",
+			mocks: func() todoHandlerMocks {
+				m := newTodoHandlerMocks()
+				m.update.On("Handle", mock.Anything, todo.UpdateInput{
+					ID: "1", Title: "Write report", Description: "Quarterly", Versions: []int{version},
+				}).Return(exampleOutput, nil).Once()
+				return m
+			},
+			body: `{"query":"mutation { updateTodo(id: \"1\", version: 2, input: {title: \"Write report\", ` +
+				`description: \"Quarterly\"}) { version } }"}`,
+			status:   http.StatusOK,
+			response: `{"data":{"updateTodo":{"version":3}}}`,
+		},
+		{
+			name: "should complete a todo",
+			mocks: func() todoHandlerMocks {
+				m := newTodoHandlerMocks()
+				m.complete.On("Handle", mock.Anything, todo.CompleteInput{ID: "1", OpenItems: todo.OpenItemsCascade}).
+					Return(exampleOutput, nil).Once()
+				return m
+			},
+			body:     `{"query":"mutation { completeTodo(id: \"1\", openItems: \"cascade\") { id } }"}`,
+			status:   http.StatusOK,
+			response: `{"data":{"completeTodo":{"id":"1"}}}`,
+		},
+		{
+			name: "should mark a todo as pending",
+			mocks: func() todoHandlerMocks {
+				m := newTodoHandlerMocks()
+				m.markAsPending.On("Handle", mock.Anything, todo.MarkAsPendingInput{ID: "1", Versions: []int{version}}).
+					Return(exampleOutput, nil).Once()
+				return m
+			},
+			body:     `{"query":"mutation { markTodoAsPending(id: \"1\", version: 2) { id } }"}`,
+			status:   http.StatusOK,
+			response: `{"data":{"markTodoAsPending":{"id":"1"}}}`,
+		},
+		{
+			name: "should delete a todo permanently",
+			mocks: func() todoHandlerMocks {
+				m := newTodoHandlerMocks()
+				m.deleteByID.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "1", Permanent: true}).
+					Return(nil).Once()
+				return m
+			},
+			body:     `{"query":"mutation { deleteTodo(id: \"1\", permanent: true) }"}`,
+			status:   http.StatusOK,
+			response: `{"data":{"deleteTodo":"1"}}`,
+		},
+		{
+			name: "should map the usecase error type to the error extensions",
+			mocks: func() todoHandlerMocks {
+				m := newTodoHandlerMocks()
+				m.markAsPending.On("Handle", mock.Anything, todo.MarkAsPendingInput{ID: "1", Versions: []int{version}}).
+					Return(todo.TodoOutput{}, usecase.NewError("the todo has changed", errors.New("version conflict"),
+						usecase.ErrorTypePreconditionFailed)).Once()
+				return m
+			},
+			body:   `{"query":"mutation { markTodoAsPending(id: \"1\", version: 2) { id } }"}`,
+			status: http.StatusOK,
+			response: `{"errors":[{"message":"the todo has changed","path":["markTodoAsPending"],` +
+				`"extensions":{"code":"precondition_failed"}}],"data":null}`,
+		},
+		{
+			name: "should hide an unexpected error",
+			mocks: func() todoHandlerMocks {
+				m := newTodoHandlerMocks()
+				m.getByID.On("Handle", mock.Anything, "1").Return(todo.TodoOutput{}, assert.AnError).Once()
+				return m
+			},
+			body:   `{"query":"{ todo(id: \"1\") { id } }"}`,
+			status: http.StatusOK,
+			response: `{"errors":[{"message":"internal server error","path":["todo"],` +
+				`"extensions":{"code":"internal_error"}}],"data":null}`,
+		},
+		{
+			name:   "should answer the errors of an invalid query",
+			mocks:  newTodoHandlerMocks,
+			body:   `{"query":"{ todo(id: \"1\") { owner } }"}`,
+			status: http.StatusOK,
+			response: `{"errors":[{"message":"Cannot query field \"owner\" on type \"Todo\".",` +
+				`"locations":[{"line":1,"column":19}]}]}`,
+		},
+		{
+			name:     "should refuse an invalid JSON body",
+			mocks:    newTodoHandlerMocks,
+			body:     `{"query":`,
+			status:   http.StatusBadRequest,
+			response: `{"message":"invalid JSON input"}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mocks := tc.mocks()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			h := mocks.handler()
+			e.Add(h.Method(), h.Path(), h.Handle, handler.Error)
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.status, rec.Code)
+			assert.JSONEq(t, tc.response, rec.Body.String())
+			mocks.assertExpectations(t)
+		})
+	}
+}
+
+func TestTodoHandler_Path(t *testing.T) {
+	assert.Equal(t, "/graphql", newTodoHandlerMocks().handler().Path())
+}
+
+func TestTodoHandler_Method(t *testing.T) {
+	assert.Equal(t, http.MethodPost, newTodoHandlerMocks().handler().Method())
+}
+
+type todoCreateMock struct {
+	mock.Mock
+}
+
+func (m *todoCreateMock) Handle(ctx context.Context, input todo.CreateInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoListMock struct {
+	mock.Mock
+}
+
+func (m *todoListMock) Handle(ctx context.Context, input todo.ListInput) (todo.ListOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.ListOutput), args.Error(1)
+}
+
+type todoGetByIDMock struct {
+	mock.Mock
+}
+
+func (m *todoGetByIDMock) Handle(ctx context.Context, id string) (todo.TodoOutput, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoUpdateMock struct {
+	mock.Mock
+}
+
+func (m *todoUpdateMock) Handle(ctx context.Context, input todo.UpdateInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoCompleteMock struct {
+	mock.Mock
+}
+
+func (m *todoCompleteMock) Handle(ctx context.Context, input todo.CompleteInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoMarkAsPendingMock struct {
+	mock.Mock
+}
+
+func (m *todoMarkAsPendingMock) Handle(ctx context.Context, input todo.MarkAsPendingInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoDeleteByIDMock struct {
+	mock.Mock
+}
+
+func (m *todoDeleteByIDMock) Handle(ctx context.Context, input todo.DeleteByIDInput) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
@@ -0,0 +1,209 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	todoFilterInput struct {
+		Status          *string
+		Priority        *string
+		Tags            *[]string
+		TagMode         *string
+		ProjectID       *graphql.ID
+		DueBefore       *graphql.Time
+		DueAfter        *graphql.Time
+		Overdue         *bool
+		NoDueDate       *bool
+		CreatedAfter    *graphql.Time
+		UpdatedSince    *graphql.Time
+		IncludeArchived *bool
+	}
+	todoInput struct {
+		Title       string
+		Description *string
+		Priority    *string
+		Tags        *[]string
+		Recurrence  *string
+		DueDate     *graphql.Time
+	}
+	// resolver resolves the queries and the mutations of the schema through the todo usecases
+	resolver struct {
+		create        todo.Create
+		list          todo.List
+		getByID       todo.GetByID
+		update        todo.Update
+		complete      todo.Complete
+		markAsPending todo.MarkAsPending
+		deleteByID    todo.DeleteByID
+	}
+)
+
+func (r *resolver) Todos(ctx context.Context, args struct {
+	Filter *todoFilterInput
+	Sort   *string
+	Order  *string
+	Limit  *int32
+	Cursor *string
+}) (*todoPageResolver, error) {
+	var limit int
+	if args.Limit != nil {
+		limit = int(*args.Limit)
+	}
+	output, err := r.list.Handle(ctx, todo.ListInput{
+		Filter: listFilterFromInput(args.Filter),
+		Sort: todo.ListSort{
+			Field:     todo.ListSortField(valueOf(args.Sort)),
+			Direction: todo.SortDirection(valueOf(args.Order)),
+		},
+		Limit:  limit,
+		Cursor: valueOf(args.Cursor),
+	})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &todoPageResolver{output: output}, nil
+}
+
+func (r *resolver) Todo(ctx context.Context, args struct{ ID graphql.ID }) (*todoResolver, error) {
+	output, err := r.getByID.Handle(ctx, string(args.ID))
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &todoResolver{output: output}, nil
+}
+
+func (r *resolver) CreateTodo(ctx context.Context, args struct{ Input todoInput }) (*todoResolver, error) {
+	output, err := r.create.Handle(ctx, todo.CreateInput{
+		Title:       args.Input.Title,
+		Description: valueOf(args.Input.Description),
+		Priority:    domain.TodoPriority(valueOf(args.Input.Priority)),
+		Tags:        valueOf(args.Input.Tags),
+		Recurrence:  valueOf(args.Input.Recurrence),
+		DueDate:     timeFromInput(args.Input.DueDate),
+	})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &todoResolver{output: output}, nil
+}
+
+func (r *resolver) UpdateTodo(ctx context.Context, args struct {
+	ID      graphql.ID
+	Input   todoInput
+	Version *int32
+}) (*todoResolver, error) {
+	output, err := r.update.Handle(ctx, todo.UpdateInput{
+		ID:          string(args.ID),
+		Title:       args.Input.Title,
+		Description: valueOf(args.Input.Description),
+		Priority:    domain.TodoPriority(valueOf(args.Input.Priority)),
+		Tags:        valueOf(args.Input.Tags),
+		Recurrence:  valueOf(args.Input.Recurrence),
+		DueDate:     timeFromInput(args.Input.DueDate),
+		Versions:    versionsFromInput(args.Version),
+	})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &todoResolver{output: output}, nil
+}
+
+func (r *resolver) CompleteTodo(ctx context.Context, args struct {
+	ID        graphql.ID
+	OpenItems *string
+	Version   *int32
+}) (*todoResolver, error) {
+	output, err := r.complete.Handle(ctx, todo.CompleteInput{
+		ID:        string(args.ID),
+		OpenItems: todo.OpenItemsPolicy(valueOf(args.OpenItems)),
+		Versions:  versionsFromInput(args.Version),
+	})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &todoResolver{output: output}, nil
+}
+
+func (r *resolver) MarkTodoAsPending(ctx context.Context, args struct {
+	ID      graphql.ID
+	Version *int32
+}) (*todoResolver, error) {
+	output, err := r.markAsPending.Handle(ctx, todo.MarkAsPendingInput{
+		ID:       string(args.ID),
+		Versions: versionsFromInput(args.Version),
+	})
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &todoResolver{output: output}, nil
+}
+
+func (r *resolver) DeleteTodo(ctx context.Context, args struct {
+	ID        graphql.ID
+	Version   *int32
+	Permanent *bool
+}) (graphql.ID, error) {
+	err := r.deleteByID.Handle(ctx, todo.DeleteByIDInput{
+		ID:        string(args.ID),
+		Versions:  versionsFromInput(args.Version),
+		Permanent: valueOf(args.Permanent),
+	})
+	if err != nil {
+		return "", resolverError(err)
+	}
+	return args.ID, nil
+}
+
+// listFilterFromInput converts the filter argument of the todos query to the usecase filter
+func listFilterFromInput(input *todoFilterInput) todo.ListFilter {
+	if input == nil {
+		return todo.ListFilter{}
+	}
+	filter := todo.ListFilter{
+		Tags:            valueOf(input.Tags),
+		TagMode:         todo.TagMatchMode(valueOf(input.TagMode)),
+		DueBefore:       timeFromInput(input.DueBefore),
+		DueAfter:        timeFromInput(input.DueAfter),
+		Overdue:         valueOf(input.Overdue),
+		NoDueDate:       valueOf(input.NoDueDate),
+		CreatedAfter:    timeFromInput(input.CreatedAfter),
+		UpdatedSince:    timeFromInput(input.UpdatedSince),
+		IncludeArchived: valueOf(input.IncludeArchived),
+	}
+	if input.Status != nil {
+		status := domain.TodoStatus(*input.Status)
+		filter.Status = &status
+	}
+	if input.Priority != nil {
+		priority := domain.TodoPriority(*input.Priority)
+		filter.Priority = &priority
+	}
+	if input.ProjectID != nil {
+		projectID := string(*input.ProjectID)
+		filter.ProjectID = &projectID
+	}
+	return filter
+}
+
+// versionsFromInput converts an optional version argument to the versions the todo may still have,
+// nil when it is not given
+func versionsFromInput(version *int32) []int {
+	if version == nil {
+		return nil
+	}
+	return []int{int(*version)}
+}
+
+// valueOf returns the value of an optional argument, its zero value when it is not given
+func valueOf[T any](value *T) T {
+	var zero T
+	if value == nil {
+		return zero
+	}
+	return *value
+}
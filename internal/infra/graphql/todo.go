@@ -0,0 +1,140 @@
+package graphql
+
+import (
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	// todoResolver resolves the fields of a Todo
+	todoResolver struct {
+		output todo.TodoOutput
+	}
+	// checklistItemResolver resolves the fields of a ChecklistItem
+	checklistItemResolver struct {
+		output todo.ChecklistItemOutput
+	}
+	// todoPageResolver resolves the fields of a TodoPage
+	todoPageResolver struct {
+		output todo.ListOutput
+	}
+)
+
+func (r *todoResolver) ID() graphql.ID {
+	return graphql.ID(r.output.ID)
+}
+
+func (r *todoResolver) Title() string {
+	return r.output.Title
+}
+
+func (r *todoResolver) Description() string {
+	return r.output.Description
+}
+
+func (r *todoResolver) Status() string {
+	return r.output.Status
+}
+
+func (r *todoResolver) Priority() string {
+	return r.output.Priority
+}
+
+func (r *todoResolver) Tags() []string {
+	if r.output.Tags == nil {
+		return []string{}
+	}
+	return r.output.Tags
+}
+
+func (r *todoResolver) Items() []*checklistItemResolver {
+	items := make([]*checklistItemResolver, 0, len(r.output.Items))
+	for _, item := range r.output.Items {
+		items = append(items, &checklistItemResolver{output: item})
+	}
+	return items
+}
+
+func (r *todoResolver) Recurrence() *string {
+	if r.output.Recurrence == "" {
+		return nil
+	}
+	return &r.output.Recurrence
+}
+
+func (r *todoResolver) ProjectID() *graphql.ID {
+	if r.output.ProjectID == nil {
+		return nil
+	}
+	id := graphql.ID(*r.output.ProjectID)
+	return &id
+}
+
+func (r *todoResolver) DueDate() *graphql.Time {
+	return timeFromOutput(r.output.DueDate)
+}
+
+func (r *todoResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.output.CreatedAt}
+}
+
+func (r *todoResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.output.UpdatedAt}
+}
+
+func (r *todoResolver) CompletedAt() *graphql.Time {
+	return timeFromOutput(r.output.CompletedAt)
+}
+
+func (r *todoResolver) ArchivedAt() *graphql.Time {
+	return timeFromOutput(r.output.ArchivedAt)
+}
+
+func (r *todoResolver) Version() int32 {
+	return int32(r.output.Version)
+}
+
+func (r *checklistItemResolver) ID() graphql.ID {
+	return graphql.ID(r.output.ID)
+}
+
+func (r *checklistItemResolver) Title() string {
+	return r.output.Title
+}
+
+func (r *checklistItemResolver) Done() bool {
+	return r.output.Done
+}
+
+func (r *todoPageResolver) Todos() []*todoResolver {
+	todos := make([]*todoResolver, 0, len(r.output.Todos))
+	for _, t := range r.output.Todos {
+		todos = append(todos, &todoResolver{output: t})
+	}
+	return todos
+}
+
+func (r *todoPageResolver) NextCursor() *string {
+	if r.output.NextCursor == "" {
+		return nil
+	}
+	return &r.output.NextCursor
+}
+
+// timeFromOutput converts an optional time, nil when it is not set
+func timeFromOutput(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
+
+// timeFromInput converts an optional time argument, nil when it is not given
+func timeFromInput(t *graphql.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
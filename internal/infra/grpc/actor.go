@@ -0,0 +1,24 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ActorMetadata is the metadata key naming who makes the call, like the X-Actor header of the HTTP API.
+const ActorMetadata = "x-actor"
+
+// UnaryActor carries the actor named by the x-actor metadata in the context of the call.
+// The changes of calls without it are recorded as made by usecase.AnonymousActor.
+func UnaryActor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if values := metadata.ValueFromIncomingContext(ctx, ActorMetadata); len(values) > 0 {
+		if actor := strings.TrimSpace(values[0]); actor != "" {
+			ctx = usecase.WithActor(ctx, actor)
+		}
+	}
+	return handler(ctx, req)
+}
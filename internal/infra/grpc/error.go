@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var mapErrorTypeCode = map[usecase.ErrorType]codes.Code{
+	usecase.ErrorTypeInternalError:      codes.Internal,
+	usecase.ErrorTypeBadRequest:         codes.InvalidArgument,
+	usecase.ErrorTypeNotFound:           codes.NotFound,
+	usecase.ErrorTypeConflict:           codes.Aborted,
+	usecase.ErrorTypePreconditionFailed: codes.FailedPrecondition,
+	usecase.ErrorTypeFailedDependency:   codes.Aborted,
+}
+
+// UnaryError converts the errors of the unary calls to gRPC statuses.
+func UnaryError(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return resp, nil
+}
+
+// StreamError converts the errors of the streaming calls to gRPC statuses.
+func StreamError(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, ss); err != nil {
+		return errorStatus(err)
+	}
+	return nil
+}
+
+// errorStatus returns the gRPC status of an error. Errors that are neither statuses nor usecase
+// errors of a known type are hidden behind an internal error.
+func errorStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	errUC, ok := err.(usecase.Error)
+	if !ok {
+		return status.Error(codes.Internal, "internal server error")
+	}
+	code, ok := mapErrorTypeCode[errUC.Type]
+	if !ok {
+		return status.Error(codes.Internal, "internal server error")
+	}
+	return status.Error(code, errUC.Message)
+}
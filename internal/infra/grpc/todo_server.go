@@ -0,0 +1,277 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	todov1 "github.com/wellingtonlope/todo-api/api/todo/v1"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/eventstream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TodoServer serves the todo.v1.TodoService through the todo usecases.
+type TodoServer struct {
+	todov1.UnimplementedTodoServiceServer
+	create        todo.Create
+	list          todo.List
+	getByID       todo.GetByID
+	update        todo.Update
+	complete      todo.Complete
+	markAsPending todo.MarkAsPending
+	deleteByID    todo.DeleteByID
+	stream        eventstream.Stream
+}
+
+func NewTodoServer(
+	create todo.Create,
+	list todo.List,
+	getByID todo.GetByID,
+	update todo.Update,
+	complete todo.Complete,
+	markAsPending todo.MarkAsPending,
+	deleteByID todo.DeleteByID,
+	stream eventstream.Stream,
+) *TodoServer {
+	return &TodoServer{
+		create:        create,
+		list:          list,
+		getByID:       getByID,
+		update:        update,
+		complete:      complete,
+		markAsPending: markAsPending,
+		deleteByID:    deleteByID,
+		stream:        stream,
+	}
+}
+
+func (s *TodoServer) Create(ctx context.Context, req *todov1.CreateRequest) (*todov1.Todo, error) {
+	output, err := s.create.Handle(ctx, todo.CreateInput{
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		Priority:    domain.TodoPriority(req.GetPriority()),
+		Tags:        req.GetTags(),
+		Recurrence:  req.GetRecurrence(),
+		DueDate:     timeFromProto(req.GetDueDate()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return todoFromUsecase(output), nil
+}
+
+func (s *TodoServer) List(ctx context.Context, req *todov1.ListRequest) (*todov1.ListResponse, error) {
+	filter := todo.ListFilter{
+		Tags:            req.GetTags(),
+		TagMode:         todo.TagMatchMode(req.GetTagMode()),
+		ProjectID:       req.ProjectId,
+		DueBefore:       timeFromProto(req.GetDueBefore()),
+		DueAfter:        timeFromProto(req.GetDueAfter()),
+		Overdue:         req.GetOverdue(),
+		NoDueDate:       req.GetNoDueDate(),
+		CreatedAfter:    timeFromProto(req.GetCreatedAfter()),
+		UpdatedSince:    timeFromProto(req.GetUpdatedSince()),
+		IncludeArchived: req.GetIncludeArchived(),
+	}
+	if req.Status != nil {
+		todoStatus := domain.TodoStatus(req.GetStatus())
+		filter.Status = &todoStatus
+	}
+	if req.Priority != nil {
+		priority := domain.TodoPriority(req.GetPriority())
+		filter.Priority = &priority
+	}
+	output, err := s.list.Handle(ctx, todo.ListInput{
+		Filter: filter,
+		Sort: todo.ListSort{
+			Field:     todo.ListSortField(req.GetSort()),
+			Direction: todo.SortDirection(req.GetOrder()),
+		},
+		Limit:  int(req.GetLimit()),
+		Cursor: req.GetCursor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	todos := make([]*todov1.Todo, 0, len(output.Todos))
+	for _, t := range output.Todos {
+		todos = append(todos, todoFromUsecase(t))
+	}
+	return &todov1.ListResponse{Todos: todos, NextCursor: output.NextCursor}, nil
+}
+
+func (s *TodoServer) GetByID(ctx context.Context, req *todov1.GetByIDRequest) (*todov1.Todo, error) {
+	output, err := s.getByID.Handle(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return todoFromUsecase(output), nil
+}
+
+func (s *TodoServer) Update(ctx context.Context, req *todov1.UpdateRequest) (*todov1.Todo, error) {
+	output, err := s.update.Handle(ctx, todo.UpdateInput{
+		ID:          req.GetId(),
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		Priority:    domain.TodoPriority(req.GetPriority()),
+		Tags:        req.GetTags(),
+		Recurrence:  req.GetRecurrence(),
+		DueDate:     timeFromProto(req.GetDueDate()),
+		Versions:    versionsFromProto(req.Version),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return todoFromUsecase(output), nil
+}
+
+func (s *TodoServer) Complete(ctx context.Context, req *todov1.CompleteRequest) (*todov1.Todo, error) {
+	output, err := s.complete.Handle(ctx, todo.CompleteInput{
+		ID:        req.GetId(),
+		OpenItems: todo.OpenItemsPolicy(req.GetOpenItems()),
+		Versions:  versionsFromProto(req.Version),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return todoFromUsecase(output), nil
+}
+
+func (s *TodoServer) MarkAsPending(ctx context.Context, req *todov1.MarkAsPendingRequest) (*todov1.Todo, error) {
+	output, err := s.markAsPending.Handle(ctx, todo.MarkAsPendingInput{
+		ID:       req.GetId(),
+		Versions: versionsFromProto(req.Version),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return todoFromUsecase(output), nil
+}
+
+func (s *TodoServer) DeleteByID(ctx context.Context, req *todov1.DeleteByIDRequest) (*todov1.DeleteByIDResponse, error) {
+	err := s.deleteByID.Handle(ctx, todo.DeleteByIDInput{
+		ID:        req.GetId(),
+		Versions:  versionsFromProto(req.Version),
+		Permanent: req.GetPermanent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &todov1.DeleteByIDResponse{}, nil
+}
+
+// Watch sends the retained events after the last sequence received, then the new ones, until the
+// call is cancelled. The headers are sent once subscribed, so that a client waiting for them misses
+// none of the events published afterwards. When the stream ends, because the server shuts down or the
+// client fell too far behind, the call fails as unavailable and the client can resume from the last
+// sequence it got. A last sequence the stream did not send, such as one from before the server
+// restarted, is refused as an invalid argument.
+func (s *TodoServer) Watch(req *todov1.WatchRequest, srv todov1.TodoService_WatchServer) error {
+	subscription, err := s.stream.Subscribe(req.GetLastSequence(), eventstream.Filter{
+		Status:    req.GetStatus(),
+		ProjectID: req.GetProjectId(),
+	})
+	if errors.Is(err, eventstream.ErrUnknownID) {
+		return status.Error(codes.InvalidArgument, "unknown last sequence: watch without it")
+	}
+	if err != nil {
+		return status.Error(codes.Unavailable, "the event stream is closed")
+	}
+	defer subscription.Close()
+	if err := srv.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	send := func(message eventstream.Message) error {
+		if len(req.GetTodoIds()) > 0 && !slices.Contains(req.GetTodoIds(), message.Event.TodoID) {
+			return nil
+		}
+		return srv.Send(eventFromStream(message))
+	}
+	for _, message := range subscription.Replay {
+		if err := send(message); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case message, ok := <-subscription.Messages:
+			if !ok {
+				return status.Error(codes.Unavailable, "the event stream ended: resume from the last sequence received")
+			}
+			if err := send(message); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func todoFromUsecase(output todo.TodoOutput) *todov1.Todo {
+	items := make([]*todov1.ChecklistItem, 0, len(output.Items))
+	for _, item := range output.Items {
+		items = append(items, &todov1.ChecklistItem{Id: item.ID, Title: item.Title, Done: item.Done})
+	}
+	return &todov1.Todo{
+		Id:          output.ID,
+		Title:       output.Title,
+		Description: output.Description,
+		Status:      output.Status,
+		Priority:    output.Priority,
+		Tags:        output.Tags,
+		Items:       items,
+		Recurrence:  output.Recurrence,
+		ProjectId:   output.ProjectID,
+		DueDate:     timestampFromTime(output.DueDate),
+		CreatedAt:   timestamppb.New(output.CreatedAt),
+		UpdatedAt:   timestamppb.New(output.UpdatedAt),
+		CompletedAt: timestampFromTime(output.CompletedAt),
+		ArchivedAt:  timestampFromTime(output.ArchivedAt),
+		Version:     int32(output.Version),
+	}
+}
+
+func eventFromStream(message eventstream.Message) *todov1.Event {
+	event := message.Event
+	return &todov1.Event{
+		Sequence:   message.ID,
+		Id:         event.ID,
+		Type:       string(event.Type),
+		TodoId:     event.TodoID,
+		Actor:      event.Actor,
+		OccurredAt: timestamppb.New(event.OccurredAt),
+		Todo:       todoFromUsecase(todo.TodoOutputFromDomain(event.Todo)),
+	}
+}
+
+// timeFromProto converts an optional timestamp, nil when it is not set
+func timeFromProto(timestamp *timestamppb.Timestamp) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+	t := timestamp.AsTime()
+	return &t
+}
+
+// timestampFromTime converts an optional time, nil when it is not set
+func timestampFromTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// versionsFromProto converts an optional version to the versions the todo may still have,
+// nil when it is not set
+func versionsFromProto(version *int32) []int {
+	if version == nil {
+		return nil
+	}
+	return []int{int(*version)}
+}
@@ -0,0 +1,459 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	todov1 "github.com/wellingtonlope/todo-api/api/todo/v1"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/eventstream"
+	grpcServer "github.com/wellingtonlope/todo-api/internal/infra/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type todoServerMocks struct {
+	create        *todoCreateMock
+	list          *todoListMock
+	getByID       *todoGetByIDMock
+	update        *todoUpdateMock
+	complete      *todoCompleteMock
+	markAsPending *todoMarkAsPendingMock
+	deleteByID    *todoDeleteByIDMock
+}
+
+func newTodoServerMocks() todoServerMocks {
+	return todoServerMocks{
+		create:        new(todoCreateMock),
+		list:          new(todoListMock),
+		getByID:       new(todoGetByIDMock),
+		update:        new(todoUpdateMock),
+		complete:      new(todoCompleteMock),
+		markAsPending: new(todoMarkAsPendingMock),
+		deleteByID:    new(todoDeleteByIDMock),
+	}
+}
+
+func (m todoServerMocks) assertExpectations(t *testing.T) {
+	m.create.AssertExpectations(t)
+	m.list.AssertExpectations(t)
+	m.getByID.AssertExpectations(t)
+	m.update.AssertExpectations(t)
+	m.complete.AssertExpectations(t)
+	m.markAsPending.AssertExpectations(t)
+	m.deleteByID.AssertExpectations(t)
+}
+
+// dialTodoServer serves the server through the interceptors of the application and connects to it.
+func dialTodoServer(t *testing.T, mocks todoServerMocks, stream eventstream.Stream) todov1.TodoServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpcServer.UnaryError, grpcServer.UnaryActor),
+		grpc.ChainStreamInterceptor(grpcServer.StreamError),
+	)
+	todov1.RegisterTodoServiceServer(server, grpcServer.NewTodoServer(mocks.create, mocks.list, mocks.getByID,
+		mocks.update, mocks.complete, mocks.markAsPending, mocks.deleteByID, stream))
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return todov1.NewTodoServiceClient(conn)
+}
+
+func TestTodoServer(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	projectID := "p1"
+	version := 2
+	exampleOutput := todo.TodoOutput{
+		ID:          "1",
+		Title:       "Write report",
+		Description: "Quarterly",
+		Status:      string(domain.TodoStatusPending),
+		Priority:    string(domain.TodoPriorityHigh),
+		Tags:        []string{"work"},
+		Items:       []todo.ChecklistItemOutput{{ID: "i1", Title: "Draft", Done: true}},
+		ProjectID:   &projectID,
+		DueDate:     &exampleDate,
+		CreatedAt:   exampleDate,
+		UpdatedAt:   exampleDate,
+		Version:     3,
+	}
+	exampleTodo := &todov1.Todo{
+		Id:          "1",
+		Title:       "Write report",
+		Description: "Quarterly",
+		Status:      "pending",
+		Priority:    "high",
+		Tags:        []string{"work"},
+		Items:       []*todov1.ChecklistItem{{Id: "i1", Title: "Draft", Done: true}},
+		ProjectId:   &projectID,
+		DueDate:     timestamppb.New(exampleDate),
+		CreatedAt:   timestamppb.New(exampleDate),
+		UpdatedAt:   timestamppb.New(exampleDate),
+		Version:     3,
+	}
+	testCases := []struct {
+		name     string
+		mocks    func() todoServerMocks
+		call     func(context.Context, todov1.TodoServiceClient) (proto.Message, error)
+		response proto.Message
+		code     codes.Code
+		message  string
+	}{
+		{
+			name: "should create a todo by the actor of the call",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				m.create.On("Handle", mock.MatchedBy(func(ctx context.Context) bool {
+					return usecase.ActorFromContext(ctx) == "alice"
+				}), todo.CreateInput{
+					Title: "Write report", Description: "Quarterly", Priority: domain.TodoPriorityHigh,
+					Tags: []string{"work"}, DueDate: &exampleDate,
+				}).Return(exampleOutput, nil).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				ctx = metadata.AppendToOutgoingContext(ctx, grpcServer.ActorMetadata, "alice")
+				return client.Create(ctx, &todov1.CreateRequest{
+					Title: "Write report", Description: "Quarterly", Priority: "high",
+					Tags: []string{"work"}, DueDate: timestamppb.New(exampleDate),
+				})
+			},
+			response: exampleTodo,
+		},
+		{
+			name: "should list the todos with the filters",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				status := domain.TodoStatusPending
+				priority := domain.TodoPriorityHigh
+				m.list.On("Handle", mock.Anything, todo.ListInput{
+					Filter: todo.ListFilter{
+						Status: &status, Priority: &priority, Tags: []string{"work"}, ProjectID: &projectID,
+						DueBefore: &exampleDate, Overdue: true,
+					},
+					Sort:  todo.ListSort{Field: todo.ListSortByTitle, Direction: todo.SortDescending},
+					Limit: 10,
+				}).Return(todo.ListOutput{Todos: []todo.TodoOutput{exampleOutput}, NextCursor: "next"}, nil).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				return client.List(ctx, &todov1.ListRequest{
+					Status: proto.String("pending"), Priority: proto.String("high"), Tags: []string{"work"},
+					ProjectId: &projectID, DueBefore: timestamppb.New(exampleDate), Overdue: true,
+					Sort: "title", Order: "desc", Limit: 10,
+				})
+			},
+			response: &todov1.ListResponse{Todos: []*todov1.Todo{exampleTodo}, NextCursor: "next"},
+		},
+		{
+			name: "should get a todo",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				m.getByID.On("Handle", mock.Anything, "1").Return(exampleOutput, nil).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				return client.GetByID(ctx, &todov1.GetByIDRequest{Id: "1"})
+			},
+			response: exampleTodo,
+		},
+		{
+			name: "should fail as not found when the todo does not exist",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				m.getByID.On("Handle", mock.Anything, "9").
+					Return(todo.TodoOutput{}, usecase.NewError("todo not found", nil, usecase.ErrorTypeNotFound)).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				return client.GetByID(ctx, &todov1.GetByIDRequest{Id: "9"})
+			},
+			code:    codes.NotFound,
+			message: "todo not found",
+		},
+		{
+			name: "should update a todo at its version",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				m.update.On("Handle", mock.Anything, todo.UpdateInput{
+					ID: "1", Title: "Write report", Priority: domain.TodoPriorityHigh, Versions: []int{version},
+				}).Return(exampleOutput, nil).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				return client.Update(ctx, &todov1.UpdateRequest{
+					Id: "1", Title: "Write report", Priority: "high", Version: proto.Int32(2),
+				})
+			},
+			response: exampleTodo,
+		},
+		{
+			name: "should fail as a failed precondition when the todo is not at the version anymore",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				m.update.On("Handle", mock.Anything, todo.UpdateInput{ID: "1", Title: "Write report", Versions: []int{version}}).
+					Return(todo.TodoOutput{}, usecase.NewError("todo was modified", nil, usecase.ErrorTypePreconditionFailed)).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				return client.Update(ctx, &todov1.UpdateRequest{Id: "1", Title: "Write report", Version: proto.Int32(2)})
+			},
+			code:    codes.FailedPrecondition,
+			message: "todo was modified",
+		},
+		{
+			name: "should complete a todo",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				m.complete.On("Handle", mock.Anything, todo.CompleteInput{ID: "1", OpenItems: todo.OpenItemsCascade}).
+					Return(exampleOutput, nil).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				return client.Complete(ctx, &todov1.CompleteRequest{Id: "1", OpenItems: "cascade"})
+			},
+			response: exampleTodo,
+		},
+		{
+			name: "should fail as aborted when the transition is not allowed",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				m.complete.On("Handle", mock.Anything, todo.CompleteInput{ID: "1", OpenItems: todo.OpenItemsRefuse}).
+					Return(todo.TodoOutput{}, usecase.NewError("todo has open items", nil, usecase.ErrorTypeConflict)).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				return client.Complete(ctx, &todov1.CompleteRequest{Id: "1", OpenItems: "refuse"})
+			},
+			code:    codes.Aborted,
+			message: "todo has open items",
+		},
+		{
+			name: "should mark a todo as pending",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				m.markAsPending.On("Handle", mock.Anything, todo.MarkAsPendingInput{ID: "1", Versions: []int{version}}).
+					Return(exampleOutput, nil).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				return client.MarkAsPending(ctx, &todov1.MarkAsPendingRequest{Id: "1", Version: proto.Int32(2)})
+			},
+			response: exampleTodo,
+		},
+		{
+			name: "should delete a todo permanently",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				m.deleteByID.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "1", Permanent: true}).
+					Return(nil).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				return client.DeleteByID(ctx, &todov1.DeleteByIDRequest{Id: "1", Permanent: true})
+			},
+			response: &todov1.DeleteByIDResponse{},
+		},
+		{
+			name: "should hide an unexpected error",
+			mocks: func() todoServerMocks {
+				m := newTodoServerMocks()
+				m.deleteByID.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "1"}).Return(assert.AnError).Once()
+				return m
+			},
+			call: func(ctx context.Context, client todov1.TodoServiceClient) (proto.Message, error) {
+				return client.DeleteByID(ctx, &todov1.DeleteByIDRequest{Id: "1"})
+			},
+			code:    codes.Internal,
+			message: "internal server error",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mocks := tc.mocks()
+			client := dialTodoServer(t, mocks, eventstream.NewStream(10, time.Unix(0, 0)))
+
+			response, err := tc.call(context.Background(), client)
+
+			if tc.code == codes.OK {
+				assert.NoError(t, err)
+				assert.True(t, proto.Equal(tc.response, response), "expected %v, got %v", tc.response, response)
+			} else {
+				assert.Equal(t, tc.code, status.Code(err))
+				assert.Equal(t, tc.message, status.Convert(err).Message())
+			}
+			mocks.assertExpectations(t)
+		})
+	}
+}
+
+func TestTodoServer_Watch(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	event := func(todoID string, eventType domain.EventType) domain.Event {
+		return domain.Event{
+			ID: "e" + todoID, Type: eventType, TodoID: todoID, Actor: "alice", OccurredAt: exampleDate,
+			Todo: domain.Todo{
+				ID: todoID, Title: "Write report", Status: domain.TodoStatusPending, Priority: domain.TodoPriorityNone,
+				CreatedAt: exampleDate, UpdatedAt: exampleDate,
+			},
+		}
+	}
+
+	t.Run("should replay the missed events and stream the new ones of the todos", func(t *testing.T) {
+		stream := eventstream.NewStream(10, time.Unix(0, 0))
+		client := dialTodoServer(t, newTodoServerMocks(), stream)
+		assert.NoError(t, stream.Publish(context.Background(), event("1", domain.EventTodoCreated)))
+		assert.NoError(t, stream.Publish(context.Background(), event("1", domain.EventTodoUpdated)))
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		watch, err := client.Watch(ctx, &todov1.WatchRequest{TodoIds: []string{"1"}, LastSequence: 1})
+		assert.NoError(t, err)
+		replayed, err := watch.Recv()
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Publish(context.Background(), event("2", domain.EventTodoCreated)))
+		assert.NoError(t, stream.Publish(context.Background(), event("1", domain.EventTodoCompleted)))
+		pushed, err := watch.Recv()
+		assert.NoError(t, err)
+
+		assert.True(t, proto.Equal(&todov1.Event{
+			Sequence: 2, Id: "e1", Type: "todo.updated", TodoId: "1", Actor: "alice",
+			OccurredAt: timestamppb.New(exampleDate),
+			Todo: &todov1.Todo{
+				Id: "1", Title: "Write report", Status: "pending", Priority: "none", Items: []*todov1.ChecklistItem{},
+				CreatedAt: timestamppb.New(exampleDate), UpdatedAt: timestamppb.New(exampleDate),
+			},
+		}, replayed), "got %v", replayed)
+		assert.Equal(t, uint64(4), pushed.GetSequence())
+		assert.Equal(t, "todo.completed", pushed.GetType())
+	})
+
+	t.Run("should fail as unavailable when the stream ends", func(t *testing.T) {
+		stream := eventstream.NewStream(10, time.Unix(0, 0))
+		client := dialTodoServer(t, newTodoServerMocks(), stream)
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		assert.NoError(t, stream.Publish(context.Background(), event("1", domain.EventTodoCreated)))
+		assert.NoError(t, stream.Publish(context.Background(), event("1", domain.EventTodoUpdated)))
+
+		watch, err := client.Watch(ctx, &todov1.WatchRequest{LastSequence: 1})
+		assert.NoError(t, err)
+		_, err = watch.Recv()
+		assert.NoError(t, err)
+		stream.Close()
+		_, err = watch.Recv()
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, "the event stream ended: resume from the last sequence received", status.Convert(err).Message())
+	})
+
+	t.Run("should refuse a last sequence the stream did not send", func(t *testing.T) {
+		client := dialTodoServer(t, newTodoServerMocks(), eventstream.NewStream(10, time.Unix(0, 0)))
+
+		watch, err := client.Watch(context.Background(), &todov1.WatchRequest{LastSequence: 42})
+		assert.NoError(t, err)
+		_, err = watch.Recv()
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "unknown last sequence: watch without it", status.Convert(err).Message())
+	})
+
+	t.Run("should fail as unavailable when the stream is closed", func(t *testing.T) {
+		stream := eventstream.NewStream(10, time.Unix(0, 0))
+		stream.Close()
+		client := dialTodoServer(t, newTodoServerMocks(), stream)
+
+		watch, err := client.Watch(context.Background(), &todov1.WatchRequest{})
+		assert.NoError(t, err)
+		_, err = watch.Recv()
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, "the event stream is closed", status.Convert(err).Message())
+	})
+}
+
+type todoCreateMock struct {
+	mock.Mock
+}
+
+func (m *todoCreateMock) Handle(ctx context.Context, input todo.CreateInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoListMock struct {
+	mock.Mock
+}
+
+func (m *todoListMock) Handle(ctx context.Context, input todo.ListInput) (todo.ListOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.ListOutput), args.Error(1)
+}
+
+type todoGetByIDMock struct {
+	mock.Mock
+}
+
+func (m *todoGetByIDMock) Handle(ctx context.Context, id string) (todo.TodoOutput, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoUpdateMock struct {
+	mock.Mock
+}
+
+func (m *todoUpdateMock) Handle(ctx context.Context, input todo.UpdateInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoCompleteMock struct {
+	mock.Mock
+}
+
+func (m *todoCompleteMock) Handle(ctx context.Context, input todo.CompleteInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoMarkAsPendingMock struct {
+	mock.Mock
+}
+
+func (m *todoMarkAsPendingMock) Handle(ctx context.Context, input todo.MarkAsPendingInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoDeleteByIDMock struct {
+	mock.Mock
+}
+
+func (m *todoDeleteByIDMock) Handle(ctx context.Context, input todo.DeleteByIDInput) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
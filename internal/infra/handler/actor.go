@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+)
+
+// ActorHeader is the request header naming who makes the request, as recorded in the audit log.
+const ActorHeader = "X-Actor"
+
+// Actor carries the actor named by the X-Actor header in the context of the request.
+// The changes of requests without it are recorded as made by usecase.AnonymousActor.
+func Actor(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if actor := strings.TrimSpace(c.Request().Header.Get(ActorHeader)); actor != "" {
+			req := c.Request()
+			c.SetRequest(req.WithContext(usecase.WithActor(req.Context(), actor)))
+		}
+		return next(c)
+	}
+}
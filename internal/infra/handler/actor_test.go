@@ -0,0 +1,41 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestActor(t *testing.T) {
+	testCases := []struct {
+		name   string
+		header string
+		actor  string
+	}{
+		{"should leave requests without the header anonymous", "", usecase.AnonymousActor},
+		{"should leave requests with a blank header anonymous", "  ", usecase.AnonymousActor},
+		{"should carry the actor of the header", " alice ", "alice"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.header != "" {
+				req.Header.Set(handler.ActorHeader, tc.header)
+			}
+			c := e.NewContext(req, httptest.NewRecorder())
+			var actor string
+			err := handler.Actor(func(c echo.Context) error {
+				actor = usecase.ActorFromContext(c.Request().Context())
+				return nil
+			})(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.actor, actor)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/audit"
+)
+
+type (
+	AuditList struct {
+		list audit.List
+	}
+	auditRecordOutput struct {
+		TodoID    string          `json:"todo_id"`
+		Actor     string          `json:"actor"`
+		Operation string          `json:"operation"`
+		Diff      json.RawMessage `json:"diff" swaggertype:"object"`
+		CreatedAt time.Time       `json:"created_at"`
+	}
+)
+
+func NewAuditList(list audit.List) *AuditList {
+	return &AuditList{list: list}
+}
+
+// @Summary List the audit log
+// @Description Retrieve the records of the changes of todos, the oldest first. Each one has the actor
+// @Description given by the X-Actor header of the request, the operation (create, update, complete,
+// @Description pending, transition, archive, unarchive, move, delete or restore) and the diff of the todo
+// @Description fields, with their value before and after.
+// @Tags audit
+// @Produce json
+// @Param todo_id query string false "Only the records of the todo with this id"
+// @Param since query string false "Only the records created at or after this RFC 3339 date"
+// @Success 200 {array} auditRecordOutput
+// @Failure 400 {object} ErrorResponse
+// @Router /audit [get]
+func (h *AuditList) Handle(c echo.Context) error {
+	since, err := timeQueryParam(c, "since")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+	}
+	outputs, err := h.list.Handle(c.Request().Context(), audit.ListInput{
+		TodoID: c.QueryParam("todo_id"),
+		Since:  since,
+	})
+	if err != nil {
+		return err
+	}
+	records := make([]auditRecordOutput, 0, len(outputs))
+	for _, output := range outputs {
+		records = append(records, auditRecordOutput{
+			TodoID:    output.TodoID,
+			Actor:     output.Actor,
+			Operation: output.Operation,
+			Diff:      output.Diff,
+			CreatedAt: output.CreatedAt,
+		})
+	}
+	return c.JSON(http.StatusOK, records)
+}
+
+func (h *AuditList) Path() string {
+	return "/audit"
+}
+
+func (h *AuditList) Method() string {
+	return http.MethodGet
+}
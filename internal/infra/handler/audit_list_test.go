@@ -0,0 +1,106 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/audit"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestAuditList_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		list           *auditListMock
+		query          string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name:           "should fail when since is not a date",
+			list:           new(auditListMock),
+			query:          "?since=yesterday",
+			responseBody:   `{"message":"invalid since: must be an RFC 3339 date"}`,
+			responseStatus: http.StatusBadRequest,
+			err:            nil,
+		},
+		{
+			name: "should fail when list use case fails",
+			list: func() *auditListMock {
+				m := new(auditListMock)
+				m.On("Handle", mock.Anything, audit.ListInput{}).Return([]audit.RecordOutput(nil), usecase.AnError).Once()
+				return m
+			}(),
+			query:          "",
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should list the records of a todo since a date",
+			list: func() *auditListMock {
+				m := new(auditListMock)
+				m.On("Handle", mock.Anything, audit.ListInput{TodoID: "123", Since: &exampleDate}).
+					Return([]audit.RecordOutput{
+						{
+							TodoID:    "123",
+							Actor:     "alice",
+							Operation: "update",
+							Diff:      json.RawMessage(`{"title":{"before":"a","after":"b"}}`),
+							CreatedAt: exampleDate,
+						},
+					}, nil).Once()
+				return m
+			}(),
+			query:          "?todo_id=123&since=2024-01-01T00:00:00Z",
+			responseBody:   `[{"todo_id":"123","actor":"alice","operation":"update","diff":{"title":{"before":"a","after":"b"}},"created_at":"2024-01-01T00:00:00Z"}]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/audit"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			h := handler.NewAuditList(tc.list)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseStatus, rec.Code)
+			if tc.responseBody != "" {
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.list.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuditList_Path(t *testing.T) {
+	h := handler.NewAuditList(new(auditListMock))
+	assert.Equal(t, "/audit", h.Path())
+}
+
+func TestAuditList_Method(t *testing.T) {
+	h := handler.NewAuditList(new(auditListMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type auditListMock struct {
+	mock.Mock
+}
+
+func (m *auditListMock) Handle(ctx context.Context, input audit.ListInput) ([]audit.RecordOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).([]audit.RecordOutput), args.Error(1)
+}
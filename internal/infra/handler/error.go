@@ -8,9 +8,12 @@ import (
 )
 
 var mapErrorTypeStatus = map[usecase.ErrorType]int{
-	usecase.ErrorTypeInternalError: http.StatusInternalServerError,
-	usecase.ErrorTypeBadRequest:    http.StatusBadRequest,
-	usecase.ErrorTypeNotFound:      http.StatusNotFound,
+	usecase.ErrorTypeInternalError:      http.StatusInternalServerError,
+	usecase.ErrorTypeBadRequest:         http.StatusBadRequest,
+	usecase.ErrorTypeNotFound:           http.StatusNotFound,
+	usecase.ErrorTypeConflict:           http.StatusConflict,
+	usecase.ErrorTypePreconditionFailed: http.StatusPreconditionFailed,
+	usecase.ErrorTypeFailedDependency:   http.StatusFailedDependency,
 }
 
 type errorMessage struct {
@@ -23,20 +26,23 @@ func Error(next echo.HandlerFunc) echo.HandlerFunc {
 		if err == nil {
 			return nil
 		}
-		errUC, ok := err.(usecase.Error)
-		if !ok {
-			return c.JSON(http.StatusInternalServerError, errorMessage{
-				Message: "internal server error",
-			})
-		}
-		status, ok := mapErrorTypeStatus[errUC.Type]
-		if !ok {
-			return c.JSON(http.StatusInternalServerError, errorMessage{
-				Message: "internal server error",
-			})
-		}
+		status, message := errorStatus(err)
 		return c.JSON(status, errorMessage{
-			Message: errUC.Message,
+			Message: message,
 		})
 	}
 }
+
+// errorStatus returns the HTTP status and the message of an error. Errors that are
+// not usecase errors of a known type are hidden behind an internal server error.
+func errorStatus(err error) (int, string) {
+	errUC, ok := err.(usecase.Error)
+	if !ok {
+		return http.StatusInternalServerError, "internal server error"
+	}
+	status, ok := mapErrorTypeStatus[errUC.Type]
+	if !ok {
+		return http.StatusInternalServerError, "internal server error"
+	}
+	return status, errUC.Message
+}
@@ -45,6 +45,30 @@ func TestError(t *testing.T) {
 			responseBody:   `{"message":"test message"}`,
 			responseStatus: http.StatusBadRequest,
 		},
+		{
+			name: "should handle conflict error",
+			next: func(c echo.Context) error {
+				return usecase.NewError("test message", assert.AnError, usecase.ErrorTypeConflict)
+			},
+			responseBody:   `{"message":"test message"}`,
+			responseStatus: http.StatusConflict,
+		},
+		{
+			name: "should handle precondition failed error",
+			next: func(c echo.Context) error {
+				return usecase.NewError("test message", assert.AnError, usecase.ErrorTypePreconditionFailed)
+			},
+			responseBody:   `{"message":"test message"}`,
+			responseStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name: "should handle failed dependency error",
+			next: func(c echo.Context) error {
+				return usecase.NewError("test message", nil, usecase.ErrorTypeFailedDependency)
+			},
+			responseBody:   `{"message":"test message"}`,
+			responseStatus: http.StatusFailedDependency,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
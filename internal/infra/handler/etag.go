@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+var (
+	errInvalidIfMatch   = errors.New("invalid If-Match header")
+	errIfMatchNoVersion = errors.New("If-Match header has no version of the todo")
+)
+
+// etag formats a todo version as a strong entity tag, such as "3"
+func etag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// ifMatch runs the change once, at the todo versions expected by the If-Match header, without any when
+// the header is missing or "*". The header is a list of ETags: the todo must be at the version of one
+// of them, the weak ETags never matching the strong ones of the API.
+func ifMatch[T any](c echo.Context, change func(versions []int) (T, error)) (T, error) {
+	var output T
+	versions, anyVersion, err := ifMatchVersions(c.Request().Header.Get(headerIfMatch))
+	if err != nil {
+		return output, err
+	}
+	if anyVersion {
+		return change(nil)
+	}
+	if len(versions) == 0 {
+		return output, usecase.NewError("todo matches none of the If-Match ETags", errIfMatchNoVersion,
+			usecase.ErrorTypePreconditionFailed)
+	}
+	return change(versions)
+}
+
+// versionsOf returns the versions a todo may still have when a request expects an optional version,
+// nil when it does not expect one.
+func versionsOf(version *int) []int {
+	if version == nil {
+		return nil
+	}
+	return []int{*version}
+}
+
+// ifMatchVersions parses an If-Match header, returning the versions of its strong ETags returned by
+// the API, or anyVersion when it is missing or "*".
+func ifMatchVersions(header string) (versions []int, anyVersion bool, err error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, true, nil
+	}
+	for rest := header; rest != ""; {
+		rest = strings.TrimLeft(rest, " \t")
+		weak := strings.HasPrefix(rest, "W/")
+		rest = strings.TrimPrefix(rest, "W/")
+		if !strings.HasPrefix(rest, `"`) {
+			return nil, false, invalidIfMatchError()
+		}
+		end := strings.IndexByte(rest[1:], '"')
+		if end < 0 {
+			return nil, false, invalidIfMatchError()
+		}
+		tag := rest[1 : end+1]
+		rest = strings.TrimLeft(rest[end+2:], " \t")
+		if rest != "" {
+			if rest[0] != ',' {
+				return nil, false, invalidIfMatchError()
+			}
+			rest = rest[1:]
+		}
+		if version, err := strconv.Atoi(tag); err == nil && version > 0 && !weak && !slices.Contains(versions, version) {
+			versions = append(versions, version)
+		}
+	}
+	return versions, false, nil
+}
+
+func invalidIfMatchError() error {
+	return usecase.NewError("invalid If-Match header: must be a list of ETags or *",
+		errInvalidIfMatch, usecase.ErrorTypeBadRequest)
+}
+
+// todoResponse writes the todo with its version as the ETag header
+func todoResponse(c echo.Context, status int, output todo.TodoOutput) error {
+	if output.Version > 0 {
+		c.Response().Header().Set(headerETag, etag(output.Version))
+	}
+	return c.JSON(status, todoOutputFromUsecase(output))
+}
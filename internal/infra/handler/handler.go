@@ -18,28 +18,65 @@ type ErrorResponse struct {
 }
 
 type todoOutput struct {
-	ID          string     `json:"id"`
-	Title       string     `json:"title"`
-	Description string     `json:"description"`
-	Status      string     `json:"status"`
-	DueDate     *time.Time `json:"due_date,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID          string                `json:"id"`
+	Title       string                `json:"title"`
+	Description string                `json:"description"`
+	Status      string                `json:"status"`
+	Priority    string                `json:"priority"`
+	Tags        []string              `json:"tags"`
+	Items       []checklistItemOutput `json:"items"`
+	Recurrence  string                `json:"recurrence,omitempty" example:"FREQ=WEEKLY;BYDAY=MO,TH"`
+	ProjectID   *string               `json:"project_id,omitempty"`
+	DueDate     *time.Time            `json:"due_date,omitempty"`
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+	CompletedAt *time.Time            `json:"completed_at,omitempty"`
+	ArchivedAt  *time.Time            `json:"archived_at,omitempty"`
+	Version     int                   `json:"version,omitempty" example:"1"`
+	DeletedAt   *time.Time            `json:"deleted_at,omitempty"`
+}
+
+type checklistItemOutput struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Done  bool   `json:"done"`
 }
 
 // todoOutputFromUsecase converts a usecase TodoOutput to handler todoOutput
 func todoOutputFromUsecase(usecaseOutput todo.TodoOutput) todoOutput {
+	tags := usecaseOutput.Tags
+	if tags == nil {
+		tags = []string{}
+	}
 	return todoOutput{
 		ID:          usecaseOutput.ID,
 		Title:       usecaseOutput.Title,
 		Description: usecaseOutput.Description,
 		Status:      usecaseOutput.Status,
+		Priority:    usecaseOutput.Priority,
+		Tags:        tags,
+		Items:       checklistItemOutputsFromUsecase(usecaseOutput.Items),
+		Recurrence:  usecaseOutput.Recurrence,
+		ProjectID:   usecaseOutput.ProjectID,
 		DueDate:     usecaseOutput.DueDate,
 		CreatedAt:   usecaseOutput.CreatedAt,
 		UpdatedAt:   usecaseOutput.UpdatedAt,
+		CompletedAt: usecaseOutput.CompletedAt,
+		ArchivedAt:  usecaseOutput.ArchivedAt,
+		Version:     usecaseOutput.Version,
+		DeletedAt:   usecaseOutput.DeletedAt,
 	}
 }
 
+// checklistItemOutputsFromUsecase converts the checklist items, an empty list when there are none
+func checklistItemOutputsFromUsecase(items []todo.ChecklistItemOutput) []checklistItemOutput {
+	outputs := make([]checklistItemOutput, 0, len(items))
+	for _, item := range items {
+		outputs = append(outputs, checklistItemOutput{ID: item.ID, Title: item.Title, Done: item.Done})
+	}
+	return outputs
+}
+
 // todoOutputsFromUsecase converts a slice of usecase TodoOutput to []todoOutput
 func todoOutputsFromUsecase(usecaseOutputs []todo.TodoOutput) []todoOutput {
 	outputs := make([]todoOutput, 0, len(usecaseOutputs))
@@ -24,6 +24,9 @@ func TestTodoOutputFromUsecase(t *testing.T) {
 				Title:       "Test Title",
 				Description: "Test Description",
 				Status:      "completed",
+				Priority:    "high",
+				Tags:        []string{"home", "work"},
+				Items:       []todo.ChecklistItemOutput{{ID: "1", Title: "first step", Done: true}},
 				DueDate:     &exampleDueDate,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -33,6 +36,9 @@ func TestTodoOutputFromUsecase(t *testing.T) {
 				Title:       "Test Title",
 				Description: "Test Description",
 				Status:      "completed",
+				Priority:    "high",
+				Tags:        []string{"home", "work"},
+				Items:       []checklistItemOutput{{ID: "1", Title: "first step", Done: true}},
 				DueDate:     &exampleDueDate,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -45,6 +51,7 @@ func TestTodoOutputFromUsecase(t *testing.T) {
 				Title:       "Another Title",
 				Description: "Another Description",
 				Status:      "pending",
+				Priority:    "none",
 				DueDate:     nil,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -54,6 +61,9 @@ func TestTodoOutputFromUsecase(t *testing.T) {
 				Title:       "Another Title",
 				Description: "Another Description",
 				Status:      "pending",
+				Priority:    "none",
+				Tags:        []string{},
+				Items:       []checklistItemOutput{},
 				DueDate:     nil,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -66,6 +76,7 @@ func TestTodoOutputFromUsecase(t *testing.T) {
 				Title:       "",
 				Description: "",
 				Status:      "pending",
+				Priority:    "none",
 				DueDate:     nil,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -75,6 +86,9 @@ func TestTodoOutputFromUsecase(t *testing.T) {
 				Title:       "",
 				Description: "",
 				Status:      "pending",
+				Priority:    "none",
+				Tags:        []string{},
+				Items:       []checklistItemOutput{},
 				DueDate:     nil,
 				CreatedAt:   exampleDate,
 				UpdatedAt:   exampleDate,
@@ -112,6 +126,7 @@ func TestTodoOutputsFromUsecase(t *testing.T) {
 					Title:       "Test Title",
 					Description: "Test Description",
 					Status:      "completed",
+					Priority:    "none",
 					DueDate:     &exampleDueDate,
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
@@ -123,6 +138,9 @@ func TestTodoOutputsFromUsecase(t *testing.T) {
 					Title:       "Test Title",
 					Description: "Test Description",
 					Status:      "completed",
+					Priority:    "none",
+					Tags:        []string{},
+					Items:       []checklistItemOutput{},
 					DueDate:     &exampleDueDate,
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
@@ -137,6 +155,7 @@ func TestTodoOutputsFromUsecase(t *testing.T) {
 					Title:       "First Todo",
 					Description: "First Description",
 					Status:      "pending",
+					Priority:    "none",
 					DueDate:     nil,
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
@@ -146,6 +165,7 @@ func TestTodoOutputsFromUsecase(t *testing.T) {
 					Title:       "Second Todo",
 					Description: "Second Description",
 					Status:      "completed",
+					Priority:    "none",
 					DueDate:     &exampleDueDate,
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
@@ -157,6 +177,9 @@ func TestTodoOutputsFromUsecase(t *testing.T) {
 					Title:       "First Todo",
 					Description: "First Description",
 					Status:      "pending",
+					Priority:    "none",
+					Tags:        []string{},
+					Items:       []checklistItemOutput{},
 					DueDate:     nil,
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
@@ -166,6 +189,9 @@ func TestTodoOutputsFromUsecase(t *testing.T) {
 					Title:       "Second Todo",
 					Description: "Second Description",
 					Status:      "completed",
+					Priority:    "none",
+					Tags:        []string{},
+					Items:       []checklistItemOutput{},
 					DueDate:     &exampleDueDate,
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+)
+
+type projectOutput struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// projectOutputFromUsecase converts a usecase ProjectOutput to handler projectOutput
+func projectOutputFromUsecase(usecaseOutput project.ProjectOutput) projectOutput {
+	return projectOutput{
+		ID:          usecaseOutput.ID,
+		Name:        usecaseOutput.Name,
+		Description: usecaseOutput.Description,
+		CreatedAt:   usecaseOutput.CreatedAt,
+		UpdatedAt:   usecaseOutput.UpdatedAt,
+	}
+}
+
+// projectOutputsFromUsecase converts a slice of usecase ProjectOutput to []projectOutput
+func projectOutputsFromUsecase(usecaseOutputs []project.ProjectOutput) []projectOutput {
+	outputs := make([]projectOutput, 0, len(usecaseOutputs))
+	for _, usecaseOutput := range usecaseOutputs {
+		outputs = append(outputs, projectOutputFromUsecase(usecaseOutput))
+	}
+	return outputs
+}
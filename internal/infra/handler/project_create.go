@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+)
+
+type (
+	projectCreateInput struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	ProjectCreate struct {
+		create project.Create
+	}
+)
+
+func NewProjectCreate(create project.Create) *ProjectCreate {
+	return &ProjectCreate{create: create}
+}
+
+// @Summary Create a project
+// @Description Create a new project to group todos
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param project body projectCreateInput true "Project data"
+// @Success 201 {object} projectOutput
+// @Failure 400 {object} ErrorResponse
+// @Router /projects [post]
+func (h *ProjectCreate) Handle(c echo.Context) error {
+	var input projectCreateInput
+	if err := c.Bind(&input); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	output, err := h.create.Handle(c.Request().Context(), project.CreateInput{
+		Name:        input.Name,
+		Description: input.Description,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, projectOutputFromUsecase(output))
+}
+
+func (h *ProjectCreate) Path() string {
+	return "/projects"
+}
+
+func (h *ProjectCreate) Method() string {
+	return http.MethodPost
+}
@@ -0,0 +1,108 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestProjectCreate_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		create         *projectCreateMock
+		requestBody    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when create use case fails",
+			create: func() *projectCreateMock {
+				m := new(projectCreateMock)
+				m.On("Handle", mock.Anything, project.CreateInput{Name: "Home"}).
+					Return(project.ProjectOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			requestBody: `{"name":"Home"}`,
+			err:         usecase.AnError,
+		},
+		{
+			name: "should create a project",
+			create: func() *projectCreateMock {
+				m := new(projectCreateMock)
+				m.On("Handle", mock.Anything, project.CreateInput{Name: "Home", Description: "chores"}).
+					Return(project.ProjectOutput{
+						ID: "p1", Name: "Home", Description: "chores", CreatedAt: exampleDate, UpdatedAt: exampleDate,
+					}, nil).Once()
+				return m
+			}(),
+			requestBody:    `{"name":"Home","description":"chores"}`,
+			responseBody:   `{"id":"p1","name":"Home","description":"chores","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusCreated,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			h := handler.NewProjectCreate(tc.create)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.create.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectCreate_InvalidJSON(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{"))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, httptest.NewRecorder())
+	err := handler.NewProjectCreate(new(projectCreateMock)).Handle(c)
+	errUC, ok := err.(usecase.Error)
+	assert.True(t, ok)
+	assert.Equal(t, "invalid JSON input", errUC.Message)
+	assert.Equal(t, usecase.ErrorTypeBadRequest, errUC.Type)
+}
+
+func TestProjectCreate_Path(t *testing.T) {
+	h := handler.NewProjectCreate(new(projectCreateMock))
+	assert.Equal(t, "/projects", h.Path())
+}
+
+func TestProjectCreate_Method(t *testing.T) {
+	h := handler.NewProjectCreate(new(projectCreateMock))
+	assert.Equal(t, http.MethodPost, h.Method())
+}
+
+type projectCreateMock struct {
+	mock.Mock
+}
+
+func (m *projectCreateMock) Handle(ctx context.Context, input project.CreateInput) (project.ProjectOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(project.ProjectOutput), args.Error(1)
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+)
+
+type ProjectDeleteByID struct {
+	deleteByID project.DeleteByID
+}
+
+func NewProjectDeleteByID(deleteByID project.DeleteByID) *ProjectDeleteByID {
+	return &ProjectDeleteByID{deleteByID: deleteByID}
+}
+
+// @Summary Delete a project by ID
+// @Description Delete a project. The todos query parameter tells what happens to its todos:
+// @Description cascade moves them to the trash, orphan keeps them without a project and refuse fails
+// @Description with 409 while the project has todos. It defaults to the PROJECT_DELETE_POLICY setting.
+// @Description The todos already in the trash are kept there without a project.
+// @Tags projects
+// @Param id path string true "Project ID"
+// @Param todos query string false "Policy for the todos of the project (cascade, orphan or refuse)"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /projects/{id} [delete]
+func (h *ProjectDeleteByID) Handle(c echo.Context) error {
+	err := h.deleteByID.Handle(c.Request().Context(), project.DeleteByIDInput{
+		ID:     c.Param("id"),
+		Policy: project.DeletePolicy(c.QueryParam("todos")),
+	})
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *ProjectDeleteByID) Path() string {
+	return "/projects/:id"
+}
+
+func (h *ProjectDeleteByID) Method() string {
+	return http.MethodDelete
+}
@@ -0,0 +1,95 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestProjectDeleteByID_Handle(t *testing.T) {
+	testCases := []struct {
+		name           string
+		deleteByID     *projectDeleteByIDMock
+		queryParams    string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when delete use case fails",
+			deleteByID: func() *projectDeleteByIDMock {
+				m := new(projectDeleteByIDMock)
+				m.On("Handle", mock.Anything, project.DeleteByIDInput{ID: "p1"}).Return(usecase.AnError).Once()
+				return m
+			}(),
+			queryParams:    "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should delete a project with the default policy",
+			deleteByID: func() *projectDeleteByIDMock {
+				m := new(projectDeleteByIDMock)
+				m.On("Handle", mock.Anything, project.DeleteByIDInput{ID: "p1"}).Return(nil).Once()
+				return m
+			}(),
+			queryParams:    "",
+			responseStatus: http.StatusNoContent,
+			err:            nil,
+		},
+		{
+			name: "should pass the todos policy to the delete use case",
+			deleteByID: func() *projectDeleteByIDMock {
+				m := new(projectDeleteByIDMock)
+				m.On("Handle", mock.Anything, project.DeleteByIDInput{ID: "p1", Policy: project.DeleteCascade}).
+					Return(nil).Once()
+				return m
+			}(),
+			queryParams:    "?todos=cascade",
+			responseStatus: http.StatusNoContent,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/"+tc.queryParams, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/projects/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("p1")
+			h := handler.NewProjectDeleteByID(tc.deleteByID)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			tc.deleteByID.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectDeleteByID_Path(t *testing.T) {
+	h := handler.NewProjectDeleteByID(new(projectDeleteByIDMock))
+	assert.Equal(t, "/projects/:id", h.Path())
+}
+
+func TestProjectDeleteByID_Method(t *testing.T) {
+	h := handler.NewProjectDeleteByID(new(projectDeleteByIDMock))
+	assert.Equal(t, http.MethodDelete, h.Method())
+}
+
+type projectDeleteByIDMock struct {
+	mock.Mock
+}
+
+func (m *projectDeleteByIDMock) Handle(ctx context.Context, input project.DeleteByIDInput) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+)
+
+type ProjectGetByID struct {
+	getByID project.GetByID
+}
+
+func NewProjectGetByID(getByID project.GetByID) *ProjectGetByID {
+	return &ProjectGetByID{getByID: getByID}
+}
+
+// @Summary Get a project by ID
+// @Description Retrieve a project by its ID
+// @Tags projects
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} projectOutput
+// @Failure 404 {object} ErrorResponse
+// @Router /projects/{id} [get]
+func (h *ProjectGetByID) Handle(c echo.Context) error {
+	output, err := h.getByID.Handle(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, projectOutputFromUsecase(output))
+}
+
+func (h *ProjectGetByID) Path() string {
+	return "/projects/:id"
+}
+
+func (h *ProjectGetByID) Method() string {
+	return http.MethodGet
+}
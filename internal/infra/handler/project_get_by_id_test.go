@@ -0,0 +1,89 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestProjectGetByID_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		getByID        *projectGetByIDMock
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when get use case fails",
+			getByID: func() *projectGetByIDMock {
+				m := new(projectGetByIDMock)
+				m.On("Handle", mock.Anything, "p1").Return(project.ProjectOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should get a project by id",
+			getByID: func() *projectGetByIDMock {
+				m := new(projectGetByIDMock)
+				m.On("Handle", mock.Anything, "p1").Return(project.ProjectOutput{
+					ID: "p1", Name: "Home", CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}, nil).Once()
+				return m
+			}(),
+			responseBody:   `{"id":"p1","name":"Home","description":"","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/projects/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("p1")
+			h := handler.NewProjectGetByID(tc.getByID)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseBody, strings.Trim(rec.Body.String(), "\n"))
+			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			tc.getByID.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectGetByID_Path(t *testing.T) {
+	h := handler.NewProjectGetByID(new(projectGetByIDMock))
+	assert.Equal(t, "/projects/:id", h.Path())
+}
+
+func TestProjectGetByID_Method(t *testing.T) {
+	h := handler.NewProjectGetByID(new(projectGetByIDMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type projectGetByIDMock struct {
+	mock.Mock
+}
+
+func (m *projectGetByIDMock) Handle(ctx context.Context, id string) (project.ProjectOutput, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(project.ProjectOutput), args.Error(1)
+}
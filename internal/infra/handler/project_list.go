@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+)
+
+type ProjectList struct {
+	list project.List
+}
+
+func NewProjectList(list project.List) *ProjectList {
+	return &ProjectList{list: list}
+}
+
+// @Summary List projects
+// @Description Retrieve every project ordered by name
+// @Tags projects
+// @Produce json
+// @Success 200 {array} projectOutput
+// @Router /projects [get]
+func (h *ProjectList) Handle(c echo.Context) error {
+	output, err := h.list.Handle(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, projectOutputsFromUsecase(output))
+}
+
+func (h *ProjectList) Path() string {
+	return "/projects"
+}
+
+func (h *ProjectList) Method() string {
+	return http.MethodGet
+}
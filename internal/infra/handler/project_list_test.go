@@ -0,0 +1,97 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestProjectList_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		list           *projectListMock
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when list use case fails",
+			list: func() *projectListMock {
+				m := new(projectListMock)
+				m.On("Handle", mock.Anything).Return([]project.ProjectOutput(nil), usecase.AnError).Once()
+				return m
+			}(),
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should list projects",
+			list: func() *projectListMock {
+				m := new(projectListMock)
+				m.On("Handle", mock.Anything).Return([]project.ProjectOutput{
+					{ID: "p1", Name: "Home", CreatedAt: exampleDate, UpdatedAt: exampleDate},
+				}, nil).Once()
+				return m
+			}(),
+			responseBody:   `[{"id":"p1","name":"Home","description":"","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should return an empty array without projects",
+			list: func() *projectListMock {
+				m := new(projectListMock)
+				m.On("Handle", mock.Anything).Return([]project.ProjectOutput(nil), nil).Once()
+				return m
+			}(),
+			responseBody:   `[]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/projects", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			h := handler.NewProjectList(tc.list)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseBody, strings.Trim(rec.Body.String(), "\n"))
+			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			tc.list.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectList_Path(t *testing.T) {
+	h := handler.NewProjectList(new(projectListMock))
+	assert.Equal(t, "/projects", h.Path())
+}
+
+func TestProjectList_Method(t *testing.T) {
+	h := handler.NewProjectList(new(projectListMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type projectListMock struct {
+	mock.Mock
+}
+
+func (m *projectListMock) Handle(ctx context.Context) ([]project.ProjectOutput, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]project.ProjectOutput), args.Error(1)
+}
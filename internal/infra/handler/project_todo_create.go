@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+)
+
+type ProjectTodoCreate struct {
+	createTodo project.CreateTodo
+}
+
+func NewProjectTodoCreate(createTodo project.CreateTodo) *ProjectTodoCreate {
+	return &ProjectTodoCreate{createTodo: createTodo}
+}
+
+// @Summary Create a todo in a project
+// @Description Create a new todo item belonging to the project
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param todo body todoCreateInput true "Todo data"
+// @Success 201 {object} todoOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /projects/{id}/todos [post]
+func (h *ProjectTodoCreate) Handle(c echo.Context) error {
+	var input todoCreateInput
+	if err := c.Bind(&input); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	output, err := h.createTodo.Handle(c.Request().Context(), project.CreateTodoInput{
+		ProjectID: c.Param("id"),
+		Todo:      createInputFromRequest(input),
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusCreated, output)
+}
+
+func (h *ProjectTodoCreate) Path() string {
+	return "/projects/:id/todos"
+}
+
+func (h *ProjectTodoCreate) Method() string {
+	return http.MethodPost
+}
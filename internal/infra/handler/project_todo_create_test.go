@@ -0,0 +1,112 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestProjectTodoCreate_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	projectID := "p1"
+	testCases := []struct {
+		name           string
+		createTodo     *projectTodoCreateMock
+		requestBody    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when create use case fails",
+			createTodo: func() *projectTodoCreateMock {
+				m := new(projectTodoCreateMock)
+				m.On("Handle", mock.Anything, project.CreateTodoInput{
+					ProjectID: "p1",
+					Todo:      todo.CreateInput{Title: "example title"},
+				}).Return(todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			requestBody: `{"title":"example title"}`,
+			err:         usecase.AnError,
+		},
+		{
+			name: "should create a todo in the project",
+			createTodo: func() *projectTodoCreateMock {
+				m := new(projectTodoCreateMock)
+				m.On("Handle", mock.Anything, project.CreateTodoInput{
+					ProjectID: "p1",
+					Todo:      todo.CreateInput{Title: "example title", Priority: domain.TodoPriorityHigh},
+				}).Return(todo.TodoOutput{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "pending",
+					Priority:  "high",
+					ProjectID: &projectID,
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDate,
+				}, nil).Once()
+				return m
+			}(),
+			requestBody:    `{"title":"example title","priority":"high"}`,
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"pending","priority":"high","tags":[],"items":[],"project_id":"p1","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusCreated,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/projects/:id/todos")
+			c.SetParamNames("id")
+			c.SetParamValues("p1")
+
+			h := handler.NewProjectTodoCreate(tc.createTodo)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.createTodo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectTodoCreate_Path(t *testing.T) {
+	h := handler.NewProjectTodoCreate(new(projectTodoCreateMock))
+	assert.Equal(t, "/projects/:id/todos", h.Path())
+}
+
+func TestProjectTodoCreate_Method(t *testing.T) {
+	h := handler.NewProjectTodoCreate(new(projectTodoCreateMock))
+	assert.Equal(t, http.MethodPost, h.Method())
+}
+
+type projectTodoCreateMock struct {
+	mock.Mock
+}
+
+func (m *projectTodoCreateMock) Handle(ctx context.Context, input project.CreateTodoInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
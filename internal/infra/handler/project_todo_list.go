@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+)
+
+type ProjectTodoList struct {
+	listTodos project.ListTodos
+}
+
+func NewProjectTodoList(listTodos project.ListTodos) *ProjectTodoList {
+	return &ProjectTodoList{listTodos: listTodos}
+}
+
+// @Summary List the todos of a project
+// @Description Retrieve the todos of a project. It accepts the filters, ordering and pagination of GET /todos.
+// @Tags projects
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param status query string false "Filter by status, one of the workflow statuses"
+// @Param sort query string false "Sort field (due_date, created_at, updated_at, title or priority), defaults to created_at"
+// @Param order query string false "Sort direction (asc or desc), defaults to asc"
+// @Param limit query int false "Page size (1-100), enables pagination"
+// @Param cursor query string false "Opaque cursor returned as next_cursor by the previous page"
+// @Success 200 {object} todoListOutput "Page envelope, or a bare todoOutput array when limit and cursor are omitted"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /projects/{id}/todos [get]
+func (h *ProjectTodoList) Handle(c echo.Context) error {
+	input, paginated, err := listInputFromQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+	}
+	output, err := h.listTodos.Handle(c.Request().Context(), project.ListTodosInput{
+		ProjectID: c.Param("id"),
+		List:      input,
+	})
+	if err != nil {
+		return err
+	}
+	return listResponse(c, output, paginated)
+}
+
+func (h *ProjectTodoList) Path() string {
+	return "/projects/:id/todos"
+}
+
+func (h *ProjectTodoList) Method() string {
+	return http.MethodGet
+}
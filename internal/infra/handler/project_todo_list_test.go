@@ -0,0 +1,130 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestProjectTodoList_Handle(t *testing.T) {
+	projectID := "p1"
+	pendingStatus := domain.TodoStatusPending
+	invalidStatus := domain.TodoStatus("done")
+	invalidStatusErr := usecase.NewError("invalid status: must be 'pending' or 'completed'", nil, usecase.ErrorTypeBadRequest)
+	testCases := []struct {
+		name           string
+		listTodos      *projectTodoListMock
+		queryParams    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when list use case fails",
+			listTodos: func() *projectTodoListMock {
+				m := new(projectTodoListMock)
+				m.On("Handle", mock.Anything, project.ListTodosInput{ProjectID: "p1"}).
+					Return(todo.ListOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			queryParams:    "",
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should list the todos of the project",
+			listTodos: func() *projectTodoListMock {
+				m := new(projectTodoListMock)
+				m.On("Handle", mock.Anything, project.ListTodosInput{
+					ProjectID: "p1",
+					List:      todo.ListInput{Filter: todo.ListFilter{Status: &pendingStatus}},
+				}).Return(todo.ListOutput{Todos: []todo.TodoOutput{
+					{ID: "123", Title: "example title", Status: "pending", Priority: "none", ProjectID: &projectID},
+				}}, nil).Once()
+				return m
+			}(),
+			queryParams:    "?status=pending",
+			responseBody:   `[{"id":"123","title":"example title","description":"","status":"pending","priority":"none","tags":[],"items":[],"project_id":"p1","created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should return a page envelope when paginated",
+			listTodos: func() *projectTodoListMock {
+				m := new(projectTodoListMock)
+				m.On("Handle", mock.Anything, project.ListTodosInput{
+					ProjectID: "p1",
+					List:      todo.ListInput{Limit: 1},
+				}).Return(todo.ListOutput{Todos: []todo.TodoOutput{}, NextCursor: "next"}, nil).Once()
+				return m
+			}(),
+			queryParams:    "?limit=1",
+			responseBody:   `{"data":[],"next_cursor":"next"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should leave the validation of the status to the use case",
+			listTodos: func() *projectTodoListMock {
+				m := new(projectTodoListMock)
+				m.On("Handle", mock.Anything, project.ListTodosInput{
+					ProjectID: "p1",
+					List:      todo.ListInput{Filter: todo.ListFilter{Status: &invalidStatus}},
+				}).Return(todo.ListOutput{}, invalidStatusErr).Once()
+				return m
+			}(),
+			queryParams:    "?status=done",
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            invalidStatusErr,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/"+tc.queryParams, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/projects/:id/todos")
+			c.SetParamNames("id")
+			c.SetParamValues("p1")
+			h := handler.NewProjectTodoList(tc.listTodos)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseBody, strings.Trim(rec.Body.String(), "\n"))
+			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			tc.listTodos.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectTodoList_Path(t *testing.T) {
+	h := handler.NewProjectTodoList(new(projectTodoListMock))
+	assert.Equal(t, "/projects/:id/todos", h.Path())
+}
+
+func TestProjectTodoList_Method(t *testing.T) {
+	h := handler.NewProjectTodoList(new(projectTodoListMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type projectTodoListMock struct {
+	mock.Mock
+}
+
+func (m *projectTodoListMock) Handle(ctx context.Context, input project.ListTodosInput) (todo.ListOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.ListOutput), args.Error(1)
+}
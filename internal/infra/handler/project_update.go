@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+)
+
+type (
+	projectUpdateInput struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	ProjectUpdate struct {
+		update project.Update
+	}
+)
+
+func NewProjectUpdate(update project.Update) *ProjectUpdate {
+	return &ProjectUpdate{update: update}
+}
+
+// @Summary Update a project
+// @Description Update the name and description of a project
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param project body projectUpdateInput true "Updated project data"
+// @Success 200 {object} projectOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /projects/{id} [put]
+func (h *ProjectUpdate) Handle(c echo.Context) error {
+	var input projectUpdateInput
+	if err := c.Bind(&input); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	output, err := h.update.Handle(c.Request().Context(), project.UpdateInput{
+		ID:          c.Param("id"),
+		Name:        input.Name,
+		Description: input.Description,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, projectOutputFromUsecase(output))
+}
+
+func (h *ProjectUpdate) Path() string {
+	return "/projects/:id"
+}
+
+func (h *ProjectUpdate) Method() string {
+	return http.MethodPut
+}
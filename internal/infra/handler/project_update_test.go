@@ -0,0 +1,99 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestProjectUpdate_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		update         *projectUpdateMock
+		requestBody    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when update use case fails",
+			update: func() *projectUpdateMock {
+				m := new(projectUpdateMock)
+				m.On("Handle", mock.Anything, project.UpdateInput{ID: "p1", Name: "Work"}).
+					Return(project.ProjectOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			requestBody: `{"name":"Work"}`,
+			err:         usecase.AnError,
+		},
+		{
+			name: "should update a project",
+			update: func() *projectUpdateMock {
+				m := new(projectUpdateMock)
+				m.On("Handle", mock.Anything, project.UpdateInput{ID: "p1", Name: "Work", Description: "office"}).
+					Return(project.ProjectOutput{
+						ID: "p1", Name: "Work", Description: "office", CreatedAt: exampleDate, UpdatedAt: exampleDate,
+					}, nil).Once()
+				return m
+			}(),
+			requestBody:    `{"name":"Work","description":"office"}`,
+			responseBody:   `{"id":"p1","name":"Work","description":"office","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/projects/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("p1")
+
+			h := handler.NewProjectUpdate(tc.update)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.update.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectUpdate_Path(t *testing.T) {
+	h := handler.NewProjectUpdate(new(projectUpdateMock))
+	assert.Equal(t, "/projects/:id", h.Path())
+}
+
+func TestProjectUpdate_Method(t *testing.T) {
+	h := handler.NewProjectUpdate(new(projectUpdateMock))
+	assert.Equal(t, http.MethodPut, h.Method())
+}
+
+type projectUpdateMock struct {
+	mock.Mock
+}
+
+func (m *projectUpdateMock) Handle(ctx context.Context, input project.UpdateInput) (project.ProjectOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(project.ProjectOutput), args.Error(1)
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	tagOutput struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+	TagList struct {
+		listTags todo.ListTags
+	}
+)
+
+func NewTagList(listTags todo.ListTags) *TagList {
+	return &TagList{listTags: listTags}
+}
+
+// @Summary List tags
+// @Description Retrieve the tags in use with the number of todos labelled with each, the most used first
+// @Tags tags
+// @Produce json
+// @Success 200 {array} tagOutput
+// @Router /tags [get]
+func (h *TagList) Handle(c echo.Context) error {
+	tags, err := h.listTags.Handle(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	outputs := make([]tagOutput, 0, len(tags))
+	for _, tag := range tags {
+		outputs = append(outputs, tagOutput{Name: tag.Name, Count: tag.Count})
+	}
+	return c.JSON(http.StatusOK, outputs)
+}
+
+func (h *TagList) Path() string {
+	return "/tags"
+}
+
+func (h *TagList) Method() string {
+	return http.MethodGet
+}
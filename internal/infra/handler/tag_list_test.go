@@ -0,0 +1,97 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTagList_Handle(t *testing.T) {
+	testCases := []struct {
+		name           string
+		listTags       *tagListMock
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when list tags use case fails",
+			listTags: func() *tagListMock {
+				m := new(tagListMock)
+				m.On("Handle", mock.Anything).Return([]todo.TagUsage{}, usecase.AnError).Once()
+				return m
+			}(),
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should return an empty list when no tag is used",
+			listTags: func() *tagListMock {
+				m := new(tagListMock)
+				m.On("Handle", mock.Anything).Return([]todo.TagUsage{}, nil).Once()
+				return m
+			}(),
+			responseBody:   `[]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should list the tags with their usage counts",
+			listTags: func() *tagListMock {
+				m := new(tagListMock)
+				m.On("Handle", mock.Anything).Return([]todo.TagUsage{
+					{Name: "work", Count: 2},
+					{Name: "home", Count: 1},
+				}, nil).Once()
+				return m
+			}(),
+			responseBody:   `[{"name":"work","count":2},{"name":"home","count":1}]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/tags", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			h := handler.NewTagList(tc.listTags)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseBody, strings.Trim(rec.Body.String(), "\n"))
+			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			tc.listTags.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTagList_Path(t *testing.T) {
+	h := handler.NewTagList(new(tagListMock))
+	assert.Equal(t, "/tags", h.Path())
+}
+
+func TestTagList_Method(t *testing.T) {
+	h := handler.NewTagList(new(tagListMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type tagListMock struct {
+	mock.Mock
+}
+
+func (m *tagListMock) Handle(ctx context.Context) ([]todo.TagUsage, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]todo.TagUsage), args.Error(1)
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	TodoArchive struct {
+		archive todo.Archive
+	}
+)
+
+func NewTodoArchive(archive todo.Archive) *TodoArchive {
+	return &TodoArchive{archive: archive}
+}
+
+// @Summary Archive a todo
+// @Description Archive a todo, whatever its status, hiding it from the todo lists that do not set include_archived
+// @Tags todos
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param If-Match header string false "ETag of the todo version being archived"
+// @Success 200 {object} todoOutput
+// @Header 200 {string} ETag "Version of the archived todo"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Router /todos/{id}/archive [post]
+func (h *TodoArchive) Handle(c echo.Context) error {
+	output, err := ifMatch(c, func(versions []int) (todo.TodoOutput, error) {
+		return h.archive.Handle(c.Request().Context(), todo.ArchiveInput{
+			ID:       c.Param("id"),
+			Versions: versions,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusOK, output)
+}
+
+func (h *TodoArchive) Path() string {
+	return "/todos/:id/archive"
+}
+
+func (h *TodoArchive) Method() string {
+	return http.MethodPost
+}
@@ -0,0 +1,114 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoArchive_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	version := 2
+	testCases := []struct {
+		name           string
+		archive        *todoArchiveMock
+		ifMatch        string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when archive use case fails",
+			archive: func() *todoArchiveMock {
+				m := new(todoArchiveMock)
+				m.On("Handle", mock.Anything, todo.ArchiveInput{ID: "123"}).
+					Return(todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name:           "should fail when If-Match is invalid",
+			archive:        new(todoArchiveMock),
+			ifMatch:        "2",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid If-Match header: must be a list of ETags or *",
+				errors.New("invalid If-Match header"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should archive a todo at the If-Match version",
+			archive: func() *todoArchiveMock {
+				m := new(todoArchiveMock)
+				m.On("Handle", mock.Anything, todo.ArchiveInput{ID: "123", Versions: []int{version}}).
+					Return(todo.TodoOutput{
+						ID:         "123",
+						Title:      "example title",
+						Status:     "completed",
+						Priority:   "none",
+						CreatedAt:  exampleDate,
+						UpdatedAt:  exampleDateUpdated,
+						ArchivedAt: &exampleDateUpdated,
+						Version:    3,
+					}, nil).Once()
+				return m
+			}(),
+			ifMatch:        `"2"`,
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"completed","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-02T00:00:00Z","archived_at":"2024-01-02T00:00:00Z","version":3}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/todos/:id/archive")
+			c.SetParamNames("id")
+			c.SetParamValues("123")
+			h := handler.NewTodoArchive(tc.archive)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseStatus, rec.Code)
+			if tc.responseBody != "" {
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.archive.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoArchive_Path(t *testing.T) {
+	h := handler.NewTodoArchive(new(todoArchiveMock))
+	assert.Equal(t, "/todos/:id/archive", h.Path())
+}
+
+func TestTodoArchive_Method(t *testing.T) {
+	h := handler.NewTodoArchive(new(todoArchiveMock))
+	assert.Equal(t, http.MethodPost, h.Method())
+}
+
+type todoArchiveMock struct {
+	mock.Mock
+}
+
+func (m *todoArchiveMock) Handle(ctx context.Context, input todo.ArchiveInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
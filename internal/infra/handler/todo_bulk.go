@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	todoBulkInput struct {
+		Operations []todoBulkOperationInput `json:"operations"`
+	}
+	// todoBulkOperationInput is an operation of a bulk request. id is required by every op but create,
+	// version plays the role of If-Match and the other fields are those of the single request of the op.
+	todoBulkOperationInput struct {
+		Op          string     `json:"op" enums:"create,update,complete,pending,delete"`
+		ID          string     `json:"id,omitempty"`
+		Version     *int       `json:"version,omitempty" example:"1"`
+		Title       string     `json:"title,omitempty"`
+		Description string     `json:"description,omitempty"`
+		Priority    string     `json:"priority,omitempty" enums:"none,low,medium,high,urgent"`
+		Tags        []string   `json:"tags,omitempty"`
+		Recurrence  string     `json:"recurrence,omitempty" example:"FREQ=WEEKLY;BYDAY=MO,TH"`
+		DueDate     *time.Time `json:"due_date,omitempty"`
+		OpenItems   string     `json:"open_items,omitempty" enums:"allow,refuse,cascade"`
+		Permanent   bool       `json:"permanent,omitempty"`
+	}
+	todoBulkOutput struct {
+		Results []todoBulkResultOutput `json:"results"`
+	}
+	// todoBulkResultOutput is the outcome of an operation, with the HTTP status its own request would have had
+	todoBulkResultOutput struct {
+		Op     string      `json:"op"`
+		Status int         `json:"status" example:"200"`
+		Todo   *todoOutput `json:"todo,omitempty"`
+		Error  string      `json:"error,omitempty"`
+	}
+	TodoBulk struct {
+		bulk todo.Bulk
+	}
+)
+
+func NewTodoBulk(bulk todo.Bulk) *TodoBulk {
+	return &TodoBulk{bulk: bulk}
+}
+
+// @Summary Run several todo operations
+// @Description Run a list of create, update, complete, pending and delete operations, reporting the
+// @Description result of each one with the status its own request would have had. By default every
+// @Description operation runs on its own. With atomic=true they run in a single transaction that is
+// @Description rolled back on the first failure: the failed operation keeps its error and every other
+// @Description one fails with 424.
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param operations body todoBulkInput true "Operations, at most 100"
+// @Param atomic query bool false "Run all operations or none" default(false)
+// @Success 200 {object} todoBulkOutput
+// @Failure 400 {object} ErrorResponse
+// @Router /todos/bulk [post]
+func (h *TodoBulk) Handle(c echo.Context) error {
+	atomic, err := boolQueryParam(c, "atomic")
+	if err != nil {
+		return usecase.NewError(err.Error(), err, usecase.ErrorTypeBadRequest)
+	}
+	var input todoBulkInput
+	if err := c.Bind(&input); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	operations := make([]todo.BulkOperation, 0, len(input.Operations))
+	for _, op := range input.Operations {
+		operations = append(operations, bulkOperationFromRequest(op))
+	}
+	output, err := h.bulk.Handle(c.Request().Context(), todo.BulkInput{Operations: operations, Atomic: atomic})
+	if err != nil {
+		return err
+	}
+	results := make([]todoBulkResultOutput, 0, len(output.Results))
+	for _, result := range output.Results {
+		results = append(results, bulkResultFromUsecase(result))
+	}
+	return c.JSON(http.StatusOK, todoBulkOutput{Results: results})
+}
+
+// bulkOperationFromRequest converts an operation of the request to the input of its usecase
+func bulkOperationFromRequest(op todoBulkOperationInput) todo.BulkOperation {
+	operation := todo.BulkOperation{Action: todo.BulkAction(op.Op)}
+	switch operation.Action {
+	case todo.BulkActionCreate:
+		operation.Create = todo.CreateInput{
+			Title:       op.Title,
+			Description: op.Description,
+			Priority:    domain.TodoPriority(op.Priority),
+			Tags:        op.Tags,
+			Recurrence:  op.Recurrence,
+			DueDate:     op.DueDate,
+		}
+	case todo.BulkActionUpdate:
+		operation.Update = todo.UpdateInput{
+			ID:          op.ID,
+			Title:       op.Title,
+			Description: op.Description,
+			Priority:    domain.TodoPriority(op.Priority),
+			Tags:        op.Tags,
+			Recurrence:  op.Recurrence,
+			DueDate:     op.DueDate,
+			Versions:    versionsOf(op.Version),
+		}
+	case todo.BulkActionComplete:
+		operation.Complete = todo.CompleteInput{
+			ID:        op.ID,
+			OpenItems: todo.OpenItemsPolicy(op.OpenItems),
+			Versions:  versionsOf(op.Version),
+		}
+	case todo.BulkActionPending:
+		operation.MarkAsPending = todo.MarkAsPendingInput{ID: op.ID, Versions: versionsOf(op.Version)}
+	case todo.BulkActionDelete:
+		operation.Delete = todo.DeleteByIDInput{ID: op.ID, Versions: versionsOf(op.Version), Permanent: op.Permanent}
+	}
+	return operation
+}
+
+// bulkResultFromUsecase converts the result of an operation, using the status of its single request
+func bulkResultFromUsecase(result todo.BulkResult) todoBulkResultOutput {
+	output := todoBulkResultOutput{Op: string(result.Action)}
+	switch {
+	case result.Err != nil:
+		output.Status, output.Error = errorStatus(result.Err)
+	case result.Action == todo.BulkActionDelete:
+		output.Status = http.StatusNoContent
+	case result.Action == todo.BulkActionCreate:
+		output.Status = http.StatusCreated
+	default:
+		output.Status = http.StatusOK
+	}
+	if result.Todo != nil {
+		t := todoOutputFromUsecase(*result.Todo)
+		output.Todo = &t
+	}
+	return output
+}
+
+func (h *TodoBulk) Path() string {
+	return "/todos/bulk"
+}
+
+func (h *TodoBulk) Method() string {
+	return http.MethodPost
+}
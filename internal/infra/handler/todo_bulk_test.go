@@ -0,0 +1,149 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoBulk_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	version := 2
+	testCases := []struct {
+		name           string
+		bulk           *todoBulkMock
+		query          string
+		requestBody    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name:           "should fail when atomic is not a boolean",
+			bulk:           new(todoBulkMock),
+			query:          "atomic=maybe",
+			requestBody:    `{"operations":[]}`,
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid atomic: must be true or false",
+				errors.New("invalid atomic: must be true or false"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:           "should fail when JSON invalid",
+			bulk:           new(todoBulkMock),
+			requestBody:    "{",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid JSON input", func() error {
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{"))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				var aux any
+				return c.Bind(&aux)
+			}(), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when bulk use case fails",
+			bulk: func() *todoBulkMock {
+				m := new(todoBulkMock)
+				m.On("Handle", mock.Anything, todo.BulkInput{Operations: []todo.BulkOperation{}}).
+					Return(todo.BulkOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			requestBody:    `{"operations":[]}`,
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should run the operations and report each result",
+			bulk: func() *todoBulkMock {
+				m := new(todoBulkMock)
+				m.On("Handle", mock.Anything, todo.BulkInput{
+					Operations: []todo.BulkOperation{
+						{Action: todo.BulkActionCreate, Create: todo.CreateInput{
+							Title: "new", Priority: domain.TodoPriorityHigh, Tags: []string{"work"},
+						}},
+						{Action: todo.BulkActionUpdate, Update: todo.UpdateInput{ID: "2", Title: "renamed", Versions: []int{version}}},
+						{Action: todo.BulkActionComplete, Complete: todo.CompleteInput{ID: "3", OpenItems: todo.OpenItemsCascade}},
+						{Action: todo.BulkActionPending, MarkAsPending: todo.MarkAsPendingInput{ID: "4"}},
+						{Action: todo.BulkActionDelete, Delete: todo.DeleteByIDInput{ID: "5"}},
+					},
+					Atomic: true,
+				}).Return(todo.BulkOutput{Results: []todo.BulkResult{
+					{Action: todo.BulkActionCreate, Todo: &todo.TodoOutput{
+						ID: "1", Title: "new", Status: "pending", Priority: "high", Tags: []string{"work"},
+						CreatedAt: exampleDate, UpdatedAt: exampleDate, Version: 1,
+					}},
+					{Action: todo.BulkActionUpdate, Err: usecase.NewError("rolled back: operation 4 failed", nil,
+						usecase.ErrorTypeFailedDependency)},
+					{Action: todo.BulkActionComplete, Err: usecase.NewError("todo not found with id 3", nil,
+						usecase.ErrorTypeNotFound)},
+					{Action: todo.BulkActionPending, Err: assert.AnError},
+					{Action: todo.BulkActionDelete},
+				}}, nil).Once()
+				return m
+			}(),
+			query: "atomic=true",
+			requestBody: `{"operations":[` +
+				`{"op":"create","title":"new","priority":"high","tags":["work"]},` +
+				`{"op":"update","id":"2","title":"renamed","version":2},` +
+				`{"op":"complete","id":"3","open_items":"cascade"},` +
+				`{"op":"pending","id":"4"},` +
+				`{"op":"delete","id":"5"}]}`,
+			responseBody: `{"results":[` +
+				`{"op":"create","status":201,"todo":{"id":"1","title":"new","description":"","status":"pending","priority":"high","tags":["work"],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z","version":1}},` +
+				`{"op":"update","status":424,"error":"rolled back: operation 4 failed"},` +
+				`{"op":"complete","status":404,"error":"todo not found with id 3"},` +
+				`{"op":"pending","status":500,"error":"internal server error"},` +
+				`{"op":"delete","status":204}]}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/?"+tc.query, strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			h := handler.NewTodoBulk(tc.bulk)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseBody, strings.Trim(rec.Body.String(), "\n"))
+			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			tc.bulk.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoBulk_Path(t *testing.T) {
+	h := handler.NewTodoBulk(new(todoBulkMock))
+	assert.Equal(t, "/todos/bulk", h.Path())
+}
+
+func TestTodoBulk_Method(t *testing.T) {
+	h := handler.NewTodoBulk(new(todoBulkMock))
+	assert.Equal(t, http.MethodPost, h.Method())
+}
+
+type todoBulkMock struct {
+	mock.Mock
+}
+
+func (m *todoBulkMock) Handle(ctx context.Context, input todo.BulkInput) (todo.BulkOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.BulkOutput), args.Error(1)
+}
@@ -18,23 +18,36 @@ func NewTodoComplete(complete todo.Complete) *TodoComplete {
 }
 
 // @Summary Mark a todo as completed
-// @Description Mark an existing todo item as completed
+// @Description Mark an existing todo item as completed, an alias of a transition to completed.
+// @Description With open_items=refuse a todo with open checklist items is not completed, and with
+// @Description open_items=cascade its open items are marked as done too. Completing a recurring todo
+// @Description not completed yet creates its next occurrence, due on the first date of its recurrence after now.
 // @Tags todos
 // @Accept json
 // @Produce json
 // @Param id path string true "Todo ID"
+// @Param If-Match header string false "ETag of the todo version being completed"
+// @Param open_items query string false "What to do with open checklist items" Enums(allow, refuse, cascade) default(allow)
 // @Success 200 {object} todoOutput
+// @Header 200 {string} ETag "Version of the completed todo"
+// @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Router /todos/{id}/complete [post]
 func (h *TodoComplete) Handle(c echo.Context) error {
 	id := c.Param("id")
-	output, err := h.complete.Handle(c.Request().Context(), todo.CompleteInput{
-		ID: id,
+	output, err := ifMatch(c, func(versions []int) (todo.TodoOutput, error) {
+		return h.complete.Handle(c.Request().Context(), todo.CompleteInput{
+			ID:        id,
+			OpenItems: todo.OpenItemsPolicy(c.QueryParam("open_items")),
+			Versions:  versions,
+		})
 	})
 	if err != nil {
 		return err
 	}
-	return c.JSON(http.StatusOK, todoOutputFromUsecase(output))
+	return todoResponse(c, http.StatusOK, output)
 }
 
 func (h *TodoComplete) Path() string {
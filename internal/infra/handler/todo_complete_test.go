@@ -20,6 +20,7 @@ func TestTodoComplete_Handle(t *testing.T) {
 	testCases := []struct {
 		name           string
 		complete       *todoCompleteMock
+		query          string
 		responseBody   string
 		responseStatus int
 		err            error
@@ -37,6 +38,29 @@ func TestTodoComplete_Handle(t *testing.T) {
 			responseStatus: http.StatusOK,
 			err:            usecase.NewError("todo not found", assert.AnError, usecase.ErrorTypeNotFound),
 		},
+		{
+			name: "should complete a todo with its open items",
+			complete: func() *todoCompleteMock {
+				m := new(todoCompleteMock)
+				m.On("Handle", mock.Anything, todo.CompleteInput{
+					ID:        "123",
+					OpenItems: todo.OpenItemsCascade,
+				}).Return(todo.TodoOutput{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "completed",
+					Priority:  "none",
+					Items:     []todo.ChecklistItemOutput{{ID: "1", Title: "first step", Done: true}},
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDate,
+				}, nil).Once()
+				return m
+			}(),
+			query:          "?open_items=cascade",
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"completed","priority":"none","tags":[],"items":[{"id":"1","title":"first step","done":true}],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
 		{
 			name: "should complete a todo",
 			complete: func() *todoCompleteMock {
@@ -48,12 +72,13 @@ func TestTodoComplete_Handle(t *testing.T) {
 					Title:       "example title",
 					Description: "example description",
 					Status:      "completed",
+					Priority:    "none",
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
 				}, nil).Once()
 				return m
 			}(),
-			responseBody:   `{"id":"123","title":"example title","description":"example description","status":"completed","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseBody:   `{"id":"123","title":"example title","description":"example description","status":"completed","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
 			responseStatus: http.StatusOK,
 			err:            nil,
 		},
@@ -61,7 +86,7 @@ func TestTodoComplete_Handle(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req := httptest.NewRequest(http.MethodPost, "/"+tc.query, nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			c.SetPath("/todos/:id/complete")
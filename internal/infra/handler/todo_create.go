@@ -7,12 +7,16 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
 type (
 	todoCreateInput struct {
 		Title       string     `json:"title"`
 		Description string     `json:"description"`
+		Priority    string     `json:"priority,omitempty" enums:"none,low,medium,high,urgent"`
+		Tags        []string   `json:"tags,omitempty"`
+		Recurrence  string     `json:"recurrence,omitempty" example:"FREQ=WEEKLY;BYDAY=MO,TH"`
 		DueDate     *time.Time `json:"due_date,omitempty"`
 	}
 	TodoCreate struct {
@@ -25,7 +29,8 @@ func NewTodoCreate(create todo.Create) *TodoCreate {
 }
 
 // @Summary Create a todo
-// @Description Create a new todo item
+// @Description Create a new todo item. The optional recurrence is a subset of an RFC 5545 RRULE
+// @Description (FREQ=DAILY, WEEKLY or MONTHLY with INTERVAL, BYDAY, COUNT and UNTIL).
 // @Tags todos
 // @Accept json
 // @Produce json
@@ -39,15 +44,23 @@ func (h *TodoCreate) Handle(c echo.Context) error {
 	if err := c.Bind(&input); err != nil {
 		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
 	}
-	output, err := h.create.Handle(c.Request().Context(), todo.CreateInput{
+	output, err := h.create.Handle(c.Request().Context(), createInputFromRequest(input))
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusCreated, output)
+}
+
+// createInputFromRequest converts the request body of a todo creation to the usecase input
+func createInputFromRequest(input todoCreateInput) todo.CreateInput {
+	return todo.CreateInput{
 		Title:       input.Title,
 		Description: input.Description,
+		Priority:    domain.TodoPriority(input.Priority),
+		Tags:        input.Tags,
+		Recurrence:  input.Recurrence,
 		DueDate:     input.DueDate,
-	})
-	if err != nil {
-		return err
 	}
-	return c.JSON(http.StatusCreated, todoOutputFromUsecase(output))
 }
 
 func (h *TodoCreate) Path() string {
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
 	"github.com/wellingtonlope/todo-api/internal/infra/handler"
 )
 
@@ -64,18 +65,24 @@ func TestTodoCreate_Handle(t *testing.T) {
 				m.On("Handle", mock.Anything, todo.CreateInput{
 					Title:       "example title",
 					Description: "example description",
+					Priority:    domain.TodoPriorityHigh,
+					Tags:        []string{"Work", "home"},
+					Recurrence:  "freq=weekly",
 				}).Return(todo.TodoOutput{
 					ID:          "123",
 					Title:       "example title",
 					Description: "example description",
 					Status:      "pending",
+					Priority:    "high",
+					Tags:        []string{"home", "work"},
+					Recurrence:  "FREQ=WEEKLY",
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
 				}, nil).Once()
 				return m
 			}(),
-			requestBody:    `{"title":"example title","description":"example description"}`,
-			responseBody:   `{"id":"123","title":"example title","description":"example description","status":"pending","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			requestBody:    `{"title":"example title","description":"example description","priority":"high","tags":["Work","home"],"recurrence":"freq=weekly"}`,
+			responseBody:   `{"id":"123","title":"example title","description":"example description","status":"pending","priority":"high","tags":["home","work"],"items":[],"recurrence":"FREQ=WEEKLY","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
 			responseStatus: http.StatusCreated,
 			err:            nil,
 		},
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
 )
 
@@ -18,15 +19,31 @@ func NewTodoDeleteByID(deleteByID todo.DeleteByID) *TodoDeleteByID {
 }
 
 // @Summary Delete a todo by ID
-// @Description Delete a todo item by its ID
+// @Description Move a todo item to the trash, from where it can be restored until it is purged.
+// @Description With permanent=true it is deleted for good instead, even from the trash. With an
+// @Description If-Match header the todo is only deleted while it is still at the version of that ETag.
 // @Tags todos
 // @Param id path string true "Todo ID"
+// @Param If-Match header string false "ETag of the todo version being deleted"
+// @Param permanent query bool false "Delete for good instead of moving to the trash" default(false)
 // @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Router /todos/{id} [delete]
 func (h *TodoDeleteByID) Handle(c echo.Context) error {
 	id := c.Param("id")
-	err := h.deleteByID.Handle(c.Request().Context(), id)
+	permanent, err := boolQueryParam(c, "permanent")
+	if err != nil {
+		return usecase.NewError(err.Error(), err, usecase.ErrorTypeBadRequest)
+	}
+	_, err = ifMatch(c, func(versions []int) (struct{}, error) {
+		return struct{}{}, h.deleteByID.Handle(c.Request().Context(), todo.DeleteByIDInput{
+			ID:        id,
+			Versions:  versions,
+			Permanent: permanent,
+		})
+	})
 	if err != nil {
 		return err
 	}
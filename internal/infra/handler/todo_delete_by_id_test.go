@@ -2,6 +2,7 @@ package handler_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
 	"github.com/wellingtonlope/todo-api/internal/infra/handler"
 )
 
@@ -18,6 +20,8 @@ func TestTodoDeleteByID_Handle(t *testing.T) {
 		name           string
 		deleteByID     *todoDeleteByIDMock
 		pathID         string
+		ifMatch        string
+		permanent      string
 		responseStatus int
 		err            error
 	}{
@@ -25,21 +29,64 @@ func TestTodoDeleteByID_Handle(t *testing.T) {
 			name: "should fail when delete use case fails",
 			deleteByID: func() *todoDeleteByIDMock {
 				m := new(todoDeleteByIDMock)
-				m.On("Handle", mock.Anything, "123").Return(usecase.AnError).Once()
+				m.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "123"}).Return(usecase.AnError).Once()
 				return m
 			}(),
 			pathID:         "123",
 			responseStatus: http.StatusOK,
 			err:            usecase.AnError,
 		},
+		{
+			name:           "should fail when If-Match is invalid",
+			deleteByID:     new(todoDeleteByIDMock),
+			pathID:         "123",
+			ifMatch:        "3",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid If-Match header: must be a list of ETags or *",
+				errors.New("invalid If-Match header"), usecase.ErrorTypeBadRequest),
+		},
 		{
 			name: "should delete a todo by id",
 			deleteByID: func() *todoDeleteByIDMock {
 				m := new(todoDeleteByIDMock)
-				m.On("Handle", mock.Anything, "123").Return(nil).Once()
+				m.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "123"}).Return(nil).Once()
+				return m
+			}(),
+			pathID:         "123",
+			responseStatus: http.StatusNoContent,
+			err:            nil,
+		},
+		{
+			name: "should delete a todo at the If-Match version",
+			deleteByID: func() *todoDeleteByIDMock {
+				version := 3
+				m := new(todoDeleteByIDMock)
+				m.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "123", Versions: []int{version}}).Return(nil).Once()
+				return m
+			}(),
+			pathID:         "123",
+			ifMatch:        `"3"`,
+			responseStatus: http.StatusNoContent,
+			err:            nil,
+		},
+		{
+			name:           "should fail when permanent is invalid",
+			deleteByID:     new(todoDeleteByIDMock),
+			pathID:         "123",
+			permanent:      "maybe",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid permanent: must be true or false",
+				errors.New("invalid permanent: must be true or false"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should delete a todo for good",
+			deleteByID: func() *todoDeleteByIDMock {
+				m := new(todoDeleteByIDMock)
+				m.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "123", Permanent: true}).Return(nil).Once()
 				return m
 			}(),
 			pathID:         "123",
+			permanent:      "true",
 			responseStatus: http.StatusNoContent,
 			err:            nil,
 		},
@@ -47,7 +94,14 @@ func TestTodoDeleteByID_Handle(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			target := "/"
+			if tc.permanent != "" {
+				target += "?permanent=" + tc.permanent
+			}
+			req := httptest.NewRequest(http.MethodDelete, target, nil)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			c.SetPath("/todos/:id")
@@ -57,6 +111,7 @@ func TestTodoDeleteByID_Handle(t *testing.T) {
 			err := h.Handle(c)
 			assert.Equal(t, tc.err, err)
 			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			tc.deleteByID.AssertExpectations(t)
 		})
 	}
 }
@@ -75,7 +130,7 @@ type todoDeleteByIDMock struct {
 	mock.Mock
 }
 
-func (m *todoDeleteByIDMock) Handle(ctx context.Context, id string) error {
-	args := m.Called(ctx, id)
+func (m *todoDeleteByIDMock) Handle(ctx context.Context, input todo.DeleteByIDInput) error {
+	args := m.Called(ctx, input)
 	return args.Error(0)
 }
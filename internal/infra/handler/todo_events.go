@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/eventstream"
+)
+
+// EventsHeartbeat is how often a comment is sent on an idle event stream, so that the proxies
+// in between do not close it.
+const EventsHeartbeat = 15 * time.Second
+
+type (
+	todoEventOutput struct {
+		ID         string     `json:"id"`
+		Type       string     `json:"type"`
+		TodoID     string     `json:"todo_id"`
+		Actor      string     `json:"actor"`
+		OccurredAt time.Time  `json:"occurred_at"`
+		Todo       todoOutput `json:"todo"`
+	}
+	TodoEvents struct {
+		stream eventstream.Stream
+	}
+)
+
+func NewTodoEvents(stream eventstream.Stream) *TodoEvents {
+	return &TodoEvents{stream: stream}
+}
+
+// @Summary Stream the todo events
+// @Description Push the todo events (todo.created, todo.updated, todo.completed, todo.reopened and
+// @Description todo.deleted) as Server-Sent Events, each with its number as id, its type as event and
+// @Description a todoEventOutput as data. A client reconnecting with the Last-Event-ID header first gets
+// @Description the events it missed, and is refused one the stream did not send, such as one from before
+// @Description the server restarted, or one so old that some of the events after it are no longer retained.
+// @Description The stream ends when the client falls too far behind, and it can then reconnect the same way.
+// @Tags todos
+// @Produce text/event-stream
+// @Param Last-Event-ID header string false "Number of the last event received, to resume after it"
+// @Param status query string false "Only the events of the todos with this status"
+// @Param project_id query string false "Only the events of the todos of the project with this id"
+// @Success 200 {object} todoEventOutput "Stream of events"
+// @Failure 400 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Router /todos/events [get]
+func (h *TodoEvents) Handle(c echo.Context) error {
+	var lastID uint64
+	if header := c.Request().Header.Get("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "invalid Last-Event-ID: must be the id of an event"})
+		}
+		lastID = id
+	}
+	subscription, err := h.stream.Subscribe(lastID, eventstream.Filter{
+		Status:    c.QueryParam("status"),
+		ProjectID: c.QueryParam("project_id"),
+	})
+	if errors.Is(err, eventstream.ErrUnknownID) {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "unknown Last-Event-ID: reconnect without it"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusServiceUnavailable, ErrorResponse{Message: "the event stream is closed"})
+	}
+	defer subscription.Close()
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	// a write only fails once the client is gone, which ends the stream like a disconnection
+	for _, message := range subscription.Replay {
+		if err := writeTodoEvent(response, message); err != nil {
+			return nil
+		}
+	}
+	response.Flush()
+
+	heartbeat := time.NewTicker(EventsHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case message, ok := <-subscription.Messages:
+			if !ok {
+				return nil
+			}
+			if err := writeTodoEvent(response, message); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(response, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+		}
+		response.Flush()
+	}
+}
+
+// writeTodoEvent writes the message as a Server-Sent Event.
+func writeTodoEvent(response *echo.Response, message eventstream.Message) error {
+	data, err := json.Marshal(todoEventOutputFromDomain(message.Event))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(response, "id: %d\nevent: %s\ndata: %s\n\n", message.ID, message.Event.Type, data)
+	return err
+}
+
+// todoEventOutputFromDomain converts a domain.Event to todoEventOutput
+func todoEventOutputFromDomain(event domain.Event) todoEventOutput {
+	return todoEventOutput{
+		ID:         event.ID,
+		Type:       string(event.Type),
+		TodoID:     event.TodoID,
+		Actor:      event.Actor,
+		OccurredAt: event.OccurredAt,
+		Todo:       todoOutputFromUsecase(todo.TodoOutputFromDomain(event.Todo)),
+	}
+}
+
+func (h *TodoEvents) Path() string {
+	return "/todos/events"
+}
+
+func (h *TodoEvents) Method() string {
+	return http.MethodGet
+}
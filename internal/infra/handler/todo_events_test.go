@@ -0,0 +1,152 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/eventstream"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoEvents_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	created := eventstream.Message{ID: 7, Event: domain.Event{
+		ID: "e7", Type: domain.EventTodoCreated, TodoID: "1", Actor: "alice", OccurredAt: exampleDate,
+		Todo: domain.Todo{
+			ID: "1", Title: "Write report", Status: domain.TodoStatusPending, Priority: domain.TodoPriorityNone,
+			CreatedAt: exampleDate, UpdatedAt: exampleDate,
+		},
+	}}
+	completed := eventstream.Message{ID: 8, Event: domain.Event{
+		ID: "e8", Type: domain.EventTodoCompleted, TodoID: "1", Actor: "bob", OccurredAt: exampleDate,
+		Todo: domain.Todo{
+			ID: "1", Title: "Write report", Status: domain.TodoStatusCompleted, Priority: domain.TodoPriorityNone,
+			CreatedAt: exampleDate, UpdatedAt: exampleDate,
+		},
+	}}
+	testCases := []struct {
+		name           string
+		stream         *todoEventsStreamMock
+		lastEventID    string
+		queryParams    string
+		responseBody   string
+		responseStatus int
+	}{
+		{
+			name:           "should refuse an invalid Last-Event-ID",
+			stream:         new(todoEventsStreamMock),
+			lastEventID:    "e7",
+			responseBody:   `{"message":"invalid Last-Event-ID: must be the id of an event"}` + "\n",
+			responseStatus: http.StatusBadRequest,
+		},
+		{
+			name: "should refuse a Last-Event-ID the stream did not send",
+			stream: func() *todoEventsStreamMock {
+				m := new(todoEventsStreamMock)
+				m.On("Subscribe", uint64(42), eventstream.Filter{}).Return(nil, eventstream.ErrUnknownID).Once()
+				return m
+			}(),
+			lastEventID:    "42",
+			responseBody:   `{"message":"unknown Last-Event-ID: reconnect without it"}` + "\n",
+			responseStatus: http.StatusBadRequest,
+		},
+		{
+			name: "should fail when the stream is closed",
+			stream: func() *todoEventsStreamMock {
+				m := new(todoEventsStreamMock)
+				m.On("Subscribe", uint64(0), eventstream.Filter{}).Return(nil, eventstream.ErrClosed).Once()
+				return m
+			}(),
+			responseBody:   `{"message":"the event stream is closed"}` + "\n",
+			responseStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name: "should replay the missed events and push the new ones until the stream ends",
+			stream: func() *todoEventsStreamMock {
+				messages := make(chan eventstream.Message, 1)
+				messages <- completed
+				close(messages)
+				m := new(todoEventsStreamMock)
+				m.On("Subscribe", uint64(6), eventstream.Filter{Status: "completed", ProjectID: "p1"}).
+					Return(&eventstream.Subscription{Replay: []eventstream.Message{created}, Messages: messages}, nil).Once()
+				return m
+			}(),
+			lastEventID: "6",
+			queryParams: "?status=completed&project_id=p1",
+			responseBody: "id: 7\nevent: todo.created\ndata: " +
+				`{"id":"e7","type":"todo.created","todo_id":"1","actor":"alice","occurred_at":"2024-01-01T00:00:00Z",` +
+				`"todo":{"id":"1","title":"Write report","description":"","status":"pending","priority":"none","tags":[],"items":[],` +
+				`"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}}` + "\n\n" +
+				"id: 8\nevent: todo.completed\ndata: " +
+				`{"id":"e8","type":"todo.completed","todo_id":"1","actor":"bob","occurred_at":"2024-01-01T00:00:00Z",` +
+				`"todo":{"id":"1","title":"Write report","description":"","status":"completed","priority":"none","tags":[],"items":[],` +
+				`"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}}` + "\n\n",
+			responseStatus: http.StatusOK,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/"+tc.queryParams, nil)
+			if tc.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tc.lastEventID)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			h := handler.NewTodoEvents(tc.stream)
+			err := h.Handle(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.responseStatus, rec.Code)
+			assert.Equal(t, tc.responseBody, rec.Body.String())
+			tc.stream.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoEvents_Handle_Disconnect(t *testing.T) {
+	e := echo.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	stream := new(todoEventsStreamMock)
+	stream.On("Subscribe", uint64(0), eventstream.Filter{}).
+		Return(&eventstream.Subscription{Messages: make(chan eventstream.Message)}, nil).Once()
+
+	cancel()
+	err := handler.NewTodoEvents(stream).Handle(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestTodoEvents_Path(t *testing.T) {
+	h := handler.NewTodoEvents(new(todoEventsStreamMock))
+	assert.Equal(t, "/todos/events", h.Path())
+}
+
+func TestTodoEvents_Method(t *testing.T) {
+	h := handler.NewTodoEvents(new(todoEventsStreamMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type todoEventsStreamMock struct {
+	mock.Mock
+}
+
+func (m *todoEventsStreamMock) Subscribe(lastID uint64, filter eventstream.Filter) (*eventstream.Subscription, error) {
+	args := m.Called(lastID, filter)
+	subscription, _ := args.Get(0).(*eventstream.Subscription)
+	return subscription, args.Error(1)
+}
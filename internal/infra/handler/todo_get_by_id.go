@@ -23,6 +23,7 @@ func NewTodoGetByID(getByID todo.GetByID) *TodoGetByID {
 // @Produce json
 // @Param id path string true "Todo ID"
 // @Success 200 {object} todoOutput
+// @Header 200 {string} ETag "Version of the todo, to send as If-Match on updates"
 // @Failure 404 {object} ErrorResponse
 // @Router /todos/{id} [get]
 func (h *TodoGetByID) Handle(c echo.Context) error {
@@ -31,7 +32,7 @@ func (h *TodoGetByID) Handle(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	return c.JSON(http.StatusOK, todoOutputFromUsecase(output))
+	return todoResponse(c, http.StatusOK, output)
 }
 
 func (h *TodoGetByID) Path() string {
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	TodoHistory struct {
+		history todo.History
+	}
+	statusChangeOutput struct {
+		From      string    `json:"from,omitempty"`
+		To        string    `json:"to"`
+		ChangedAt time.Time `json:"changed_at"`
+	}
+)
+
+func NewTodoHistory(history todo.History) *TodoHistory {
+	return &TodoHistory{history: history}
+}
+
+// @Summary Get the status history of a todo
+// @Description Retrieve every status change of a todo, the oldest first; the first one, without from, is its creation
+// @Tags todos
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Success 200 {array} statusChangeOutput
+// @Failure 404 {object} ErrorResponse
+// @Router /todos/{id}/history [get]
+func (h *TodoHistory) Handle(c echo.Context) error {
+	outputs, err := h.history.Handle(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return err
+	}
+	changes := make([]statusChangeOutput, 0, len(outputs))
+	for _, output := range outputs {
+		changes = append(changes, statusChangeOutput{
+			From:      output.From,
+			To:        output.To,
+			ChangedAt: output.ChangedAt,
+		})
+	}
+	return c.JSON(http.StatusOK, changes)
+}
+
+func (h *TodoHistory) Path() string {
+	return "/todos/:id/history"
+}
+
+func (h *TodoHistory) Method() string {
+	return http.MethodGet
+}
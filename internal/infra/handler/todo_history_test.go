@@ -0,0 +1,92 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoHistory_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	testCases := []struct {
+		name           string
+		history        *todoHistoryMock
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when history use case fails",
+			history: func() *todoHistoryMock {
+				m := new(todoHistoryMock)
+				m.On("Handle", mock.Anything, "123").Return([]todo.StatusChangeOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should return the status changes of the todo",
+			history: func() *todoHistoryMock {
+				m := new(todoHistoryMock)
+				m.On("Handle", mock.Anything, "123").Return([]todo.StatusChangeOutput{
+					{To: "pending", ChangedAt: exampleDate},
+					{From: "pending", To: "completed", ChangedAt: exampleDateUpdated},
+				}, nil).Once()
+				return m
+			}(),
+			responseBody:   `[{"to":"pending","changed_at":"2024-01-01T00:00:00Z"},{"from":"pending","to":"completed","changed_at":"2024-01-02T00:00:00Z"}]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/todos/:id/history")
+			c.SetParamNames("id")
+			c.SetParamValues("123")
+			h := handler.NewTodoHistory(tc.history)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseStatus, rec.Code)
+			if tc.responseBody != "" {
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.history.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoHistory_Path(t *testing.T) {
+	h := handler.NewTodoHistory(new(todoHistoryMock))
+	assert.Equal(t, "/todos/:id/history", h.Path())
+}
+
+func TestTodoHistory_Method(t *testing.T) {
+	h := handler.NewTodoHistory(new(todoHistoryMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type todoHistoryMock struct {
+	mock.Mock
+}
+
+func (m *todoHistoryMock) Handle(ctx context.Context, id string) ([]todo.StatusChangeOutput, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]todo.StatusChangeOutput), args.Error(1)
+}
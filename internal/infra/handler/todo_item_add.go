@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	todoItemAddInput struct {
+		Title string `json:"title"`
+	}
+	TodoItemAdd struct {
+		addItem todo.AddItem
+	}
+)
+
+func NewTodoItemAdd(addItem todo.AddItem) *TodoItemAdd {
+	return &TodoItemAdd{addItem: addItem}
+}
+
+// @Summary Add a checklist item
+// @Description Append an open checklist item to a todo
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param item body todoItemAddInput true "Checklist item data"
+// @Success 201 {object} todoOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /todos/{id}/items [post]
+func (h *TodoItemAdd) Handle(c echo.Context) error {
+	var input todoItemAddInput
+	if err := c.Bind(&input); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	output, err := h.addItem.Handle(c.Request().Context(), todo.AddItemInput{
+		TodoID: c.Param("id"),
+		Title:  input.Title,
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusCreated, output)
+}
+
+func (h *TodoItemAdd) Path() string {
+	return "/todos/:id/items"
+}
+
+func (h *TodoItemAdd) Method() string {
+	return http.MethodPost
+}
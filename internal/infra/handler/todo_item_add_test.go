@@ -0,0 +1,123 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoItemAdd_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		addItem        *todoItemAddMock
+		requestBody    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name:           "should fail when JSON invalid",
+			addItem:        new(todoItemAddMock),
+			requestBody:    "{",
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid JSON input", func() error {
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{"))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, httptest.NewRecorder())
+				var aux any
+				return c.Bind(&aux)
+			}(), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when add item use case fails",
+			addItem: func() *todoItemAddMock {
+				m := new(todoItemAddMock)
+				m.On("Handle", mock.Anything, todo.AddItemInput{TodoID: "123", Title: "buy paint"}).
+					Return(todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			requestBody:    `{"title":"buy paint"}`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should add a checklist item",
+			addItem: func() *todoItemAddMock {
+				m := new(todoItemAddMock)
+				m.On("Handle", mock.Anything, todo.AddItemInput{TodoID: "123", Title: "buy paint"}).
+					Return(todo.TodoOutput{
+						ID:        "123",
+						Title:     "paint the room",
+						Status:    "pending",
+						Priority:  "none",
+						Items:     []todo.ChecklistItemOutput{{ID: "1", Title: "buy paint"}},
+						CreatedAt: exampleDate,
+						UpdatedAt: exampleDate,
+					}, nil).Once()
+				return m
+			}(),
+			requestBody:    `{"title":"buy paint"}`,
+			responseBody:   `{"id":"123","title":"paint the room","description":"","status":"pending","priority":"none","tags":[],"items":[{"id":"1","title":"buy paint","done":false}],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusCreated,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/todos/:id/items")
+			c.SetParamNames("id")
+			c.SetParamValues("123")
+
+			h := handler.NewTodoItemAdd(tc.addItem)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.addItem.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoItemAdd_Path(t *testing.T) {
+	h := handler.NewTodoItemAdd(new(todoItemAddMock))
+	assert.Equal(t, "/todos/:id/items", h.Path())
+}
+
+func TestTodoItemAdd_Method(t *testing.T) {
+	h := handler.NewTodoItemAdd(new(todoItemAddMock))
+	assert.Equal(t, http.MethodPost, h.Method())
+}
+
+type todoItemAddMock struct {
+	mock.Mock
+}
+
+func (m *todoItemAddMock) Handle(ctx context.Context, input todo.AddItemInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	TodoItemRemove struct {
+		removeItem todo.RemoveItem
+	}
+)
+
+func NewTodoItemRemove(removeItem todo.RemoveItem) *TodoItemRemove {
+	return &TodoItemRemove{removeItem: removeItem}
+}
+
+// @Summary Remove a checklist item
+// @Description Remove a checklist item from a todo, keeping the order of the others
+// @Tags todos
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param item_id path string true "Checklist item ID"
+// @Success 200 {object} todoOutput
+// @Failure 404 {object} ErrorResponse
+// @Router /todos/{id}/items/{item_id} [delete]
+func (h *TodoItemRemove) Handle(c echo.Context) error {
+	output, err := h.removeItem.Handle(c.Request().Context(), todo.RemoveItemInput{
+		TodoID: c.Param("id"),
+		ItemID: c.Param("item_id"),
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusOK, output)
+}
+
+func (h *TodoItemRemove) Path() string {
+	return "/todos/:id/items/:item_id"
+}
+
+func (h *TodoItemRemove) Method() string {
+	return http.MethodDelete
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	todoItemReorderInput struct {
+		ItemIDs []string `json:"item_ids"`
+	}
+	TodoItemReorder struct {
+		reorderItems todo.ReorderItems
+	}
+)
+
+func NewTodoItemReorder(reorderItems todo.ReorderItems) *TodoItemReorder {
+	return &TodoItemReorder{reorderItems: reorderItems}
+}
+
+// @Summary Reorder checklist items
+// @Description Put the checklist items of a todo in the given order. The ids must list
+// @Description every item of the todo exactly once.
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param order body todoItemReorderInput true "Checklist item ids in the new order"
+// @Success 200 {object} todoOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /todos/{id}/items/order [put]
+func (h *TodoItemReorder) Handle(c echo.Context) error {
+	var input todoItemReorderInput
+	if err := c.Bind(&input); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	output, err := h.reorderItems.Handle(c.Request().Context(), todo.ReorderItemsInput{
+		TodoID:  c.Param("id"),
+		ItemIDs: input.ItemIDs,
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusOK, output)
+}
+
+func (h *TodoItemReorder) Path() string {
+	return "/todos/:id/items/order"
+}
+
+func (h *TodoItemReorder) Method() string {
+	return http.MethodPut
+}
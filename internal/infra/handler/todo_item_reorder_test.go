@@ -0,0 +1,123 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoItemReorder_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		reorderItems   *todoItemReorderMock
+		requestBody    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name:           "should fail when JSON invalid",
+			reorderItems:   new(todoItemReorderMock),
+			requestBody:    "{",
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid JSON input", func() error {
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{"))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				c := e.NewContext(req, httptest.NewRecorder())
+				var aux any
+				return c.Bind(&aux)
+			}(), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when reorder items use case fails",
+			reorderItems: func() *todoItemReorderMock {
+				m := new(todoItemReorderMock)
+				m.On("Handle", mock.Anything, todo.ReorderItemsInput{TodoID: "123", ItemIDs: []string{"2", "1"}}).
+					Return(todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			requestBody:    `{"item_ids":["2","1"]}`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should reorder the checklist items",
+			reorderItems: func() *todoItemReorderMock {
+				m := new(todoItemReorderMock)
+				m.On("Handle", mock.Anything, todo.ReorderItemsInput{TodoID: "123", ItemIDs: []string{"2", "1"}}).
+					Return(todo.TodoOutput{
+						ID:        "123",
+						Title:     "paint the room",
+						Status:    "pending",
+						Priority:  "none",
+						Items:     []todo.ChecklistItemOutput{{ID: "2", Title: "paint"}, {ID: "1", Title: "buy paint"}},
+						CreatedAt: exampleDate,
+						UpdatedAt: exampleDate,
+					}, nil).Once()
+				return m
+			}(),
+			requestBody:    `{"item_ids":["2","1"]}`,
+			responseBody:   `{"id":"123","title":"paint the room","description":"","status":"pending","priority":"none","tags":[],"items":[{"id":"2","title":"paint","done":false},{"id":"1","title":"buy paint","done":false}],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/todos/:id/items/order")
+			c.SetParamNames("id")
+			c.SetParamValues("123")
+
+			h := handler.NewTodoItemReorder(tc.reorderItems)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.reorderItems.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoItemReorder_Path(t *testing.T) {
+	h := handler.NewTodoItemReorder(new(todoItemReorderMock))
+	assert.Equal(t, "/todos/:id/items/order", h.Path())
+}
+
+func TestTodoItemReorder_Method(t *testing.T) {
+	h := handler.NewTodoItemReorder(new(todoItemReorderMock))
+	assert.Equal(t, http.MethodPut, h.Method())
+}
+
+type todoItemReorderMock struct {
+	mock.Mock
+}
+
+func (m *todoItemReorderMock) Handle(ctx context.Context, input todo.ReorderItemsInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
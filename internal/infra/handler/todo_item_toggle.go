@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	TodoItemToggle struct {
+		toggleItem todo.ToggleItem
+	}
+)
+
+func NewTodoItemToggle(toggleItem todo.ToggleItem) *TodoItemToggle {
+	return &TodoItemToggle{toggleItem: toggleItem}
+}
+
+// @Summary Toggle a checklist item
+// @Description Mark an open checklist item as done, or a done one as open
+// @Tags todos
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param item_id path string true "Checklist item ID"
+// @Success 200 {object} todoOutput
+// @Failure 404 {object} ErrorResponse
+// @Router /todos/{id}/items/{item_id}/toggle [post]
+func (h *TodoItemToggle) Handle(c echo.Context) error {
+	output, err := h.toggleItem.Handle(c.Request().Context(), todo.ToggleItemInput{
+		TodoID: c.Param("id"),
+		ItemID: c.Param("item_id"),
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusOK, output)
+}
+
+func (h *TodoItemToggle) Path() string {
+	return "/todos/:id/items/:item_id/toggle"
+}
+
+func (h *TodoItemToggle) Method() string {
+	return http.MethodPost
+}
@@ -0,0 +1,103 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoItemToggle_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		toggleItem     *todoItemToggleMock
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when toggle item use case fails",
+			toggleItem: func() *todoItemToggleMock {
+				m := new(todoItemToggleMock)
+				m.On("Handle", mock.Anything, todo.ToggleItemInput{TodoID: "123", ItemID: "1"}).
+					Return(todo.TodoOutput{}, usecase.NewError("checklist item not found with id 1", assert.AnError,
+						usecase.ErrorTypeNotFound)).Once()
+				return m
+			}(),
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.NewError("checklist item not found with id 1", assert.AnError, usecase.ErrorTypeNotFound),
+		},
+		{
+			name: "should toggle a checklist item",
+			toggleItem: func() *todoItemToggleMock {
+				m := new(todoItemToggleMock)
+				m.On("Handle", mock.Anything, todo.ToggleItemInput{TodoID: "123", ItemID: "1"}).
+					Return(todo.TodoOutput{
+						ID:        "123",
+						Title:     "paint the room",
+						Status:    "pending",
+						Priority:  "none",
+						Items:     []todo.ChecklistItemOutput{{ID: "1", Title: "buy paint", Done: true}},
+						CreatedAt: exampleDate,
+						UpdatedAt: exampleDate,
+					}, nil).Once()
+				return m
+			}(),
+			responseBody:   `{"id":"123","title":"paint the room","description":"","status":"pending","priority":"none","tags":[],"items":[{"id":"1","title":"buy paint","done":true}],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/todos/:id/items/:item_id/toggle")
+			c.SetParamNames("id", "item_id")
+			c.SetParamValues("123", "1")
+
+			h := handler.NewTodoItemToggle(tc.toggleItem)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestTodoItemToggle_Path(t *testing.T) {
+	h := handler.NewTodoItemToggle(new(todoItemToggleMock))
+	assert.Equal(t, "/todos/:id/items/:item_id/toggle", h.Path())
+}
+
+func TestTodoItemToggle_Method(t *testing.T) {
+	h := handler.NewTodoItemToggle(new(todoItemToggleMock))
+	assert.Equal(t, http.MethodPost, h.Method())
+}
+
+type todoItemToggleMock struct {
+	mock.Mock
+}
+
+func (m *todoItemToggleMock) Handle(ctx context.Context, input todo.ToggleItemInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
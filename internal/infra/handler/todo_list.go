@@ -1,7 +1,11 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
@@ -9,6 +13,10 @@ import (
 )
 
 type (
+	todoListOutput struct {
+		Data       []todoOutput `json:"data"`
+		NextCursor string       `json:"next_cursor,omitempty"`
+	}
 	TodoList struct {
 		list todo.List
 	}
@@ -19,32 +27,160 @@ func NewTodoList(list todo.List) *TodoList {
 }
 
 // @Summary List todos
-// @Description Retrieve todo items with optional status filter
+// @Description Retrieve todo items with optional status, due date, creation and update filters and ordering.
+// @Description Todos without a due date are always placed last when sorting by due_date.
+// @Description Sorting by priority follows importance, from none to urgent.
+// @Description When limit or cursor is given the response is a page envelope, otherwise a bare array of every todo.
 // @Tags todos
 // @Produce json
-// @Param status query string false "Filter by status (pending or completed)"
-// @Success 200 {array} todoOutput
+// @Param status query string false "Filter by status, one of the workflow statuses"
+// @Param priority query string false "Filter by priority (none, low, medium, high or urgent)"
+// @Param tag query []string false "Filter by tag, repeated or comma-separated" collectionFormat(multi)
+// @Param tag_mode query string false "Match any (default) or all of the tags"
+// @Param project_id query string false "Only todos of the project with this id"
+// @Param due_before query string false "Only todos due before this RFC 3339 date"
+// @Param due_after query string false "Only todos due after this RFC 3339 date"
+// @Param overdue query bool false "Only todos not completed whose due date has passed"
+// @Param no_due_date query bool false "Only todos without a due date"
+// @Param created_after query string false "Only todos created after this RFC 3339 date"
+// @Param updated_since query string false "Only todos updated at or after this RFC 3339 date"
+// @Param include_archived query bool false "Also list the archived todos" default(false)
+// @Param sort query string false "Sort field (due_date, created_at, updated_at, title or priority), defaults to created_at"
+// @Param order query string false "Sort direction (asc or desc), defaults to asc"
+// @Param limit query int false "Page size (1-100), enables pagination"
+// @Param cursor query string false "Opaque cursor returned as next_cursor by the previous page"
+// @Success 200 {object} todoListOutput "Page envelope, or a bare todoOutput array when limit and cursor are omitted"
+// @Failure 400 {object} ErrorResponse
 // @Router /todos [get]
 func (h *TodoList) Handle(c echo.Context) error {
-	statusParam := c.QueryParam("status")
+	input, paginated, err := listInputFromQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+	}
+	output, err := h.list.Handle(c.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+	return listResponse(c, output, paginated)
+}
 
+// listInputFromQuery parses the status, filters, sort and pagination of the list query string.
+// It also reports whether the response is paginated, which is when limit or cursor is given.
+func listInputFromQuery(c echo.Context) (todo.ListInput, bool, error) {
 	var status *domain.TodoStatus
-	if statusParam != "" {
+	if statusParam := c.QueryParam("status"); statusParam != "" {
 		s := domain.TodoStatus(statusParam)
-		if !s.IsValid() {
-			return c.JSON(http.StatusBadRequest, ErrorResponse{
-				Message: "invalid status: must be 'pending' or 'completed'",
-			})
-		}
 		status = &s
 	}
 
-	input := todo.ListInput{Status: status}
-	outputs, err := h.list.Handle(c.Request().Context(), input)
+	filter, err := listFilterFromQuery(c)
 	if err != nil {
-		return err
+		return todo.ListInput{}, false, err
+	}
+	filter.Status = status
+
+	params := c.QueryParams()
+	paginated := params.Has("limit") || params.Has("cursor")
+	var limit int
+	if params.Has("limit") {
+		l, err := strconv.Atoi(c.QueryParam("limit"))
+		if err != nil || l < 1 {
+			return todo.ListInput{}, false, fmt.Errorf("invalid limit: must be between 1 and %d", todo.MaxListLimit)
+		}
+		limit = l
+	}
+
+	return todo.ListInput{
+		Filter: filter,
+		Sort: todo.ListSort{
+			Field:     todo.ListSortField(c.QueryParam("sort")),
+			Direction: todo.SortDirection(c.QueryParam("order")),
+		},
+		Limit:  limit,
+		Cursor: c.QueryParam("cursor"),
+	}, paginated, nil
+}
+
+// listResponse writes the listed todos as a page envelope when paginated, otherwise as a bare array.
+func listResponse(c echo.Context, output todo.ListOutput, paginated bool) error {
+	if !paginated {
+		return c.JSON(http.StatusOK, todoOutputsFromUsecase(output.Todos))
+	}
+	return c.JSON(http.StatusOK, todoListOutput{
+		Data:       todoOutputsFromUsecase(output.Todos),
+		NextCursor: output.NextCursor,
+	})
+}
+
+// listFilterFromQuery parses the priority, tag, date and flag filters of the list query string.
+func listFilterFromQuery(c echo.Context) (todo.ListFilter, error) {
+	var filter todo.ListFilter
+	var err error
+	if priority := c.QueryParam("priority"); priority != "" {
+		todoPriority := domain.TodoPriority(priority)
+		filter.Priority = &todoPriority
+	}
+	for _, value := range c.QueryParams()["tag"] {
+		filter.Tags = append(filter.Tags, strings.Split(value, ",")...)
+	}
+	filter.TagMode = todo.TagMatchMode(c.QueryParam("tag_mode"))
+	if projectID := c.QueryParam("project_id"); projectID != "" {
+		filter.ProjectID = &projectID
+	}
+	dates := []struct {
+		param  string
+		target **time.Time
+	}{
+		{"due_before", &filter.DueBefore},
+		{"due_after", &filter.DueAfter},
+		{"created_after", &filter.CreatedAfter},
+		{"updated_since", &filter.UpdatedSince},
+	}
+	for _, d := range dates {
+		if *d.target, err = timeQueryParam(c, d.param); err != nil {
+			return todo.ListFilter{}, err
+		}
+	}
+	flags := []struct {
+		param  string
+		target *bool
+	}{
+		{"overdue", &filter.Overdue},
+		{"no_due_date", &filter.NoDueDate},
+		{"include_archived", &filter.IncludeArchived},
+	}
+	for _, f := range flags {
+		if *f.target, err = boolQueryParam(c, f.param); err != nil {
+			return todo.ListFilter{}, err
+		}
+	}
+	return filter, nil
+}
+
+// timeQueryParam parses an optional RFC 3339 query parameter.
+func timeQueryParam(c echo.Context, name string) (*time.Time, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: must be an RFC 3339 date", name)
+	}
+	return &t, nil
+}
+
+// boolQueryParam parses an optional boolean query parameter.
+func boolQueryParam(c echo.Context, name string) (bool, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: must be true or false", name)
 	}
-	return c.JSON(http.StatusOK, todoOutputsFromUsecase(outputs))
+	return b, nil
 }
 
 func (h *TodoList) Path() string {
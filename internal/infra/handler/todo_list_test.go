@@ -21,6 +21,11 @@ func TestTodoList_Handle(t *testing.T) {
 	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
 	pendingStatus := domain.TodoStatusPending
 	completedStatus := domain.TodoStatusCompleted
+	invalidStatus := domain.TodoStatus("invalid")
+	invalidStatusErr := usecase.NewError("invalid status: must be 'pending' or 'completed'", nil, usecase.ErrorTypeBadRequest)
+	highPriority := domain.TodoPriorityHigh
+	dueBefore, _ := time.Parse(time.DateOnly, "2024-02-01")
+	projectID := "p1"
 
 	testCases := []struct {
 		name           string
@@ -34,7 +39,7 @@ func TestTodoList_Handle(t *testing.T) {
 			name: "should fail when list use case fails",
 			list: func() *todoListMock {
 				m := new(todoListMock)
-				m.On("Handle", mock.Anything, todo.ListInput{}).Return([]todo.TodoOutput{}, usecase.AnError).Once()
+				m.On("Handle", mock.Anything, todo.ListInput{}).Return(todo.ListOutput{}, usecase.AnError).Once()
 				return m
 			}(),
 			queryParams:    "",
@@ -46,20 +51,21 @@ func TestTodoList_Handle(t *testing.T) {
 			name: "should list all todos without filter",
 			list: func() *todoListMock {
 				m := new(todoListMock)
-				m.On("Handle", mock.Anything, todo.ListInput{}).Return([]todo.TodoOutput{
+				m.On("Handle", mock.Anything, todo.ListInput{}).Return(todo.ListOutput{Todos: []todo.TodoOutput{
 					{
 						ID:          "123",
 						Title:       "example title",
 						Description: "example description",
 						Status:      "pending",
+						Priority:    "none",
 						CreatedAt:   exampleDate,
 						UpdatedAt:   exampleDate,
 					},
-				}, nil).Once()
+				}}, nil).Once()
 				return m
 			}(),
 			queryParams:    "",
-			responseBody:   `[{"id":"123","title":"example title","description":"example description","status":"pending","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}]`,
+			responseBody:   `[{"id":"123","title":"example title","description":"example description","status":"pending","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}]`,
 			responseStatus: http.StatusOK,
 			err:            nil,
 		},
@@ -67,19 +73,20 @@ func TestTodoList_Handle(t *testing.T) {
 			name: "should list todos filtered by pending status",
 			list: func() *todoListMock {
 				m := new(todoListMock)
-				m.On("Handle", mock.Anything, todo.ListInput{Status: &pendingStatus}).Return([]todo.TodoOutput{
+				m.On("Handle", mock.Anything, todo.ListInput{Filter: todo.ListFilter{Status: &pendingStatus}}).Return(todo.ListOutput{Todos: []todo.TodoOutput{
 					{
 						ID:        "123",
 						Title:     "pending todo",
 						Status:    "pending",
+						Priority:  "none",
 						CreatedAt: exampleDate,
 						UpdatedAt: exampleDate,
 					},
-				}, nil).Once()
+				}}, nil).Once()
 				return m
 			}(),
 			queryParams:    "?status=pending",
-			responseBody:   `[{"id":"123","title":"pending todo","description":"","status":"pending","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}]`,
+			responseBody:   `[{"id":"123","title":"pending todo","description":"","status":"pending","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}]`,
 			responseStatus: http.StatusOK,
 			err:            nil,
 		},
@@ -87,31 +94,197 @@ func TestTodoList_Handle(t *testing.T) {
 			name: "should list todos filtered by completed status",
 			list: func() *todoListMock {
 				m := new(todoListMock)
-				m.On("Handle", mock.Anything, todo.ListInput{Status: &completedStatus}).Return([]todo.TodoOutput{
+				m.On("Handle", mock.Anything, todo.ListInput{Filter: todo.ListFilter{Status: &completedStatus}}).Return(todo.ListOutput{Todos: []todo.TodoOutput{
 					{
 						ID:        "456",
 						Title:     "completed todo",
 						Status:    "completed",
+						Priority:  "none",
 						CreatedAt: exampleDate,
 						UpdatedAt: exampleDate,
 					},
-				}, nil).Once()
+				}}, nil).Once()
 				return m
 			}(),
 			queryParams:    "?status=completed",
-			responseBody:   `[{"id":"456","title":"completed todo","description":"","status":"completed","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}]`,
+			responseBody:   `[{"id":"456","title":"completed todo","description":"","status":"completed","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}]`,
 			responseStatus: http.StatusOK,
 			err:            nil,
 		},
 		{
-			name: "should fail when status is invalid",
+			name: "should leave the validation of the status to the use case",
 			list: func() *todoListMock {
 				m := new(todoListMock)
-				m.On("Handle", mock.Anything, mock.Anything).Return([]todo.TodoOutput{}, nil).Maybe()
+				m.On("Handle", mock.Anything, todo.ListInput{Filter: todo.ListFilter{Status: &invalidStatus}}).
+					Return(todo.ListOutput{}, invalidStatusErr).Once()
 				return m
 			}(),
 			queryParams:    "?status=invalid",
-			responseBody:   `{"message":"invalid status: must be 'pending' or 'completed'"}`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            invalidStatusErr,
+		},
+		{
+			name: "should return a page envelope when limit is given",
+			list: func() *todoListMock {
+				m := new(todoListMock)
+				m.On("Handle", mock.Anything, todo.ListInput{Limit: 1}).Return(todo.ListOutput{
+					Todos: []todo.TodoOutput{
+						{
+							ID:        "123",
+							Title:     "first todo",
+							Status:    "pending",
+							Priority:  "none",
+							CreatedAt: exampleDate,
+							UpdatedAt: exampleDate,
+						},
+					},
+					NextCursor: "next",
+				}, nil).Once()
+				return m
+			}(),
+			queryParams:    "?limit=1",
+			responseBody:   `{"data":[{"id":"123","title":"first todo","description":"","status":"pending","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}],"next_cursor":"next"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should return a page envelope without next cursor on the last page",
+			list: func() *todoListMock {
+				m := new(todoListMock)
+				m.On("Handle", mock.Anything, todo.ListInput{Cursor: "next"}).
+					Return(todo.ListOutput{Todos: []todo.TodoOutput{}}, nil).Once()
+				return m
+			}(),
+			queryParams:    "?cursor=next",
+			responseBody:   `{"data":[]}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should pass the sort to the list use case",
+			list: func() *todoListMock {
+				m := new(todoListMock)
+				m.On("Handle", mock.Anything, todo.ListInput{
+					Sort: todo.ListSort{Field: todo.ListSortByDueDate, Direction: todo.SortDescending},
+				}).Return(todo.ListOutput{Todos: []todo.TodoOutput{}}, nil).Once()
+				return m
+			}(),
+			queryParams:    "?sort=due_date&order=desc",
+			responseBody:   `[]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should pass the date and flag filters to the list use case",
+			list: func() *todoListMock {
+				m := new(todoListMock)
+				m.On("Handle", mock.Anything, todo.ListInput{
+					Filter: todo.ListFilter{
+						Status:       &pendingStatus,
+						DueBefore:    &dueBefore,
+						DueAfter:     &exampleDate,
+						Overdue:      true,
+						CreatedAfter: &exampleDate,
+						UpdatedSince: &exampleDate,
+					},
+				}).Return(todo.ListOutput{Todos: []todo.TodoOutput{}}, nil).Once()
+				return m
+			}(),
+			queryParams: "?status=pending&due_before=2024-02-01T00:00:00Z&due_after=2024-01-01T00:00:00Z" +
+				"&overdue=true&created_after=2024-01-01T00:00:00Z&updated_since=2024-01-01T00:00:00Z",
+			responseBody:   `[]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should pass the include_archived flag to the list use case",
+			list: func() *todoListMock {
+				m := new(todoListMock)
+				m.On("Handle", mock.Anything, todo.ListInput{
+					Filter: todo.ListFilter{IncludeArchived: true},
+				}).Return(todo.ListOutput{Todos: []todo.TodoOutput{}}, nil).Once()
+				return m
+			}(),
+			queryParams:    "?include_archived=true",
+			responseBody:   `[]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should pass the priority filter and sort to the list use case",
+			list: func() *todoListMock {
+				m := new(todoListMock)
+				m.On("Handle", mock.Anything, todo.ListInput{
+					Filter: todo.ListFilter{Priority: &highPriority},
+					Sort:   todo.ListSort{Field: todo.ListSortByPriority, Direction: todo.SortDescending},
+				}).Return(todo.ListOutput{Todos: []todo.TodoOutput{}}, nil).Once()
+				return m
+			}(),
+			queryParams:    "?priority=high&sort=priority&order=desc",
+			responseBody:   `[]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should pass the repeated and comma-separated tags to the list use case",
+			list: func() *todoListMock {
+				m := new(todoListMock)
+				m.On("Handle", mock.Anything, todo.ListInput{
+					Filter: todo.ListFilter{Tags: []string{"work", "home", "blocked"}, TagMode: todo.TagMatchAll},
+				}).Return(todo.ListOutput{Todos: []todo.TodoOutput{}}, nil).Once()
+				return m
+			}(),
+			queryParams:    "?tag=work&tag=home,blocked&tag_mode=all",
+			responseBody:   `[]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should pass the project filter to the list use case",
+			list: func() *todoListMock {
+				m := new(todoListMock)
+				m.On("Handle", mock.Anything, todo.ListInput{
+					Filter: todo.ListFilter{ProjectID: &projectID},
+				}).Return(todo.ListOutput{Todos: []todo.TodoOutput{
+					{ID: "123", Title: "example title", Status: "pending", Priority: "none", ProjectID: &projectID},
+				}}, nil).Once()
+				return m
+			}(),
+			queryParams:    "?project_id=p1",
+			responseBody:   `[{"id":"123","title":"example title","description":"","status":"pending","priority":"none","tags":[],"items":[],"project_id":"p1","created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name:           "should fail when a date filter is not RFC 3339",
+			list:           new(todoListMock),
+			queryParams:    "?due_before=tomorrow",
+			responseBody:   `{"message":"invalid due_before: must be an RFC 3339 date"}`,
+			responseStatus: http.StatusBadRequest,
+			err:            nil,
+		},
+		{
+			name:           "should fail when a flag filter is not a boolean",
+			list:           new(todoListMock),
+			queryParams:    "?no_due_date=maybe",
+			responseBody:   `{"message":"invalid no_due_date: must be true or false"}`,
+			responseStatus: http.StatusBadRequest,
+			err:            nil,
+		},
+		{
+			name:           "should fail when limit is not a number",
+			list:           new(todoListMock),
+			queryParams:    "?limit=abc",
+			responseBody:   `{"message":"invalid limit: must be between 1 and 100"}`,
+			responseStatus: http.StatusBadRequest,
+			err:            nil,
+		},
+		{
+			name:           "should fail when limit is zero",
+			list:           new(todoListMock),
+			queryParams:    "?limit=0",
+			responseBody:   `{"message":"invalid limit: must be between 1 and 100"}`,
 			responseStatus: http.StatusBadRequest,
 			err:            nil,
 		},
@@ -127,6 +300,7 @@ func TestTodoList_Handle(t *testing.T) {
 			assert.Equal(t, tc.err, err)
 			assert.Equal(t, tc.responseBody, strings.Trim(rec.Body.String(), "\n"))
 			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			tc.list.AssertExpectations(t)
 		})
 	}
 }
@@ -145,7 +319,7 @@ type todoListMock struct {
 	mock.Mock
 }
 
-func (m *todoListMock) Handle(ctx context.Context, input todo.ListInput) ([]todo.TodoOutput, error) {
+func (m *todoListMock) Handle(ctx context.Context, input todo.ListInput) (todo.ListOutput, error) {
 	args := m.Called(ctx, input)
-	return args.Get(0).([]todo.TodoOutput), args.Error(1)
+	return args.Get(0).(todo.ListOutput), args.Error(1)
 }
@@ -18,23 +18,31 @@ func NewTodoMarkPending(markAsPending todo.MarkAsPending) *TodoMarkPending {
 }
 
 // @Summary Mark a todo as pending
-// @Description Mark an existing todo item as pending
+// @Description Mark an existing todo item as pending, an alias of a transition to pending
 // @Tags todos
 // @Accept json
 // @Produce json
 // @Param id path string true "Todo ID"
+// @Param If-Match header string false "ETag of the todo version being marked as pending"
 // @Success 200 {object} todoOutput
+// @Header 200 {string} ETag "Version of the pending todo"
+// @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Router /todos/{id}/pending [post]
 func (h *TodoMarkPending) Handle(c echo.Context) error {
 	id := c.Param("id")
-	output, err := h.markAsPending.Handle(c.Request().Context(), todo.MarkAsPendingInput{
-		ID: id,
+	output, err := ifMatch(c, func(versions []int) (todo.TodoOutput, error) {
+		return h.markAsPending.Handle(c.Request().Context(), todo.MarkAsPendingInput{
+			ID:       id,
+			Versions: versions,
+		})
 	})
 	if err != nil {
 		return err
 	}
-	return c.JSON(http.StatusOK, todoOutputFromUsecase(output))
+	return todoResponse(c, http.StatusOK, output)
 }
 
 func (h *TodoMarkPending) Path() string {
@@ -48,12 +48,13 @@ func TestTodoMarkPending_Handle(t *testing.T) {
 					Title:       "example title",
 					Description: "example description",
 					Status:      "pending",
+					Priority:    "none",
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
 				}, nil).Once()
 				return m
 			}(),
-			responseBody:   `{"id":"123","title":"example title","description":"example description","status":"pending","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseBody:   `{"id":"123","title":"example title","description":"example description","status":"pending","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
 			responseStatus: http.StatusOK,
 			err:            nil,
 		},
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+)
+
+type (
+	todoMoveInput struct {
+		ProjectID *string `json:"project_id"`
+	}
+	TodoMove struct {
+		move project.MoveTodo
+	}
+)
+
+func NewTodoMove(move project.MoveTodo) *TodoMove {
+	return &TodoMove{move: move}
+}
+
+// @Summary Move a todo to a project
+// @Description Put a todo in the project with the given id, or take it out of its project with a null project_id
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param project body todoMoveInput true "Destination project"
+// @Success 200 {object} todoOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /todos/{id}/project [put]
+func (h *TodoMove) Handle(c echo.Context) error {
+	var input todoMoveInput
+	if err := c.Bind(&input); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	output, err := h.move.Handle(c.Request().Context(), project.MoveTodoInput{
+		TodoID:    c.Param("id"),
+		ProjectID: input.ProjectID,
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusOK, output)
+}
+
+func (h *TodoMove) Path() string {
+	return "/todos/:id/project"
+}
+
+func (h *TodoMove) Method() string {
+	return http.MethodPut
+}
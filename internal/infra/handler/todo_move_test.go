@@ -0,0 +1,113 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/project"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoMove_Handle(t *testing.T) {
+	projectID := "p1"
+	testCases := []struct {
+		name           string
+		move           *todoMoveMock
+		requestBody    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when move use case fails",
+			move: func() *todoMoveMock {
+				m := new(todoMoveMock)
+				m.On("Handle", mock.Anything, project.MoveTodoInput{TodoID: "123", ProjectID: &projectID}).
+					Return(todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			requestBody: `{"project_id":"p1"}`,
+			err:         usecase.AnError,
+		},
+		{
+			name: "should move a todo to a project",
+			move: func() *todoMoveMock {
+				m := new(todoMoveMock)
+				m.On("Handle", mock.Anything, project.MoveTodoInput{TodoID: "123", ProjectID: &projectID}).
+					Return(todo.TodoOutput{
+						ID: "123", Title: "example title", Status: "pending", Priority: "none", ProjectID: &projectID,
+					}, nil).Once()
+				return m
+			}(),
+			requestBody:    `{"project_id":"p1"}`,
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"pending","priority":"none","tags":[],"items":[],"project_id":"p1","created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should remove a todo from its project",
+			move: func() *todoMoveMock {
+				m := new(todoMoveMock)
+				m.On("Handle", mock.Anything, project.MoveTodoInput{TodoID: "123"}).
+					Return(todo.TodoOutput{ID: "123", Title: "example title", Status: "pending", Priority: "none"}, nil).
+					Once()
+				return m
+			}(),
+			requestBody:    `{"project_id":null}`,
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"pending","priority":"none","tags":[],"items":[],"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/todos/:id/project")
+			c.SetParamNames("id")
+			c.SetParamValues("123")
+
+			h := handler.NewTodoMove(tc.move)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.move.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoMove_Path(t *testing.T) {
+	h := handler.NewTodoMove(new(todoMoveMock))
+	assert.Equal(t, "/todos/:id/project", h.Path())
+}
+
+func TestTodoMove_Method(t *testing.T) {
+	h := handler.NewTodoMove(new(todoMoveMock))
+	assert.Equal(t, http.MethodPut, h.Method())
+}
+
+type todoMoveMock struct {
+	mock.Mock
+}
+
+func (m *todoMoveMock) Handle(ctx context.Context, input project.MoveTodoInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/pkg/jsonpatch"
+)
+
+const (
+	mimeMergePatchJSON = "application/merge-patch+json"
+	mimeJSONPatchJSON  = "application/json-patch+json"
+)
+
+var errUnsupportedPatch = errors.New("unsupported patch content type")
+
+type (
+	// todoPatchInput is a JSON Merge Patch of a todo: absent fields are left untouched
+	// and null clears a field.
+	todoPatchInput struct {
+		Title       *string    `json:"title,omitempty"`
+		Description *string    `json:"description,omitempty"`
+		Priority    *string    `json:"priority,omitempty" enums:"none,low,medium,high,urgent"`
+		Tags        *[]string  `json:"tags,omitempty"`
+		Recurrence  *string    `json:"recurrence,omitempty" example:"FREQ=WEEKLY;BYDAY=MO,TH"`
+		DueDate     *time.Time `json:"due_date,omitempty"`
+	}
+	TodoPatch struct {
+		patch     todo.Patch
+		jsonPatch todo.JSONPatch
+	}
+)
+
+func NewTodoPatch(patch todo.Patch, jsonPatch todo.JSONPatch) *TodoPatch {
+	return &TodoPatch{patch: patch, jsonPatch: jsonPatch}
+}
+
+// @Summary Patch a todo
+// @Description Change some fields of a todo. With application/merge-patch+json the body is a
+// @Description JSON Merge Patch (RFC 7396): absent fields are left untouched and null clears a field,
+// @Description such as "due_date": null. With application/json-patch+json the body is a JSON Patch
+// @Description (RFC 6902) with add, remove, replace and test operations on the todo as returned by the API;
+// @Description title, description, status, priority, tags, recurrence and due_date can be changed, and a
+// @Description failed test returns 409. With an If-Match header the todo is only patched while it is
+// @Description still at the version of that ETag.
+// @Tags todos
+// @Accept application/merge-patch+json
+// @Accept application/json-patch+json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param If-Match header string false "ETag of the todo version being patched"
+// @Param patch body todoPatchInput true "Fields to change, or a JSON Patch array of operations"
+// @Success 200 {object} todoOutput
+// @Header 200 {string} ETag "Version of the patched todo"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Router /todos/{id} [patch]
+func (h *TodoPatch) Handle(c echo.Context) error {
+	id := c.Param("id")
+	contentType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if contentType != mimeMergePatchJSON && contentType != mimeJSONPatchJSON && contentType != echo.MIMEApplicationJSON {
+		return usecase.NewError("invalid content type: must be "+mimeMergePatchJSON+" or "+mimeJSONPatchJSON,
+			errUnsupportedPatch, usecase.ErrorTypeBadRequest)
+	}
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	output, err := ifMatch(c, func(versions []int) (todo.TodoOutput, error) {
+		if contentType == mimeJSONPatchJSON {
+			return h.handleJSONPatch(c, id, versions, body)
+		}
+		return h.handleMergePatch(c, id, versions, body)
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusOK, output)
+}
+
+func (h *TodoPatch) handleJSONPatch(c echo.Context, id string, versions []int, body []byte) (todo.TodoOutput, error) {
+	var operations []jsonpatch.Operation
+	if err := json.Unmarshal(body, &operations); err != nil {
+		return todo.TodoOutput{}, usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	return h.jsonPatch.Handle(c.Request().Context(), todo.JSONPatchInput{
+		ID:         id,
+		Operations: operations,
+		Versions:   versions,
+	})
+}
+
+func (h *TodoPatch) handleMergePatch(c echo.Context, id string, versions []int, body []byte) (todo.TodoOutput, error) {
+	input, err := mergePatchInput(body)
+	if err != nil {
+		return todo.TodoOutput{}, usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	input.ID = id
+	input.Versions = versions
+	return h.patch.Handle(c.Request().Context(), input)
+}
+
+// mergePatchInput reads a merge patch, telling the fields set to null, which are cleared,
+// from the absent ones, which are left untouched.
+func mergePatchInput(body []byte) (todo.PatchInput, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return todo.PatchInput{}, err
+	}
+	var patch todoPatchInput
+	if err := json.Unmarshal(body, &patch); err != nil {
+		return todo.PatchInput{}, err
+	}
+	input := todo.PatchInput{
+		Title:       patch.Title,
+		Description: patch.Description,
+		Tags:        patch.Tags,
+		Recurrence:  patch.Recurrence,
+		DueDate:     patch.DueDate,
+	}
+	if patch.Priority != nil {
+		priority := domain.TodoPriority(*patch.Priority)
+		input.Priority = &priority
+	}
+	isNull := func(name string) bool { return string(fields[name]) == "null" }
+	if isNull("title") {
+		input.Title = new(string)
+	}
+	if isNull("description") {
+		input.Description = new(string)
+	}
+	if isNull("priority") {
+		input.Priority = new(domain.TodoPriority)
+	}
+	if isNull("tags") {
+		input.Tags = new([]string)
+	}
+	if isNull("recurrence") {
+		input.Recurrence = new(string)
+	}
+	input.RemoveDueDate = isNull("due_date")
+	return input, nil
+}
+
+func (h *TodoPatch) Path() string {
+	return "/todos/:id"
+}
+
+func (h *TodoPatch) Method() string {
+	return http.MethodPatch
+}
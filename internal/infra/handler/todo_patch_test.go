@@ -0,0 +1,246 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+	"github.com/wellingtonlope/todo-api/pkg/jsonpatch"
+)
+
+func TestTodoPatch_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	dueDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	title := "example title"
+	high := domain.TodoPriorityHigh
+	version := 2
+	testCases := []struct {
+		name           string
+		patch          *todoPatchMock
+		jsonPatch      *todoJSONPatchMock
+		contentType    string
+		ifMatch        string
+		requestBody    string
+		responseBody   string
+		responseStatus int
+		etag           string
+		err            error
+	}{
+		{
+			name:           "should fail when content type is not a merge patch",
+			patch:          new(todoPatchMock),
+			contentType:    echo.MIMETextPlain,
+			requestBody:    `{"title":"example title"}`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid content type: must be application/merge-patch+json or application/json-patch+json",
+				errors.New("unsupported patch content type"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:           "should fail when the patch is not a JSON object",
+			patch:          new(todoPatchMock),
+			contentType:    "application/merge-patch+json",
+			requestBody:    `["title"]`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid JSON input", func() error {
+				var fields map[string]json.RawMessage
+				return json.Unmarshal([]byte(`["title"]`), &fields)
+			}(), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when patch use case fails",
+			patch: func() *todoPatchMock {
+				m := new(todoPatchMock)
+				m.On("Handle", mock.Anything, todo.PatchInput{ID: "123", Title: &title}).
+					Return(todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			contentType:    "application/merge-patch+json",
+			requestBody:    `{"title":"example title"}`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should patch only the given fields",
+			patch: func() *todoPatchMock {
+				m := new(todoPatchMock)
+				m.On("Handle", mock.Anything, todo.PatchInput{
+					ID:       "123",
+					Priority: &high,
+					DueDate:  &dueDate,
+					Versions: []int{version},
+				}).Return(todo.TodoOutput{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "pending",
+					Priority:  "high",
+					DueDate:   &dueDate,
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDate,
+					Version:   3,
+				}, nil).Once()
+				return m
+			}(),
+			contentType:    "application/merge-patch+json; charset=utf-8",
+			ifMatch:        `"2"`,
+			requestBody:    `{"priority":"high","due_date":"2024-02-01T00:00:00Z","unknown":1}`,
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"pending","priority":"high","tags":[],"items":[],"due_date":"2024-02-01T00:00:00Z","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z","version":3}`,
+			responseStatus: http.StatusOK,
+			etag:           `"3"`,
+			err:            nil,
+		},
+		{
+			name: "should clear the fields set to null",
+			patch: func() *todoPatchMock {
+				m := new(todoPatchMock)
+				m.On("Handle", mock.Anything, todo.PatchInput{
+					ID:            "123",
+					Description:   new(string),
+					Priority:      new(domain.TodoPriority),
+					Tags:          new([]string),
+					Recurrence:    new(string),
+					RemoveDueDate: true,
+				}).Return(todo.TodoOutput{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "pending",
+					Priority:  "none",
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDate,
+				}, nil).Once()
+				return m
+			}(),
+			contentType:    echo.MIMEApplicationJSON,
+			requestBody:    `{"description":null,"priority":null,"tags":null,"recurrence":null,"due_date":null}`,
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"pending","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should apply a JSON patch",
+			jsonPatch: func() *todoJSONPatchMock {
+				m := new(todoJSONPatchMock)
+				m.On("Handle", mock.Anything, todo.JSONPatchInput{
+					ID: "123",
+					Operations: []jsonpatch.Operation{
+						{Op: "test", Path: "/status", Value: json.RawMessage(`"pending"`)},
+						{Op: "replace", Path: "/status", Value: json.RawMessage(`"completed"`)},
+					},
+					Versions: []int{version},
+				}).Return(todo.TodoOutput{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "completed",
+					Priority:  "none",
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDate,
+					Version:   3,
+				}, nil).Once()
+				return m
+			}(),
+			contentType:    "application/json-patch+json",
+			ifMatch:        `"2"`,
+			requestBody:    `[{"op":"test","path":"/status","value":"pending"},{"op":"replace","path":"/status","value":"completed"}]`,
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"completed","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z","version":3}`,
+			responseStatus: http.StatusOK,
+			etag:           `"3"`,
+			err:            nil,
+		},
+		{
+			name: "should fail when JSON patch use case fails",
+			jsonPatch: func() *todoJSONPatchMock {
+				m := new(todoJSONPatchMock)
+				m.On("Handle", mock.Anything, todo.JSONPatchInput{ID: "123", Operations: []jsonpatch.Operation{}}).
+					Return(todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			contentType:    "application/json-patch+json",
+			requestBody:    `[]`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name:           "should fail when the JSON patch is not an array",
+			contentType:    "application/json-patch+json",
+			requestBody:    `{"op":"remove","path":"/tags"}`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid JSON input", func() error {
+				var operations []jsonpatch.Operation
+				return json.Unmarshal([]byte(`{"op":"remove","path":"/tags"}`), &operations)
+			}(), usecase.ErrorTypeBadRequest),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, tc.contentType)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/todos/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("123")
+			if tc.patch == nil {
+				tc.patch = new(todoPatchMock)
+			}
+			if tc.jsonPatch == nil {
+				tc.jsonPatch = new(todoJSONPatchMock)
+			}
+			h := handler.NewTodoPatch(tc.patch, tc.jsonPatch)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseBody, strings.Trim(rec.Body.String(), "\n"))
+			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			assert.Equal(t, tc.etag, rec.Header().Get("ETag"))
+			tc.patch.AssertExpectations(t)
+			tc.jsonPatch.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoPatch_Path(t *testing.T) {
+	h := handler.NewTodoPatch(new(todoPatchMock), new(todoJSONPatchMock))
+	assert.Equal(t, "/todos/:id", h.Path())
+}
+
+func TestTodoPatch_Method(t *testing.T) {
+	h := handler.NewTodoPatch(new(todoPatchMock), new(todoJSONPatchMock))
+	assert.Equal(t, http.MethodPatch, h.Method())
+}
+
+type todoPatchMock struct {
+	mock.Mock
+}
+
+func (m *todoPatchMock) Handle(ctx context.Context, input todo.PatchInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
+
+type todoJSONPatchMock struct {
+	mock.Mock
+}
+
+func (m *todoJSONPatchMock) Handle(ctx context.Context, input todo.JSONPatchInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	TodoRestore struct {
+		restore todo.Restore
+	}
+)
+
+func NewTodoRestore(restore todo.Restore) *TodoRestore {
+	return &TodoRestore{restore: restore}
+}
+
+// @Summary Restore a todo from the trash
+// @Description Take a deleted todo out of the trash, as it was when deleted
+// @Tags todos
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Success 200 {object} todoOutput
+// @Header 200 {string} ETag "Version of the restored todo"
+// @Failure 404 {object} ErrorResponse
+// @Router /todos/{id}/restore [post]
+func (h *TodoRestore) Handle(c echo.Context) error {
+	output, err := h.restore.Handle(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusOK, output)
+}
+
+func (h *TodoRestore) Path() string {
+	return "/todos/:id/restore"
+}
+
+func (h *TodoRestore) Method() string {
+	return http.MethodPost
+}
@@ -0,0 +1,99 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoRestore_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		restore        *todoRestoreMock
+		responseBody   string
+		responseStatus int
+		etag           string
+		err            error
+	}{
+		{
+			name: "should fail when restore use case fails",
+			restore: func() *todoRestoreMock {
+				m := new(todoRestoreMock)
+				m.On("Handle", mock.Anything, "123").Return(todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should restore a todo from the trash",
+			restore: func() *todoRestoreMock {
+				m := new(todoRestoreMock)
+				m.On("Handle", mock.Anything, "123").Return(todo.TodoOutput{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "pending",
+					Priority:  "none",
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDate,
+					Version:   3,
+				}, nil).Once()
+				return m
+			}(),
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"pending","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z","version":3}`,
+			responseStatus: http.StatusOK,
+			etag:           `"3"`,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/todos/:id/restore")
+			c.SetParamNames("id")
+			c.SetParamValues("123")
+			h := handler.NewTodoRestore(tc.restore)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseStatus, rec.Code)
+			if tc.responseBody != "" {
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			assert.Equal(t, tc.etag, rec.Header().Get("ETag"))
+			tc.restore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoRestore_Path(t *testing.T) {
+	h := handler.NewTodoRestore(new(todoRestoreMock))
+	assert.Equal(t, "/todos/:id/restore", h.Path())
+}
+
+func TestTodoRestore_Method(t *testing.T) {
+	h := handler.NewTodoRestore(new(todoRestoreMock))
+	assert.Equal(t, http.MethodPost, h.Method())
+}
+
+type todoRestoreMock struct {
+	mock.Mock
+}
+
+func (m *todoRestoreMock) Handle(ctx context.Context, id string) (todo.TodoOutput, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	TodoSearch struct {
+		search todo.Search
+	}
+)
+
+func NewTodoSearch(search todo.Search) *TodoSearch {
+	return &TodoSearch{search: search}
+}
+
+// @Summary Search todos
+// @Description Full-text search over the titles and descriptions of the todos that are not archived, best
+// @Description matches first. A todo matches when it has a word starting with any word of the query.
+// @Tags todos
+// @Produce json
+// @Param q query string true "Search query"
+// @Param status query string false "Filter by status, one of the workflow statuses"
+// @Param limit query int false "Maximum number of results (1-100), defaults to 20"
+// @Success 200 {array} todoOutput
+// @Failure 400 {object} ErrorResponse
+// @Router /todos/search [get]
+func (h *TodoSearch) Handle(c echo.Context) error {
+	var status *domain.TodoStatus
+	if statusParam := c.QueryParam("status"); statusParam != "" {
+		s := domain.TodoStatus(statusParam)
+		status = &s
+	}
+
+	var limit int
+	if c.QueryParams().Has("limit") {
+		l, err := strconv.Atoi(c.QueryParam("limit"))
+		if err != nil || l < 1 {
+			return c.JSON(http.StatusBadRequest, ErrorResponse{
+				Message: fmt.Sprintf("invalid limit: must be between 1 and %d", todo.MaxListLimit),
+			})
+		}
+		limit = l
+	}
+
+	outputs, err := h.search.Handle(c.Request().Context(), todo.SearchInput{
+		Query:  c.QueryParam("q"),
+		Status: status,
+		Limit:  limit,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, todoOutputsFromUsecase(outputs))
+}
+
+func (h *TodoSearch) Path() string {
+	return "/todos/search"
+}
+
+func (h *TodoSearch) Method() string {
+	return http.MethodGet
+}
@@ -0,0 +1,123 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoSearch_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	completedStatus := domain.TodoStatusCompleted
+	invalidStatus := domain.TodoStatus("invalid")
+	invalidStatusErr := usecase.NewError("invalid status: must be 'pending' or 'completed'", nil, usecase.ErrorTypeBadRequest)
+
+	testCases := []struct {
+		name           string
+		search         *todoSearchMock
+		queryParams    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when search use case fails",
+			search: func() *todoSearchMock {
+				m := new(todoSearchMock)
+				m.On("Handle", mock.Anything, todo.SearchInput{Query: "milk"}).Return([]todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			queryParams:    "?q=milk",
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should return the matching todos",
+			search: func() *todoSearchMock {
+				m := new(todoSearchMock)
+				m.On("Handle", mock.Anything, todo.SearchInput{Query: "milk", Status: &completedStatus, Limit: 5}).Return([]todo.TodoOutput{
+					{
+						ID:        "123",
+						Title:     "buy milk",
+						Status:    "completed",
+						Priority:  "none",
+						CreatedAt: exampleDate,
+						UpdatedAt: exampleDate,
+					},
+				}, nil).Once()
+				return m
+			}(),
+			queryParams:    "?q=milk&status=completed&limit=5",
+			responseBody:   `[{"id":"123","title":"buy milk","description":"","status":"completed","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should leave the validation of the status to the use case",
+			search: func() *todoSearchMock {
+				m := new(todoSearchMock)
+				m.On("Handle", mock.Anything, todo.SearchInput{Query: "milk", Status: &invalidStatus}).
+					Return([]todo.TodoOutput{}, invalidStatusErr).Once()
+				return m
+			}(),
+			queryParams:    "?q=milk&status=invalid",
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            invalidStatusErr,
+		},
+		{
+			name:           "should fail when limit is invalid",
+			search:         new(todoSearchMock),
+			queryParams:    "?q=milk&limit=0",
+			responseBody:   `{"message":"invalid limit: must be between 1 and 100"}`,
+			responseStatus: http.StatusBadRequest,
+			err:            nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/todos/search"+tc.queryParams, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			h := handler.NewTodoSearch(tc.search)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseBody, strings.Trim(rec.Body.String(), "\n"))
+			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			tc.search.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoSearch_Path(t *testing.T) {
+	h := handler.NewTodoSearch(new(todoSearchMock))
+	assert.Equal(t, "/todos/search", h.Path())
+}
+
+func TestTodoSearch_Method(t *testing.T) {
+	h := handler.NewTodoSearch(new(todoSearchMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type todoSearchMock struct {
+	mock.Mock
+}
+
+func (m *todoSearchMock) Handle(ctx context.Context, input todo.SearchInput) ([]todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).([]todo.TodoOutput), args.Error(1)
+}
@@ -0,0 +1,349 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/eventstream"
+)
+
+const (
+	// SocketQueueSize is how many messages can wait to be written to a socket. The events pushed
+	// to a client that lets its queue fill up close its socket, while its own requests wait.
+	SocketQueueSize = 32
+	// SocketPingInterval is how often a socket is pinged, the client being gone when it does not
+	// answer before the next ping.
+	SocketPingInterval = 30 * time.Second
+	// SocketMessageLimit is the largest message a client can send, in bytes.
+	SocketMessageLimit = 64 << 10
+	// socketWriteTimeout is how long writing a message to a socket can take.
+	socketWriteTimeout = 10 * time.Second
+)
+
+const (
+	socketMessageSubscribe   = "subscribe"
+	socketMessageUnsubscribe = "unsubscribe"
+	socketMessageCreate      = "create"
+	socketMessageUpdate      = "update"
+	socketMessageComplete    = "complete"
+	socketMessagePending     = "pending"
+	socketMessageDelete      = "delete"
+	socketMessageResult      = "result"
+	socketMessageError       = "error"
+	socketMessageEvent       = "event"
+)
+
+type (
+	// socketRequest is a message sent by a client, answered by a socketResponse with the same id.
+	socketRequest struct {
+		ID        string           `json:"id"`
+		Type      string           `json:"type" enums:"subscribe,unsubscribe,create,update,complete,pending,delete"`
+		TodoIDs   []string         `json:"todo_ids,omitempty"`
+		TodoID    string           `json:"todo_id,omitempty"`
+		Version   *int             `json:"version,omitempty"`
+		OpenItems string           `json:"open_items,omitempty"`
+		Permanent bool             `json:"permanent,omitempty"`
+		Todo      *todoUpdateInput `json:"todo,omitempty"`
+	}
+	// socketResponse is a message sent to a client: the result or the error of one of its
+	// requests, or an event of a todo it is subscribed to.
+	socketResponse struct {
+		Type    string           `json:"type" enums:"result,error,event"`
+		ID      string           `json:"id,omitempty"`
+		TodoIDs []string         `json:"todo_ids,omitempty"`
+		Todo    *todoOutput      `json:"todo,omitempty"`
+		Error   *socketError     `json:"error,omitempty"`
+		Event   *todoEventOutput `json:"event,omitempty"`
+	}
+	socketError struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}
+	TodoSocket struct {
+		create        todo.Create
+		update        todo.Update
+		complete      todo.Complete
+		markAsPending todo.MarkAsPending
+		deleteByID    todo.DeleteByID
+		stream        eventstream.Stream
+		upgrader      websocket.Upgrader
+	}
+)
+
+func NewTodoSocket(
+	create todo.Create,
+	update todo.Update,
+	complete todo.Complete,
+	markAsPending todo.MarkAsPending,
+	deleteByID todo.DeleteByID,
+	stream eventstream.Stream,
+) *TodoSocket {
+	return &TodoSocket{
+		create:        create,
+		update:        update,
+		complete:      complete,
+		markAsPending: markAsPending,
+		deleteByID:    deleteByID,
+		stream:        stream,
+	}
+}
+
+// @Summary Open a todo WebSocket
+// @Description Upgrade to a WebSocket exchanging JSON messages. A client sends socketRequest messages,
+// @Description each answered by a result or an error socketResponse with the same id: subscribe and
+// @Description unsubscribe change the todo_ids it gets the events of, answering with all of them, while
+// @Description create, update, complete, pending and delete change a todo like the HTTP endpoints, with
+// @Description the todo as input and output. The events of the subscribed todos are pushed as event
+// @Description messages. A client reading too slowly has its requests wait and, once too many events
+// @Description wait for it, its socket closed with the status 1013.
+// @Tags todos
+// @Param X-Actor header string false "Who makes the changes sent on the socket"
+// @Success 101 {object} socketResponse "Switching Protocols"
+// @Failure 503 {object} ErrorResponse
+// @Router /ws [get]
+func (h *TodoSocket) Handle(c echo.Context) error {
+	subscription, err := h.stream.Subscribe(0, eventstream.Filter{})
+	if err != nil {
+		return c.JSON(http.StatusServiceUnavailable, ErrorResponse{Message: "the event stream is closed"})
+	}
+	defer subscription.Close()
+	conn, err := h.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// the upgrader already answered with the error
+		return nil
+	}
+	socket := &todoSocket{
+		handler:  h,
+		conn:     conn,
+		outbound: make(chan socketResponse, SocketQueueSize),
+		done:     make(chan struct{}),
+		written:  make(chan struct{}),
+		todoIDs:  map[string]struct{}{},
+	}
+	socket.serve(c.Request().Context(), subscription)
+	return nil
+}
+
+func (h *TodoSocket) Path() string {
+	return "/ws"
+}
+
+func (h *TodoSocket) Method() string {
+	return http.MethodGet
+}
+
+// todoSocket is the connection of a client: its requests are read and handled one at a time,
+// its messages are written by a single writer and the events are pushed to it by a pump. done is
+// closed once the requests are no longer read and written once the writer is gone.
+type todoSocket struct {
+	handler  *TodoSocket
+	conn     *websocket.Conn
+	outbound chan socketResponse
+	done     chan struct{}
+	written  chan struct{}
+	mu       sync.Mutex
+	todoIDs  map[string]struct{}
+}
+
+// serve handles the requests of the client until it goes away or its socket is closed.
+func (s *todoSocket) serve(ctx context.Context, subscription *eventstream.Subscription) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer close(s.written)
+		s.write()
+	}()
+	go func() {
+		defer wg.Done()
+		s.pump(subscription.Messages)
+	}()
+	s.read(ctx)
+	close(s.done)
+	_ = s.conn.Close()
+	wg.Wait()
+}
+
+// read handles the requests of the client. Their responses wait for room in the queue, so that
+// a client which does not read its messages stops having its requests handled, until the writer
+// is gone.
+func (s *todoSocket) read(ctx context.Context) {
+	s.conn.SetReadLimit(SocketMessageLimit)
+	_ = s.conn.SetReadDeadline(time.Now().Add(2 * SocketPingInterval))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(2 * SocketPingInterval))
+	})
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var request socketRequest
+		var response socketResponse
+		if err := json.Unmarshal(data, &request); err != nil {
+			response = socketErrorResponse("", usecase.NewError("invalid JSON message", err, usecase.ErrorTypeBadRequest))
+		} else {
+			response = s.handle(ctx, request)
+		}
+		select {
+		case s.outbound <- response:
+		case <-s.written:
+			return
+		}
+	}
+}
+
+// handle runs the request through the usecases, returning its result or its error.
+func (s *todoSocket) handle(ctx context.Context, request socketRequest) socketResponse {
+	var output todo.TodoOutput
+	var err error
+	switch request.Type {
+	case socketMessageSubscribe, socketMessageUnsubscribe:
+		return socketResponse{Type: socketMessageResult, ID: request.ID, TodoIDs: s.subscribe(request)}
+	case socketMessageCreate:
+		input := todoUpdateInput{}
+		if request.Todo != nil {
+			input = *request.Todo
+		}
+		output, err = s.handler.create.Handle(ctx, createInputFromRequest(todoCreateInput(input)))
+	case socketMessageUpdate:
+		input := todoUpdateInput{}
+		if request.Todo != nil {
+			input = *request.Todo
+		}
+		output, err = s.handler.update.Handle(ctx, updateInputFromRequest(request.TodoID, input, versionsOf(request.Version)))
+	case socketMessageComplete:
+		output, err = s.handler.complete.Handle(ctx, todo.CompleteInput{
+			ID:        request.TodoID,
+			OpenItems: todo.OpenItemsPolicy(request.OpenItems),
+			Versions:  versionsOf(request.Version),
+		})
+	case socketMessagePending:
+		output, err = s.handler.markAsPending.Handle(ctx, todo.MarkAsPendingInput{
+			ID:       request.TodoID,
+			Versions: versionsOf(request.Version),
+		})
+	case socketMessageDelete:
+		err = s.handler.deleteByID.Handle(ctx, todo.DeleteByIDInput{
+			ID:        request.TodoID,
+			Versions:  versionsOf(request.Version),
+			Permanent: request.Permanent,
+		})
+		if err == nil {
+			return socketResponse{Type: socketMessageResult, ID: request.ID}
+		}
+	default:
+		err = usecase.NewError(fmt.Sprintf("unknown message type %q", request.Type),
+			errors.New("unknown message type"), usecase.ErrorTypeBadRequest)
+	}
+	if err != nil {
+		return socketErrorResponse(request.ID, err)
+	}
+	result := todoOutputFromUsecase(output)
+	return socketResponse{Type: socketMessageResult, ID: request.ID, Todo: &result}
+}
+
+// subscribe adds or removes the todos of the request to the ones the client gets the events of,
+// returning them all in order.
+func (s *todoSocket) subscribe(request socketRequest) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range request.TodoIDs {
+		if request.Type == socketMessageSubscribe {
+			s.todoIDs[id] = struct{}{}
+		} else {
+			delete(s.todoIDs, id)
+		}
+	}
+	ids := make([]string, 0, len(s.todoIDs))
+	for id := range s.todoIDs {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (s *todoSocket) subscribed(todoID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.todoIDs[todoID]
+	return ok
+}
+
+// pump queues the events of the subscribed todos without waiting: the socket of a client too slow
+// to keep up is closed, as is every socket once the stream is closed.
+func (s *todoSocket) pump(messages <-chan eventstream.Message) {
+	for {
+		select {
+		case <-s.done:
+			return
+		case message, ok := <-messages:
+			if !ok {
+				s.close(websocket.CloseGoingAway, "the server is shutting down")
+				return
+			}
+			if !s.subscribed(message.Event.TodoID) {
+				continue
+			}
+			event := todoEventOutputFromDomain(message.Event)
+			select {
+			case s.outbound <- socketResponse{Type: socketMessageEvent, Event: &event}:
+			default:
+				s.close(websocket.CloseTryAgainLater, "too many messages waiting to be read")
+				return
+			}
+		}
+	}
+}
+
+// write writes the queued messages to the socket and pings the client between them.
+func (s *todoSocket) write() {
+	ping := time.NewTicker(SocketPingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case response := <-s.outbound:
+			_ = s.conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout))
+			if err := s.conn.WriteJSON(response); err != nil {
+				_ = s.conn.Close()
+				return
+			}
+		case <-ping.C:
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(socketWriteTimeout)); err != nil {
+				_ = s.conn.Close()
+				return
+			}
+		}
+	}
+}
+
+// close closes the socket with the status code and its reason, which ends the reading of the requests.
+func (s *todoSocket) close(code int, reason string) {
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason),
+		time.Now().Add(socketWriteTimeout))
+	_ = s.conn.Close()
+}
+
+// socketErrorResponse answers the request with the error. Errors that are not usecase errors of
+// a known type are hidden behind an internal error.
+func socketErrorResponse(id string, err error) socketResponse {
+	socketErr := socketError{Type: string(usecase.ErrorTypeInternalError), Message: "internal server error"}
+	if errUC, ok := err.(usecase.Error); ok {
+		if _, known := mapErrorTypeStatus[errUC.Type]; known {
+			socketErr = socketError{Type: string(errUC.Type), Message: errUC.Message}
+		}
+	}
+	return socketResponse{Type: socketMessageError, ID: id, Error: &socketErr}
+}
@@ -0,0 +1,278 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/eventstream"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+type todoSocketMocks struct {
+	create        *todoCreateMock
+	update        *todoUpdateMock
+	complete      *todoCompleteMock
+	markAsPending *todoMarkPendingMock
+	deleteByID    *todoDeleteByIDMock
+}
+
+func newTodoSocketMocks() todoSocketMocks {
+	return todoSocketMocks{
+		create:        new(todoCreateMock),
+		update:        new(todoUpdateMock),
+		complete:      new(todoCompleteMock),
+		markAsPending: new(todoMarkPendingMock),
+		deleteByID:    new(todoDeleteByIDMock),
+	}
+}
+
+func (m todoSocketMocks) assertExpectations(t *testing.T) {
+	m.create.AssertExpectations(t)
+	m.update.AssertExpectations(t)
+	m.complete.AssertExpectations(t)
+	m.markAsPending.AssertExpectations(t)
+	m.deleteByID.AssertExpectations(t)
+}
+
+// dialTodoSocket serves the handler and opens a socket to it.
+func dialTodoSocket(t *testing.T, mocks todoSocketMocks, stream eventstream.Stream) *websocket.Conn {
+	t.Helper()
+	h := handler.NewTodoSocket(mocks.create, mocks.update, mocks.complete, mocks.markAsPending, mocks.deleteByID, stream)
+	e := echo.New()
+	e.Add(h.Method(), h.Path(), h.Handle)
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+h.Path(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	return conn
+}
+
+func TestTodoSocket_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	version := 2
+	exampleOutput := todo.TodoOutput{
+		ID:        "1",
+		Title:     "Write report",
+		Status:    string(domain.TodoStatusCompleted),
+		Priority:  string(domain.TodoPriorityNone),
+		Version:   3,
+		CreatedAt: exampleDate,
+		UpdatedAt: exampleDate,
+	}
+	exampleTodo := `{"id":"1","title":"Write report","description":"","status":"completed","priority":"none","tags":[],` +
+		`"items":[],"version":3,"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`
+	testCases := []struct {
+		name     string
+		mocks    func() todoSocketMocks
+		request  string
+		response string
+	}{
+		{
+			name: "should create a todo",
+			mocks: func() todoSocketMocks {
+				m := newTodoSocketMocks()
+				m.create.On("Handle", mock.Anything, todo.CreateInput{
+					Title: "Write report", Priority: domain.TodoPriorityHigh, Tags: []string{"work"},
+				}).Return(exampleOutput, nil).Once()
+				return m
+			},
+			request:  `{"id":"r1","type":"create","todo":{"title":"Write report","priority":"high","tags":["work"]}}`,
+			response: `{"type":"result","id":"r1","todo":` + exampleTodo + `}`,
+		},
+		{
+			name: "should update a todo at its version",
+			mocks: func() todoSocketMocks {
+				m := newTodoSocketMocks()
+				m.update.On("Handle", mock.Anything, todo.UpdateInput{
+					ID: "1", Title: "Write report", Versions: []int{version},
+				}).Return(exampleOutput, nil).Once()
+				return m
+			},
+			request:  `{"id":"r2","type":"update","todo_id":"1","version":2,"todo":{"title":"Write report"}}`,
+			response: `{"type":"result","id":"r2","todo":` + exampleTodo + `}`,
+		},
+		{
+			name: "should complete a todo",
+			mocks: func() todoSocketMocks {
+				m := newTodoSocketMocks()
+				m.complete.On("Handle", mock.Anything, todo.CompleteInput{
+					ID: "1", OpenItems: todo.OpenItemsCascade,
+				}).Return(exampleOutput, nil).Once()
+				return m
+			},
+			request:  `{"id":"r3","type":"complete","todo_id":"1","open_items":"cascade"}`,
+			response: `{"type":"result","id":"r3","todo":` + exampleTodo + `}`,
+		},
+		{
+			name: "should mark a todo as pending",
+			mocks: func() todoSocketMocks {
+				m := newTodoSocketMocks()
+				m.markAsPending.On("Handle", mock.Anything, todo.MarkAsPendingInput{ID: "1"}).
+					Return(exampleOutput, nil).Once()
+				return m
+			},
+			request:  `{"id":"r4","type":"pending","todo_id":"1"}`,
+			response: `{"type":"result","id":"r4","todo":` + exampleTodo + `}`,
+		},
+		{
+			name: "should delete a todo permanently",
+			mocks: func() todoSocketMocks {
+				m := newTodoSocketMocks()
+				m.deleteByID.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "1", Permanent: true}).
+					Return(nil).Once()
+				return m
+			},
+			request:  `{"id":"r5","type":"delete","todo_id":"1","permanent":true}`,
+			response: `{"type":"result","id":"r5"}`,
+		},
+		{
+			name:     "should answer the subscribed todos",
+			mocks:    newTodoSocketMocks,
+			request:  `{"id":"r6","type":"subscribe","todo_ids":["2","1","2"]}`,
+			response: `{"type":"result","id":"r6","todo_ids":["1","2"]}`,
+		},
+		{
+			name: "should answer the error of the usecase",
+			mocks: func() todoSocketMocks {
+				m := newTodoSocketMocks()
+				m.markAsPending.On("Handle", mock.Anything, todo.MarkAsPendingInput{ID: "9"}).
+					Return(todo.TodoOutput{}, usecase.NewError("todo not found", nil, usecase.ErrorTypeNotFound)).Once()
+				return m
+			},
+			request:  `{"id":"r7","type":"pending","todo_id":"9"}`,
+			response: `{"type":"error","id":"r7","error":{"type":"not_found","message":"todo not found"}}`,
+		},
+		{
+			name: "should hide an unexpected error",
+			mocks: func() todoSocketMocks {
+				m := newTodoSocketMocks()
+				m.deleteByID.On("Handle", mock.Anything, todo.DeleteByIDInput{ID: "1"}).
+					Return(assert.AnError).Once()
+				return m
+			},
+			request:  `{"id":"r8","type":"delete","todo_id":"1"}`,
+			response: `{"type":"error","id":"r8","error":{"type":"internal_error","message":"internal server error"}}`,
+		},
+		{
+			name:     "should refuse an unknown message type",
+			mocks:    newTodoSocketMocks,
+			request:  `{"id":"r9","type":"archive","todo_id":"1"}`,
+			response: `{"type":"error","id":"r9","error":{"type":"bad_request","message":"unknown message type \"archive\""}}`,
+		},
+		{
+			name:     "should refuse an invalid message",
+			mocks:    newTodoSocketMocks,
+			request:  `{"id":`,
+			response: `{"type":"error","error":{"type":"bad_request","message":"invalid JSON message"}}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mocks := tc.mocks()
+			conn := dialTodoSocket(t, mocks, eventstream.NewStream(10, time.Unix(0, 0)))
+
+			assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(tc.request)))
+			_, response, err := conn.ReadMessage()
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.response, string(response))
+			mocks.assertExpectations(t)
+		})
+	}
+}
+
+func TestTodoSocket_Handle_Events(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	event := func(todoID string, eventType domain.EventType) domain.Event {
+		return domain.Event{
+			ID: "e" + todoID, Type: eventType, TodoID: todoID, Actor: "alice", OccurredAt: exampleDate,
+			Todo: domain.Todo{
+				ID: todoID, Title: "Write report", Status: domain.TodoStatusPending, Priority: domain.TodoPriorityNone,
+				CreatedAt: exampleDate, UpdatedAt: exampleDate,
+			},
+		}
+	}
+	stream := eventstream.NewStream(10, time.Unix(0, 0))
+	conn := dialTodoSocket(t, newTodoSocketMocks(), stream)
+	send := func(request string) string {
+		assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(request)))
+		_, response, err := conn.ReadMessage()
+		assert.NoError(t, err)
+		return string(response)
+	}
+
+	assert.JSONEq(t, `{"type":"result","id":"s1","todo_ids":["1","2"]}`,
+		send(`{"id":"s1","type":"subscribe","todo_ids":["1","2"]}`))
+	assert.JSONEq(t, `{"type":"result","id":"s2","todo_ids":["1"]}`,
+		send(`{"id":"s2","type":"unsubscribe","todo_ids":["2"]}`))
+	for _, e := range []domain.Event{
+		event("2", domain.EventTodoUpdated),
+		event("3", domain.EventTodoCreated),
+		event("1", domain.EventTodoCompleted),
+	} {
+		assert.NoError(t, stream.Publish(context.Background(), e))
+	}
+	_, pushed, err := conn.ReadMessage()
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"event","event":{"id":"e1","type":"todo.completed","todo_id":"1","actor":"alice",`+
+		`"occurred_at":"2024-01-01T00:00:00Z","todo":{"id":"1","title":"Write report","description":"",`+
+		`"status":"pending","priority":"none","tags":[],"items":[],`+
+		`"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}}}`, string(pushed))
+}
+
+func TestTodoSocket_Handle_StreamClosed(t *testing.T) {
+	t.Run("should close the socket when the stream closes", func(t *testing.T) {
+		stream := eventstream.NewStream(10, time.Unix(0, 0))
+		conn := dialTodoSocket(t, newTodoSocketMocks(), stream)
+
+		stream.Close()
+		_, _, err := conn.ReadMessage()
+
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), err)
+	})
+
+	t.Run("should refuse the socket when the stream is closed", func(t *testing.T) {
+		stream := new(todoEventsStreamMock)
+		stream.On("Subscribe", uint64(0), eventstream.Filter{}).Return(nil, eventstream.ErrClosed).Once()
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/ws", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		m := newTodoSocketMocks()
+
+		err := handler.NewTodoSocket(m.create, m.update, m.complete, m.markAsPending, m.deleteByID, stream).Handle(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Equal(t, `{"message":"the event stream is closed"}`+"\n", rec.Body.String())
+		stream.AssertExpectations(t)
+	})
+}
+
+func TestTodoSocket_Path(t *testing.T) {
+	m := newTodoSocketMocks()
+	h := handler.NewTodoSocket(m.create, m.update, m.complete, m.markAsPending, m.deleteByID, new(todoEventsStreamMock))
+	assert.Equal(t, "/ws", h.Path())
+}
+
+func TestTodoSocket_Method(t *testing.T) {
+	m := newTodoSocketMocks()
+	h := handler.NewTodoSocket(m.create, m.update, m.complete, m.markAsPending, m.deleteByID, new(todoEventsStreamMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	todoTransitionInput struct {
+		Status    string `json:"status" example:"in_progress"`
+		OpenItems string `json:"open_items,omitempty" enums:"allow,refuse,cascade" example:"allow"`
+	}
+	TodoTransition struct {
+		transition todo.Transition
+	}
+)
+
+func NewTodoTransition(transition todo.Transition) *TodoTransition {
+	return &TodoTransition{transition: transition}
+}
+
+// @Summary Move a todo to another status
+// @Description Move an existing todo to a status of the configured workflow. The workflow lists the
+// @Description statuses and the transitions allowed between them, and a transition it does not allow
+// @Description is a conflict. Moving a todo to completed applies the open_items policy and creates the
+// @Description next occurrence of a recurring todo, as the complete endpoint does.
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param If-Match header string false "ETag of the todo version being changed"
+// @Param transition body todoTransitionInput true "Target status"
+// @Success 200 {object} todoOutput
+// @Header 200 {string} ETag "Version of the changed todo"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Router /todos/{id}/transition [post]
+func (h *TodoTransition) Handle(c echo.Context) error {
+	var input todoTransitionInput
+	if err := c.Bind(&input); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	output, err := ifMatch(c, func(versions []int) (todo.TodoOutput, error) {
+		return h.transition.Handle(c.Request().Context(), todo.TransitionInput{
+			ID:        c.Param("id"),
+			Status:    domain.TodoStatus(input.Status),
+			OpenItems: todo.OpenItemsPolicy(input.OpenItems),
+			Versions:  versions,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusOK, output)
+}
+
+func (h *TodoTransition) Path() string {
+	return "/todos/:id/transition"
+}
+
+func (h *TodoTransition) Method() string {
+	return http.MethodPost
+}
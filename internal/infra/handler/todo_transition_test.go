@@ -0,0 +1,132 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoTransition_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	exampleDateUpdated, _ := time.Parse(time.DateOnly, "2024-01-02")
+	version := 2
+	testCases := []struct {
+		name           string
+		transition     *todoTransitionMock
+		body           string
+		ifMatch        string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name:           "should fail when the body is not valid JSON",
+			transition:     new(todoTransitionMock),
+			body:           `{"status":`,
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid JSON input",
+				echo.NewHTTPError(http.StatusBadRequest, "unexpected EOF").SetInternal(errors.New("unexpected EOF")),
+				usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:           "should fail when If-Match is invalid",
+			transition:     new(todoTransitionMock),
+			body:           `{"status":"in_progress"}`,
+			ifMatch:        "2",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid If-Match header: must be a list of ETags or *",
+				errors.New("invalid If-Match header"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name: "should fail when transition use case fails",
+			transition: func() *todoTransitionMock {
+				m := new(todoTransitionMock)
+				m.On("Handle", mock.Anything, todo.TransitionInput{ID: "123", Status: "blocked"}).
+					Return(todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			body:           `{"status":"blocked"}`,
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should move a todo to the status at the If-Match version",
+			transition: func() *todoTransitionMock {
+				m := new(todoTransitionMock)
+				m.On("Handle", mock.Anything, todo.TransitionInput{
+					ID:        "123",
+					Status:    "completed",
+					OpenItems: todo.OpenItemsRefuse,
+					Versions:  []int{version},
+				}).Return(todo.TodoOutput{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "completed",
+					Priority:  "none",
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDateUpdated,
+					Version:   3,
+				}, nil).Once()
+				return m
+			}(),
+			body:           `{"status":"completed","open_items":"refuse"}`,
+			ifMatch:        `"2"`,
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"completed","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-02T00:00:00Z","version":3}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/todos/:id/transition")
+			c.SetParamNames("id")
+			c.SetParamValues("123")
+			h := handler.NewTodoTransition(tc.transition)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseStatus, rec.Code)
+			if tc.responseBody != "" {
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.transition.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoTransition_Path(t *testing.T) {
+	h := handler.NewTodoTransition(new(todoTransitionMock))
+	assert.Equal(t, "/todos/:id/transition", h.Path())
+}
+
+func TestTodoTransition_Method(t *testing.T) {
+	h := handler.NewTodoTransition(new(todoTransitionMock))
+	assert.Equal(t, http.MethodPost, h.Method())
+}
+
+type todoTransitionMock struct {
+	mock.Mock
+}
+
+func (m *todoTransitionMock) Handle(ctx context.Context, input todo.TransitionInput) (todo.TodoOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(todo.TodoOutput), args.Error(1)
+}
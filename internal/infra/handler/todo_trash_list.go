@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	TodoTrashList struct {
+		listTrash todo.ListTrash
+	}
+)
+
+func NewTodoTrashList(listTrash todo.ListTrash) *TodoTrashList {
+	return &TodoTrashList{listTrash: listTrash}
+}
+
+// @Summary List the trash
+// @Description Retrieve the deleted todos that can still be restored, the most recently deleted first
+// @Tags todos
+// @Produce json
+// @Success 200 {array} todoOutput
+// @Router /todos/trash [get]
+func (h *TodoTrashList) Handle(c echo.Context) error {
+	outputs, err := h.listTrash.Handle(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, todoOutputsFromUsecase(outputs))
+}
+
+func (h *TodoTrashList) Path() string {
+	return "/todos/trash"
+}
+
+func (h *TodoTrashList) Method() string {
+	return http.MethodGet
+}
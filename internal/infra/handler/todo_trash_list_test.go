@@ -0,0 +1,107 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestTodoTrashList_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		listTrash      *todoTrashListMock
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when list trash use case fails",
+			listTrash: func() *todoTrashListMock {
+				m := new(todoTrashListMock)
+				m.On("Handle", mock.Anything).Return([]todo.TodoOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should return an empty list when the trash is empty",
+			listTrash: func() *todoTrashListMock {
+				m := new(todoTrashListMock)
+				m.On("Handle", mock.Anything).Return([]todo.TodoOutput{}, nil).Once()
+				return m
+			}(),
+			responseBody:   `[]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should list the todos in the trash",
+			listTrash: func() *todoTrashListMock {
+				m := new(todoTrashListMock)
+				m.On("Handle", mock.Anything).Return([]todo.TodoOutput{
+					{
+						ID:        "123",
+						Title:     "example title",
+						Status:    "pending",
+						Priority:  "none",
+						CreatedAt: exampleDate,
+						UpdatedAt: exampleDate,
+						DeletedAt: &exampleDate,
+						Version:   2,
+					},
+				}, nil).Once()
+				return m
+			}(),
+			responseBody:   `[{"id":"123","title":"example title","description":"","status":"pending","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z","deleted_at":"2024-01-01T00:00:00Z","version":2}]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			h := handler.NewTodoTrashList(tc.listTrash)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseStatus, rec.Code)
+			if tc.responseBody != "" {
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.listTrash.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoTrashList_Path(t *testing.T) {
+	h := handler.NewTodoTrashList(new(todoTrashListMock))
+	assert.Equal(t, "/todos/trash", h.Path())
+}
+
+func TestTodoTrashList_Method(t *testing.T) {
+	h := handler.NewTodoTrashList(new(todoTrashListMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type todoTrashListMock struct {
+	mock.Mock
+}
+
+func (m *todoTrashListMock) Handle(ctx context.Context) ([]todo.TodoOutput, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]todo.TodoOutput), args.Error(1)
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+type (
+	TodoUnarchive struct {
+		unarchive todo.Unarchive
+	}
+)
+
+func NewTodoUnarchive(unarchive todo.Unarchive) *TodoUnarchive {
+	return &TodoUnarchive{unarchive: unarchive}
+}
+
+// @Summary Unarchive a todo
+// @Description Take a todo out of the archive, listing it again with its status kept
+// @Tags todos
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param If-Match header string false "ETag of the todo version being unarchived"
+// @Success 200 {object} todoOutput
+// @Header 200 {string} ETag "Version of the unarchived todo"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Router /todos/{id}/archive [delete]
+func (h *TodoUnarchive) Handle(c echo.Context) error {
+	output, err := ifMatch(c, func(versions []int) (todo.TodoOutput, error) {
+		return h.unarchive.Handle(c.Request().Context(), todo.UnarchiveInput{
+			ID:       c.Param("id"),
+			Versions: versions,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusOK, output)
+}
+
+func (h *TodoUnarchive) Path() string {
+	return "/todos/:id/archive"
+}
+
+func (h *TodoUnarchive) Method() string {
+	return http.MethodDelete
+}
@@ -7,12 +7,16 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
 type (
 	todoUpdateInput struct {
 		Title       string     `json:"title"`
 		Description string     `json:"description"`
+		Priority    string     `json:"priority,omitempty" enums:"none,low,medium,high,urgent"`
+		Tags        []string   `json:"tags,omitempty"`
+		Recurrence  string     `json:"recurrence,omitempty" example:"FREQ=WEEKLY;BYDAY=MO,TH"`
 		DueDate     *time.Time `json:"due_date,omitempty"`
 	}
 	TodoUpdate struct {
@@ -25,15 +29,20 @@ func NewTodoUpdate(update todo.Update) *TodoUpdate {
 }
 
 // @Summary Update a todo
-// @Description Update an existing todo item
+// @Description Update an existing todo item. With an If-Match header the todo is only
+// @Description updated while it is still at the version of that ETag.
 // @Tags todos
 // @Accept json
 // @Produce json
 // @Param id path string true "Todo ID"
+// @Param If-Match header string false "ETag of the todo version being updated"
 // @Param todo body todoUpdateInput true "Updated todo data"
 // @Success 200 {object} todoOutput
+// @Header 200 {string} ETag "Version of the updated todo"
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Router /todos/{id} [put]
 func (h *TodoUpdate) Handle(c echo.Context) error {
 	id := c.Param("id")
@@ -41,16 +50,27 @@ func (h *TodoUpdate) Handle(c echo.Context) error {
 	if err := c.Bind(&input); err != nil {
 		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
 	}
-	output, err := h.update.Handle(c.Request().Context(), todo.UpdateInput{
+	output, err := ifMatch(c, func(versions []int) (todo.TodoOutput, error) {
+		return h.update.Handle(c.Request().Context(), updateInputFromRequest(id, input, versions))
+	})
+	if err != nil {
+		return err
+	}
+	return todoResponse(c, http.StatusOK, output)
+}
+
+// updateInputFromRequest converts the request body of a todo update to the usecase input
+func updateInputFromRequest(id string, input todoUpdateInput, versions []int) todo.UpdateInput {
+	return todo.UpdateInput{
 		ID:          id,
 		Title:       input.Title,
 		Description: input.Description,
+		Priority:    domain.TodoPriority(input.Priority),
+		Tags:        input.Tags,
+		Recurrence:  input.Recurrence,
 		DueDate:     input.DueDate,
-	})
-	if err != nil {
-		return err
+		Versions:    versions,
 	}
-	return c.JSON(http.StatusOK, todoOutputFromUsecase(output))
 }
 
 func (h *TodoUpdate) Path() string {
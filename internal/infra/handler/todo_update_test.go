@@ -2,6 +2,7 @@ package handler_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase"
 	"github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
 	"github.com/wellingtonlope/todo-api/internal/infra/handler"
 )
 
@@ -22,9 +24,11 @@ func TestTodoUpdate_Handle(t *testing.T) {
 		name           string
 		update         *todoUpdateMock
 		pathID         string
+		ifMatch        string
 		requestBody    string
 		responseBody   string
 		responseStatus int
+		etag           string
 		err            error
 	}{
 		{
@@ -69,20 +73,118 @@ func TestTodoUpdate_Handle(t *testing.T) {
 					ID:          "123",
 					Title:       "example title",
 					Description: "example description",
+					Priority:    domain.TodoPriorityHigh,
 				}).Return(todo.TodoOutput{
 					ID:          "123",
 					Title:       "example title",
 					Description: "example description",
 					Status:      "pending",
+					Priority:    "high",
 					CreatedAt:   exampleDate,
 					UpdatedAt:   exampleDate,
 				}, nil).Once()
 				return m
 			}(),
 			pathID:         "123",
-			requestBody:    `{"title":"example title","description":"example description"}`,
-			responseBody:   `{"id":"123","title":"example title","description":"example description","status":"pending","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			requestBody:    `{"title":"example title","description":"example description","priority":"high"}`,
+			responseBody:   `{"id":"123","title":"example title","description":"example description","status":"pending","priority":"high","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name:           "should fail when If-Match is not a list of ETags",
+			update:         new(todoUpdateMock),
+			pathID:         "123",
+			ifMatch:        `"2" "3"`,
+			requestBody:    `{"title":"example title"}`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("invalid If-Match header: must be a list of ETags or *",
+				errors.New("invalid If-Match header"), usecase.ErrorTypeBadRequest),
+		},
+		{
+			name:           "should fail the precondition when If-Match has only weak ETags",
+			update:         new(todoUpdateMock),
+			pathID:         "123",
+			ifMatch:        `W/"2", W/"3"`,
+			requestBody:    `{"title":"example title"}`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err: usecase.NewError("todo matches none of the If-Match ETags",
+				errors.New("If-Match header has no version of the todo"), usecase.ErrorTypePreconditionFailed),
+		},
+		{
+			name: "should fail the precondition when the todo is at none of the If-Match versions",
+			update: func() *todoUpdateMock {
+				m := new(todoUpdateMock)
+				m.On("Handle", mock.Anything, todo.UpdateInput{
+					ID:       "123",
+					Title:    "example title",
+					Versions: []int{2, 3},
+				}).Return(todo.TodoOutput{}, usecase.NewError("todo has changed",
+					nil, usecase.ErrorTypePreconditionFailed)).Once()
+				return m
+			}(),
+			pathID:         "123",
+			ifMatch:        `"2", W/"4", "3"`,
+			requestBody:    `{"title":"example title"}`,
+			responseBody:   "",
+			responseStatus: http.StatusOK,
+			err:            usecase.NewError("todo has changed", nil, usecase.ErrorTypePreconditionFailed),
+		},
+		{
+			name: "should update a todo once at all the If-Match versions",
+			update: func() *todoUpdateMock {
+				m := new(todoUpdateMock)
+				m.On("Handle", mock.Anything, todo.UpdateInput{
+					ID:       "123",
+					Title:    "example title",
+					Versions: []int{2, 3},
+				}).Return(todo.TodoOutput{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "pending",
+					Priority:  "none",
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDate,
+					Version:   4,
+				}, nil).Once()
+				return m
+			}(),
+			pathID:         "123",
+			ifMatch:        `"2", "3"`,
+			requestBody:    `{"title":"example title"}`,
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"pending","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z","version":4}`,
+			responseStatus: http.StatusOK,
+			etag:           `"4"`,
+			err:            nil,
+		},
+		{
+			name: "should update a todo at the If-Match version",
+			update: func() *todoUpdateMock {
+				version := 2
+				m := new(todoUpdateMock)
+				m.On("Handle", mock.Anything, todo.UpdateInput{
+					ID:       "123",
+					Title:    "example title",
+					Versions: []int{version},
+				}).Return(todo.TodoOutput{
+					ID:        "123",
+					Title:     "example title",
+					Status:    "pending",
+					Priority:  "none",
+					CreatedAt: exampleDate,
+					UpdatedAt: exampleDate,
+					Version:   3,
+				}, nil).Once()
+				return m
+			}(),
+			pathID:         "123",
+			ifMatch:        `"2"`,
+			requestBody:    `{"title":"example title"}`,
+			responseBody:   `{"id":"123","title":"example title","description":"","status":"pending","priority":"none","tags":[],"items":[],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z","version":3}`,
 			responseStatus: http.StatusOK,
+			etag:           `"3"`,
 			err:            nil,
 		},
 	}
@@ -91,6 +193,9 @@ func TestTodoUpdate_Handle(t *testing.T) {
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(tc.requestBody))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			c.SetPath("/todos/:id")
@@ -101,6 +206,8 @@ func TestTodoUpdate_Handle(t *testing.T) {
 			assert.Equal(t, tc.err, err)
 			assert.Equal(t, tc.responseBody, strings.Trim(rec.Body.String(), "\n"))
 			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			assert.Equal(t, tc.etag, rec.Header().Get("ETag"))
+			tc.update.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+type (
+	webhookOutput struct {
+		ID        string    `json:"id"`
+		URL       string    `json:"url"`
+		Events    []string  `json:"events"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+	webhookDeliveryOutput struct {
+		ID             string          `json:"id"`
+		WebhookID      string          `json:"webhook_id"`
+		EventID        string          `json:"event_id"`
+		EventType      string          `json:"event_type"`
+		Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+		Status         string          `json:"status"`
+		Attempts       int             `json:"attempts"`
+		ResponseStatus int             `json:"response_status,omitempty"`
+		LastError      string          `json:"last_error,omitempty"`
+		NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+		CreatedAt      time.Time       `json:"created_at"`
+		UpdatedAt      time.Time       `json:"updated_at"`
+	}
+)
+
+// webhookOutputFromUsecase converts a usecase WebhookOutput to handler webhookOutput
+func webhookOutputFromUsecase(usecaseOutput webhook.WebhookOutput) webhookOutput {
+	return webhookOutput{
+		ID:        usecaseOutput.ID,
+		URL:       usecaseOutput.URL,
+		Events:    usecaseOutput.Events,
+		CreatedAt: usecaseOutput.CreatedAt,
+		UpdatedAt: usecaseOutput.UpdatedAt,
+	}
+}
+
+// webhookOutputsFromUsecase converts a slice of usecase WebhookOutput to []webhookOutput
+func webhookOutputsFromUsecase(usecaseOutputs []webhook.WebhookOutput) []webhookOutput {
+	outputs := make([]webhookOutput, 0, len(usecaseOutputs))
+	for _, usecaseOutput := range usecaseOutputs {
+		outputs = append(outputs, webhookOutputFromUsecase(usecaseOutput))
+	}
+	return outputs
+}
+
+// webhookDeliveryOutputsFromUsecase converts a slice of usecase DeliveryOutput to []webhookDeliveryOutput
+func webhookDeliveryOutputsFromUsecase(usecaseOutputs []webhook.DeliveryOutput) []webhookDeliveryOutput {
+	outputs := make([]webhookDeliveryOutput, 0, len(usecaseOutputs))
+	for _, usecaseOutput := range usecaseOutputs {
+		outputs = append(outputs, webhookDeliveryOutput{
+			ID:             usecaseOutput.ID,
+			WebhookID:      usecaseOutput.WebhookID,
+			EventID:        usecaseOutput.EventID,
+			EventType:      usecaseOutput.EventType,
+			Payload:        usecaseOutput.Payload,
+			Status:         usecaseOutput.Status,
+			Attempts:       usecaseOutput.Attempts,
+			ResponseStatus: usecaseOutput.ResponseStatus,
+			LastError:      usecaseOutput.LastError,
+			NextAttemptAt:  usecaseOutput.NextAttemptAt,
+			CreatedAt:      usecaseOutput.CreatedAt,
+			UpdatedAt:      usecaseOutput.UpdatedAt,
+		})
+	}
+	return outputs
+}
+
+// eventTypesFromInput converts the event types of a webhook input to domain.EventType
+func eventTypesFromInput(events []string) []domain.EventType {
+	types := make([]domain.EventType, 0, len(events))
+	for _, event := range events {
+		types = append(types, domain.EventType(event))
+	}
+	return types
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+)
+
+type (
+	webhookCreateInput struct {
+		URL    string   `json:"url"`
+		Secret string   `json:"secret"`
+		Events []string `json:"events"`
+	}
+	WebhookCreate struct {
+		create webhook.Create
+	}
+)
+
+func NewWebhookCreate(create webhook.Create) *WebhookCreate {
+	return &WebhookCreate{create: create}
+}
+
+// @Summary Create a webhook
+// @Description Subscribe a URL to the todo events of the given types (todo.created, todo.updated,
+// @Description todo.completed, todo.reopened or todo.deleted). Each event is POSTed to it as JSON with
+// @Description the X-Webhook-ID, X-Webhook-Event and X-Webhook-Signature headers, the signature being
+// @Description sha256= followed by the hex HMAC-SHA256 of the body with the secret.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body webhookCreateInput true "Webhook data"
+// @Success 201 {object} webhookOutput
+// @Failure 400 {object} ErrorResponse
+// @Router /webhooks [post]
+func (h *WebhookCreate) Handle(c echo.Context) error {
+	var input webhookCreateInput
+	if err := c.Bind(&input); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	output, err := h.create.Handle(c.Request().Context(), webhook.CreateInput{
+		URL:    input.URL,
+		Secret: input.Secret,
+		Events: eventTypesFromInput(input.Events),
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, webhookOutputFromUsecase(output))
+}
+
+func (h *WebhookCreate) Path() string {
+	return "/webhooks"
+}
+
+func (h *WebhookCreate) Method() string {
+	return http.MethodPost
+}
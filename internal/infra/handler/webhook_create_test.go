@@ -0,0 +1,114 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestWebhookCreate_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		create         *webhookCreateMock
+		requestBody    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when create use case fails",
+			create: func() *webhookCreateMock {
+				m := new(webhookCreateMock)
+				m.On("Handle", mock.Anything, webhook.CreateInput{
+					URL: "https://example.com/hooks", Events: []domain.EventType{},
+				}).Return(webhook.WebhookOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			requestBody: `{"url":"https://example.com/hooks"}`,
+			err:         usecase.AnError,
+		},
+		{
+			name: "should create a webhook",
+			create: func() *webhookCreateMock {
+				m := new(webhookCreateMock)
+				m.On("Handle", mock.Anything, webhook.CreateInput{
+					URL:    "https://example.com/hooks",
+					Secret: "s3cret",
+					Events: []domain.EventType{domain.EventTodoCreated, domain.EventTodoDeleted},
+				}).Return(webhook.WebhookOutput{
+					ID: "w1", URL: "https://example.com/hooks", Events: []string{"todo.created", "todo.deleted"},
+					CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}, nil).Once()
+				return m
+			}(),
+			requestBody:    `{"url":"https://example.com/hooks","secret":"s3cret","events":["todo.created","todo.deleted"]}`,
+			responseBody:   `{"id":"w1","url":"https://example.com/hooks","events":["todo.created","todo.deleted"],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusCreated,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			h := handler.NewWebhookCreate(tc.create)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.create.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookCreate_InvalidJSON(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{"))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, httptest.NewRecorder())
+	err := handler.NewWebhookCreate(new(webhookCreateMock)).Handle(c)
+	errUC, ok := err.(usecase.Error)
+	assert.True(t, ok)
+	assert.Equal(t, "invalid JSON input", errUC.Message)
+	assert.Equal(t, usecase.ErrorTypeBadRequest, errUC.Type)
+}
+
+func TestWebhookCreate_Path(t *testing.T) {
+	h := handler.NewWebhookCreate(new(webhookCreateMock))
+	assert.Equal(t, "/webhooks", h.Path())
+}
+
+func TestWebhookCreate_Method(t *testing.T) {
+	h := handler.NewWebhookCreate(new(webhookCreateMock))
+	assert.Equal(t, http.MethodPost, h.Method())
+}
+
+type webhookCreateMock struct {
+	mock.Mock
+}
+
+func (m *webhookCreateMock) Handle(ctx context.Context, input webhook.CreateInput) (webhook.WebhookOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(webhook.WebhookOutput), args.Error(1)
+}
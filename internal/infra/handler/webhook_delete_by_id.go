@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+)
+
+type WebhookDeleteByID struct {
+	deleteByID webhook.DeleteByID
+}
+
+func NewWebhookDeleteByID(deleteByID webhook.DeleteByID) *WebhookDeleteByID {
+	return &WebhookDeleteByID{deleteByID: deleteByID}
+}
+
+// @Summary Delete a webhook by ID
+// @Description Delete a webhook with its deliveries. The events are no longer delivered to it.
+// @Tags webhooks
+// @Param id path string true "Webhook ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} ErrorResponse
+// @Router /webhooks/{id} [delete]
+func (h *WebhookDeleteByID) Handle(c echo.Context) error {
+	if err := h.deleteByID.Handle(c.Request().Context(), c.Param("id")); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *WebhookDeleteByID) Path() string {
+	return "/webhooks/:id"
+}
+
+func (h *WebhookDeleteByID) Method() string {
+	return http.MethodDelete
+}
@@ -0,0 +1,79 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestWebhookDeleteByID_Handle(t *testing.T) {
+	testCases := []struct {
+		name           string
+		deleteByID     *webhookDeleteByIDMock
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when delete use case fails",
+			deleteByID: func() *webhookDeleteByIDMock {
+				m := new(webhookDeleteByIDMock)
+				m.On("Handle", mock.Anything, "w1").Return(usecase.AnError).Once()
+				return m
+			}(),
+			responseStatus: http.StatusOK,
+			err:            usecase.AnError,
+		},
+		{
+			name: "should delete a webhook",
+			deleteByID: func() *webhookDeleteByIDMock {
+				m := new(webhookDeleteByIDMock)
+				m.On("Handle", mock.Anything, "w1").Return(nil).Once()
+				return m
+			}(),
+			responseStatus: http.StatusNoContent,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/webhooks/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("w1")
+			h := handler.NewWebhookDeleteByID(tc.deleteByID)
+			err := h.Handle(c)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.responseStatus, rec.Result().StatusCode)
+			tc.deleteByID.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookDeleteByID_Path(t *testing.T) {
+	h := handler.NewWebhookDeleteByID(new(webhookDeleteByIDMock))
+	assert.Equal(t, "/webhooks/:id", h.Path())
+}
+
+func TestWebhookDeleteByID_Method(t *testing.T) {
+	h := handler.NewWebhookDeleteByID(new(webhookDeleteByIDMock))
+	assert.Equal(t, http.MethodDelete, h.Method())
+}
+
+type webhookDeleteByIDMock struct {
+	mock.Mock
+}
+
+func (m *webhookDeleteByIDMock) Handle(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
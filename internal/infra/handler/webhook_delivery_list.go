@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+)
+
+type WebhookDeliveryList struct {
+	listDeliveries webhook.ListDeliveries
+}
+
+func NewWebhookDeliveryList(listDeliveries webhook.ListDeliveries) *WebhookDeliveryList {
+	return &WebhookDeliveryList{listDeliveries: listDeliveries}
+}
+
+// @Summary List the deliveries of a webhook
+// @Description Retrieve the deliveries of the events to a webhook, the newest first. A delivery is
+// @Description pending until the webhook answers with a 2xx status, retried with an exponential
+// @Description backoff, and failed once it is given up after its last attempt.
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {array} webhookDeliveryOutput
+// @Failure 404 {object} ErrorResponse
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookDeliveryList) Handle(c echo.Context) error {
+	output, err := h.listDeliveries.Handle(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, webhookDeliveryOutputsFromUsecase(output))
+}
+
+func (h *WebhookDeliveryList) Path() string {
+	return "/webhooks/:id/deliveries"
+}
+
+func (h *WebhookDeliveryList) Method() string {
+	return http.MethodGet
+}
@@ -0,0 +1,111 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestWebhookDeliveryList_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	retryAt := exampleDate.Add(time.Minute)
+	testCases := []struct {
+		name           string
+		listDeliveries *webhookDeliveryListMock
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when list deliveries use case fails",
+			listDeliveries: func() *webhookDeliveryListMock {
+				m := new(webhookDeliveryListMock)
+				m.On("Handle", mock.Anything, "w1").Return([]webhook.DeliveryOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			err: usecase.AnError,
+		},
+		{
+			name: "should list the deliveries of the webhook",
+			listDeliveries: func() *webhookDeliveryListMock {
+				m := new(webhookDeliveryListMock)
+				m.On("Handle", mock.Anything, "w1").Return([]webhook.DeliveryOutput{
+					{
+						ID: "d2", WebhookID: "w1", EventID: "e2", EventType: "todo.completed",
+						Payload: json.RawMessage(`{"id":"e2"}`), Status: "pending", Attempts: 1,
+						ResponseStatus: 500, LastError: "unexpected status 500", NextAttemptAt: &retryAt,
+						CreatedAt: exampleDate, UpdatedAt: exampleDate,
+					},
+					{
+						ID: "d1", WebhookID: "w1", EventID: "e1", EventType: "todo.created",
+						Payload: json.RawMessage(`{"id":"e1"}`), Status: "succeeded", Attempts: 1,
+						ResponseStatus: 204, CreatedAt: exampleDate, UpdatedAt: exampleDate,
+					},
+				}, nil).Once()
+				return m
+			}(),
+			responseBody: `[
+				{"id":"d2","webhook_id":"w1","event_id":"e2","event_type":"todo.completed","payload":{"id":"e2"},
+				 "status":"pending","attempts":1,"response_status":500,"last_error":"unexpected status 500",
+				 "next_attempt_at":"2024-01-01T00:01:00Z","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"},
+				{"id":"d1","webhook_id":"w1","event_id":"e1","event_type":"todo.created","payload":{"id":"e1"},
+				 "status":"succeeded","attempts":1,"response_status":204,
+				 "created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}
+			]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/webhooks/:id/deliveries")
+			c.SetParamNames("id")
+			c.SetParamValues("w1")
+
+			h := handler.NewWebhookDeliveryList(tc.listDeliveries)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.listDeliveries.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookDeliveryList_Path(t *testing.T) {
+	h := handler.NewWebhookDeliveryList(new(webhookDeliveryListMock))
+	assert.Equal(t, "/webhooks/:id/deliveries", h.Path())
+}
+
+func TestWebhookDeliveryList_Method(t *testing.T) {
+	h := handler.NewWebhookDeliveryList(new(webhookDeliveryListMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type webhookDeliveryListMock struct {
+	mock.Mock
+}
+
+func (m *webhookDeliveryListMock) Handle(ctx context.Context, webhookID string) ([]webhook.DeliveryOutput, error) {
+	args := m.Called(ctx, webhookID)
+	return args.Get(0).([]webhook.DeliveryOutput), args.Error(1)
+}
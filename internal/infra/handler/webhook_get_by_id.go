@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+)
+
+type WebhookGetByID struct {
+	getByID webhook.GetByID
+}
+
+func NewWebhookGetByID(getByID webhook.GetByID) *WebhookGetByID {
+	return &WebhookGetByID{getByID: getByID}
+}
+
+// @Summary Get a webhook by ID
+// @Description Retrieve a webhook by its ID, without its secret
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} webhookOutput
+// @Failure 404 {object} ErrorResponse
+// @Router /webhooks/{id} [get]
+func (h *WebhookGetByID) Handle(c echo.Context) error {
+	output, err := h.getByID.Handle(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, webhookOutputFromUsecase(output))
+}
+
+func (h *WebhookGetByID) Path() string {
+	return "/webhooks/:id"
+}
+
+func (h *WebhookGetByID) Method() string {
+	return http.MethodGet
+}
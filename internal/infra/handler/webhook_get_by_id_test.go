@@ -0,0 +1,93 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestWebhookGetByID_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		getByID        *webhookGetByIDMock
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when get use case fails",
+			getByID: func() *webhookGetByIDMock {
+				m := new(webhookGetByIDMock)
+				m.On("Handle", mock.Anything, "w1").Return(webhook.WebhookOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			err: usecase.AnError,
+		},
+		{
+			name: "should get a webhook",
+			getByID: func() *webhookGetByIDMock {
+				m := new(webhookGetByIDMock)
+				m.On("Handle", mock.Anything, "w1").Return(webhook.WebhookOutput{
+					ID: "w1", URL: "https://example.com/hooks", Events: []string{"todo.created"},
+					CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}, nil).Once()
+				return m
+			}(),
+			responseBody:   `{"id":"w1","url":"https://example.com/hooks","events":["todo.created"],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/webhooks/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("w1")
+
+			h := handler.NewWebhookGetByID(tc.getByID)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.getByID.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookGetByID_Path(t *testing.T) {
+	h := handler.NewWebhookGetByID(new(webhookGetByIDMock))
+	assert.Equal(t, "/webhooks/:id", h.Path())
+}
+
+func TestWebhookGetByID_Method(t *testing.T) {
+	h := handler.NewWebhookGetByID(new(webhookGetByIDMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type webhookGetByIDMock struct {
+	mock.Mock
+}
+
+func (m *webhookGetByIDMock) Handle(ctx context.Context, id string) (webhook.WebhookOutput, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(webhook.WebhookOutput), args.Error(1)
+}
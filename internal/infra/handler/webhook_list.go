@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+)
+
+type WebhookList struct {
+	list webhook.List
+}
+
+func NewWebhookList(list webhook.List) *WebhookList {
+	return &WebhookList{list: list}
+}
+
+// @Summary List webhooks
+// @Description Retrieve every webhook, the oldest first, without their secrets
+// @Tags webhooks
+// @Produce json
+// @Success 200 {array} webhookOutput
+// @Router /webhooks [get]
+func (h *WebhookList) Handle(c echo.Context) error {
+	output, err := h.list.Handle(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, webhookOutputsFromUsecase(output))
+}
+
+func (h *WebhookList) Path() string {
+	return "/webhooks"
+}
+
+func (h *WebhookList) Method() string {
+	return http.MethodGet
+}
@@ -0,0 +1,101 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestWebhookList_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		list           *webhookListMock
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when list use case fails",
+			list: func() *webhookListMock {
+				m := new(webhookListMock)
+				m.On("Handle", mock.Anything).Return([]webhook.WebhookOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			err: usecase.AnError,
+		},
+		{
+			name: "should list the webhooks",
+			list: func() *webhookListMock {
+				m := new(webhookListMock)
+				m.On("Handle", mock.Anything).Return([]webhook.WebhookOutput{{
+					ID: "w1", URL: "https://example.com/hooks", Events: []string{"todo.created"},
+					CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}}, nil).Once()
+				return m
+			}(),
+			responseBody:   `[{"id":"w1","url":"https://example.com/hooks","events":["todo.created"],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+		{
+			name: "should return an empty list when there are no webhooks",
+			list: func() *webhookListMock {
+				m := new(webhookListMock)
+				m.On("Handle", mock.Anything).Return([]webhook.WebhookOutput{}, nil).Once()
+				return m
+			}(),
+			responseBody:   `[]`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			h := handler.NewWebhookList(tc.list)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.list.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookList_Path(t *testing.T) {
+	h := handler.NewWebhookList(new(webhookListMock))
+	assert.Equal(t, "/webhooks", h.Path())
+}
+
+func TestWebhookList_Method(t *testing.T) {
+	h := handler.NewWebhookList(new(webhookListMock))
+	assert.Equal(t, http.MethodGet, h.Method())
+}
+
+type webhookListMock struct {
+	mock.Mock
+}
+
+func (m *webhookListMock) Handle(ctx context.Context) ([]webhook.WebhookOutput, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]webhook.WebhookOutput), args.Error(1)
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+)
+
+type (
+	webhookUpdateInput struct {
+		URL    string   `json:"url"`
+		Secret string   `json:"secret"`
+		Events []string `json:"events"`
+	}
+	WebhookUpdate struct {
+		update webhook.Update
+	}
+)
+
+func NewWebhookUpdate(update webhook.Update) *WebhookUpdate {
+	return &WebhookUpdate{update: update}
+}
+
+// @Summary Update a webhook
+// @Description Update the URL, secret and event types of a webhook. An empty secret keeps the current one.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param webhook body webhookUpdateInput true "Updated webhook data"
+// @Success 200 {object} webhookOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /webhooks/{id} [put]
+func (h *WebhookUpdate) Handle(c echo.Context) error {
+	var input webhookUpdateInput
+	if err := c.Bind(&input); err != nil {
+		return usecase.NewError("invalid JSON input", err, usecase.ErrorTypeBadRequest)
+	}
+	output, err := h.update.Handle(c.Request().Context(), webhook.UpdateInput{
+		ID:     c.Param("id"),
+		URL:    input.URL,
+		Secret: input.Secret,
+		Events: eventTypesFromInput(input.Events),
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, webhookOutputFromUsecase(output))
+}
+
+func (h *WebhookUpdate) Path() string {
+	return "/webhooks/:id"
+}
+
+func (h *WebhookUpdate) Method() string {
+	return http.MethodPut
+}
@@ -0,0 +1,104 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+	"github.com/wellingtonlope/todo-api/internal/infra/handler"
+)
+
+func TestWebhookUpdate_Handle(t *testing.T) {
+	exampleDate, _ := time.Parse(time.DateOnly, "2024-01-01")
+	testCases := []struct {
+		name           string
+		update         *webhookUpdateMock
+		requestBody    string
+		responseBody   string
+		responseStatus int
+		err            error
+	}{
+		{
+			name: "should fail when update use case fails",
+			update: func() *webhookUpdateMock {
+				m := new(webhookUpdateMock)
+				m.On("Handle", mock.Anything, webhook.UpdateInput{
+					ID: "w1", URL: "https://example.com/hooks", Events: []domain.EventType{domain.EventTodoUpdated},
+				}).Return(webhook.WebhookOutput{}, usecase.AnError).Once()
+				return m
+			}(),
+			requestBody: `{"url":"https://example.com/hooks","events":["todo.updated"]}`,
+			err:         usecase.AnError,
+		},
+		{
+			name: "should update a webhook",
+			update: func() *webhookUpdateMock {
+				m := new(webhookUpdateMock)
+				m.On("Handle", mock.Anything, webhook.UpdateInput{
+					ID: "w1", URL: "https://example.com/moved", Secret: "n3w",
+					Events: []domain.EventType{domain.EventTodoCompleted},
+				}).Return(webhook.WebhookOutput{
+					ID: "w1", URL: "https://example.com/moved", Events: []string{"todo.completed"},
+					CreatedAt: exampleDate, UpdatedAt: exampleDate,
+				}, nil).Once()
+				return m
+			}(),
+			requestBody:    `{"url":"https://example.com/moved","secret":"n3w","events":["todo.completed"]}`,
+			responseBody:   `{"id":"w1","url":"https://example.com/moved","events":["todo.completed"],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`,
+			responseStatus: http.StatusOK,
+			err:            nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/webhooks/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("w1")
+
+			h := handler.NewWebhookUpdate(tc.update)
+			err := h.Handle(c)
+
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.responseStatus, rec.Code)
+				assert.JSONEq(t, tc.responseBody, rec.Body.String())
+			}
+			tc.update.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookUpdate_Path(t *testing.T) {
+	h := handler.NewWebhookUpdate(new(webhookUpdateMock))
+	assert.Equal(t, "/webhooks/:id", h.Path())
+}
+
+func TestWebhookUpdate_Method(t *testing.T) {
+	h := handler.NewWebhookUpdate(new(webhookUpdateMock))
+	assert.Equal(t, http.MethodPut, h.Method())
+}
+
+type webhookUpdateMock struct {
+	mock.Mock
+}
+
+func (m *webhookUpdateMock) Handle(ctx context.Context, input webhook.UpdateInput) (webhook.WebhookOutput, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(webhook.WebhookOutput), args.Error(1)
+}
@@ -1,65 +1,334 @@
 package memory
 
 import (
+	"cmp"
 	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
 	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
 type todo struct {
 	todos map[string]domain.Todo
+	// trash holds the deleted todos until they are removed for good
+	trash map[string]domain.Todo
+	// history holds the status changes of each todo, the oldest first
+	history map[string][]domain.StatusChange
+	// mu guards todos, trash and history, unit makes the units of work run by Transaction wait for each other
+	mu   sync.RWMutex
+	unit sync.Mutex
 }
 
 func NewTodoRepository() *todo {
-	return &todo{make(map[string]domain.Todo)}
+	return &todo{
+		todos:   make(map[string]domain.Todo),
+		trash:   make(map[string]domain.Todo),
+		history: make(map[string][]domain.StatusChange),
+	}
 }
 
 func (r *todo) Create(_ context.Context, todo domain.Todo) (domain.Todo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	todo.ID = uuid.New().String()
+	todo.Version = 1
+	todo = withItemIDs(todo)
 
 	r.todos[todo.ID] = todo
+	r.recordStatusChange(todo.ID, "", todo.Status, todo.CreatedAt)
 	return todo, nil
 }
 
-func (r *todo) List(_ context.Context, status *domain.TodoStatus) ([]domain.Todo, error) {
+func (r *todo) List(_ context.Context, query todoUC.ListQuery) ([]domain.Todo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var after *domain.Todo
+	if query.After != nil {
+		a := todoFromCursor(*query.After)
+		after = &a
+	}
 	todos := make([]domain.Todo, 0, len(r.todos))
 	for _, item := range r.todos {
-		if status != nil && item.Status != *status {
+		if !matchesFilter(item, query.Filter, query.Now) {
+			continue
+		}
+		if after != nil && compareTodos(item, *after, query.Sort) <= 0 {
 			continue
 		}
 		todos = append(todos, item)
 	}
-	// Sort by created_at to ensure consistent order
-	for i := 0; i < len(todos); i++ {
-		for j := i + 1; j < len(todos); j++ {
-			if todos[i].CreatedAt.After(todos[j].CreatedAt) {
-				todos[i], todos[j] = todos[j], todos[i]
-			}
-		}
+	// Sort with the same total order used by the keyset pagination
+	slices.SortFunc(todos, func(a, b domain.Todo) int {
+		return compareTodos(a, b, query.Sort)
+	})
+	if query.Limit > 0 && len(todos) > query.Limit {
+		todos = todos[:query.Limit]
 	}
 	return todos, nil
 }
 
+// matchesFilter reports whether the todo satisfies every condition of the filter.
+// now is the reference instant of the overdue condition.
+func matchesFilter(item domain.Todo, filter todoUC.ListFilter, now time.Time) bool {
+	if filter.Status != nil && item.Status != *filter.Status {
+		return false
+	}
+	if filter.Priority != nil && item.Priority != *filter.Priority {
+		return false
+	}
+	if len(filter.Tags) > 0 && !matchesTags(item, filter.Tags, filter.TagMode) {
+		return false
+	}
+	if filter.ProjectID != nil && (item.ProjectID == nil || *item.ProjectID != *filter.ProjectID) {
+		return false
+	}
+	if filter.DueBefore != nil && (item.DueDate == nil || !item.DueDate.Before(*filter.DueBefore)) {
+		return false
+	}
+	if filter.DueAfter != nil && (item.DueDate == nil || !item.DueDate.After(*filter.DueAfter)) {
+		return false
+	}
+	if filter.Overdue && (item.DueDate == nil || !item.DueDate.Before(now) || item.CompletedAt != nil) {
+		return false
+	}
+	if filter.NoDueDate && item.DueDate != nil {
+		return false
+	}
+	if filter.CreatedAfter != nil && !item.CreatedAt.After(*filter.CreatedAfter) {
+		return false
+	}
+	if filter.UpdatedSince != nil && item.UpdatedAt.Before(*filter.UpdatedSince) {
+		return false
+	}
+	if !filter.IncludeArchived && item.IsArchived() {
+		return false
+	}
+	return true
+}
+
+// matchesTags reports whether the todo has any or all of the tags, depending on the mode.
+func matchesTags(item domain.Todo, tags []string, mode todoUC.TagMatchMode) bool {
+	if mode == todoUC.TagMatchAll {
+		return !slices.ContainsFunc(tags, func(tag string) bool { return !item.HasTag(tag) })
+	}
+	return slices.ContainsFunc(tags, item.HasTag)
+}
+
+// compareTodos compares two todos by the sort field, breaking ties by id.
+// Todos without a due date are placed last in both directions.
+func compareTodos(a, b domain.Todo, sort todoUC.ListSort) int {
+	var c int
+	switch sort.Field {
+	case todoUC.ListSortByDueDate:
+		switch {
+		case a.DueDate == nil && b.DueDate != nil:
+			return 1
+		case a.DueDate != nil && b.DueDate == nil:
+			return -1
+		case a.DueDate != nil && b.DueDate != nil:
+			c = a.DueDate.Compare(*b.DueDate)
+		}
+	case todoUC.ListSortByUpdatedAt:
+		c = a.UpdatedAt.Compare(b.UpdatedAt)
+	case todoUC.ListSortByTitle:
+		c = strings.Compare(a.Title, b.Title)
+	case todoUC.ListSortByPriority:
+		c = cmp.Compare(a.Priority.Rank(), b.Priority.Rank())
+	default:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if c == 0 {
+		c = strings.Compare(a.ID, b.ID)
+	}
+	if sort.Direction == todoUC.SortDescending {
+		return -c
+	}
+	return c
+}
+
+// todoFromCursor rebuilds the sortable keys of the todo a cursor points at.
+func todoFromCursor(cursor todoUC.ListCursor) domain.Todo {
+	return domain.Todo{
+		ID:        cursor.ID,
+		Title:     cursor.Title,
+		Priority:  cursor.Priority,
+		DueDate:   cursor.DueDate,
+		CreatedAt: cursor.CreatedAt,
+		UpdatedAt: cursor.UpdatedAt,
+	}
+}
+
 func (r *todo) GetByID(_ context.Context, id string) (domain.Todo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	if item, ok := r.todos[id]; ok {
 		return item, nil
 	}
 	return domain.Todo{}, domain.ErrTodoNotFound
 }
 
-func (r *todo) DeleteByID(_ context.Context, id string) error {
-	if _, ok := r.todos[id]; !ok {
-		return domain.ErrTodoNotFound
+// Trash moves the todo to the trash, deleted at date, and returns it as it was before.
+// When versions are given the todo is only moved if it is still at one of them.
+func (r *todo) Trash(_ context.Context, id string, versions []int, date time.Time) (domain.Todo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.todos[id]
+	if !ok {
+		return domain.Todo{}, domain.ErrTodoNotFound
 	}
+	if len(versions) > 0 && !slices.Contains(versions, stored.Version) {
+		return domain.Todo{}, domain.ErrTodoVersionConflict
+	}
+	trashed := stored
+	trashed.DeletedAt = &date
+	trashed.Version++
 	delete(r.todos, id)
-	return nil
+	r.trash[id] = trashed
+	return stored, nil
 }
 
-func (r *todo) Update(_ context.Context, todo domain.Todo) (domain.Todo, error) {
-	if _, ok := r.todos[todo.ID]; ok {
-		r.todos[todo.ID] = todo
-		return todo, nil
+// DeleteByID removes the todo, whether it is in the trash or not, and returns it as it was
+// before. When versions are given the todo is only removed if it is still at one of them.
+func (r *todo) DeleteByID(_ context.Context, id string, versions []int) (domain.Todo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, todos := range []map[string]domain.Todo{r.todos, r.trash} {
+		stored, ok := todos[id]
+		if !ok {
+			continue
+		}
+		if len(versions) > 0 && !slices.Contains(versions, stored.Version) {
+			return domain.Todo{}, domain.ErrTodoVersionConflict
+		}
+		delete(todos, id)
+		delete(r.history, id)
+		return stored, nil
 	}
 	return domain.Todo{}, domain.ErrTodoNotFound
 }
+
+// Restore takes the todo out of the trash, updated at date, and returns it as it was in the trash.
+func (r *todo) Restore(_ context.Context, id string, date time.Time) (domain.Todo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.trash[id]
+	if !ok {
+		return domain.Todo{}, domain.ErrTodoNotFound
+	}
+	restored := stored
+	restored.DeletedAt = nil
+	restored.UpdatedAt = date
+	restored.Version++
+	delete(r.trash, id)
+	r.todos[id] = restored
+	return stored, nil
+}
+
+// ListTrash returns the todos in the trash, the most recently deleted first.
+func (r *todo) ListTrash(_ context.Context) ([]domain.Todo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	todos := slices.Collect(maps.Values(r.trash))
+	slices.SortFunc(todos, func(a, b domain.Todo) int {
+		if c := b.DeletedAt.Compare(*a.DeletedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return todos, nil
+}
+
+// ListTrashedBefore returns the todos moved to the trash before deletedBefore, the oldest first.
+func (r *todo) ListTrashedBefore(_ context.Context, deletedBefore time.Time) ([]domain.Todo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	todos := []domain.Todo{}
+	for _, item := range r.trash {
+		if item.DeletedAt.Before(deletedBefore) {
+			todos = append(todos, item)
+		}
+	}
+	slices.SortFunc(todos, func(a, b domain.Todo) int {
+		if c := a.DeletedAt.Compare(*b.DeletedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return todos, nil
+}
+
+// ListCompletedBefore returns the completed todos that are not archived and were completed before
+// completedBefore, the oldest first.
+func (r *todo) ListCompletedBefore(_ context.Context, completedBefore time.Time) ([]domain.Todo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	todos := []domain.Todo{}
+	for _, item := range r.todos {
+		completedAt := item.UpdatedAt
+		if item.CompletedAt != nil {
+			completedAt = *item.CompletedAt
+		}
+		if item.Status == domain.TodoStatusCompleted && !item.IsArchived() && completedAt.Before(completedBefore) {
+			todos = append(todos, item)
+		}
+	}
+	slices.SortFunc(todos, func(a, b domain.Todo) int {
+		return compareTodos(a, b, todoUC.DefaultListSort)
+	})
+	return todos, nil
+}
+
+// Update saves the todo if the stored one is still at its version, which is then incremented.
+// A change of status is appended to the status history, changed at the update date.
+func (r *todo) Update(_ context.Context, todo domain.Todo) (domain.Todo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.todos[todo.ID]
+	if !ok {
+		return domain.Todo{}, domain.ErrTodoNotFound
+	}
+	if stored.Version != todo.Version {
+		return domain.Todo{}, domain.ErrTodoVersionConflict
+	}
+	todo = withItemIDs(todo)
+	todo.Version++
+	r.todos[todo.ID] = todo
+	if stored.Status != todo.Status {
+		r.recordStatusChange(todo.ID, stored.Status, todo.Status, todo.UpdatedAt)
+	}
+	return todo, nil
+}
+
+// StatusHistory returns the status changes of the todo, the oldest first.
+func (r *todo) StatusHistory(_ context.Context, todoID string) ([]domain.StatusChange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.history[todoID]), nil
+}
+
+// recordStatusChange appends a change of status to the history of the todo. The caller holds mu.
+func (r *todo) recordStatusChange(todoID string, from, to domain.TodoStatus, date time.Time) {
+	r.history[todoID] = append(r.history[todoID], domain.StatusChange{
+		TodoID:    todoID,
+		From:      from,
+		To:        to,
+		ChangedAt: date,
+	})
+}
+
+// withItemIDs assigns an id to the checklist items added since the todo was loaded.
+func withItemIDs(t domain.Todo) domain.Todo {
+	t.Items = slices.Clone(t.Items)
+	for i := range t.Items {
+		if t.Items[i].ID == "" {
+			t.Items[i].ID = uuid.New().String()
+		}
+	}
+	return t
+}
@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+// Search returns the todos that are not archived with a word starting with any of the terms,
+// best matches first.
+// Title and description are tokenized like the query and a term matching the title
+// counts twice as much as one matching the description.
+func (r *todo) Search(_ context.Context, query todoUC.SearchQuery) ([]domain.Todo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	type match struct {
+		todo  domain.Todo
+		score int
+	}
+	matches := make([]match, 0, len(r.todos))
+	for _, item := range r.todos {
+		if item.ArchivedAt != nil || (query.Status != nil && item.Status != *query.Status) {
+			continue
+		}
+		if score := searchScore(item, query.Terms); score > 0 {
+			matches = append(matches, match{item, score})
+		}
+	}
+	slices.SortFunc(matches, func(a, b match) int {
+		if a.score != b.score {
+			return b.score - a.score
+		}
+		return compareTodos(a.todo, b.todo, todoUC.DefaultListSort)
+	})
+	if query.Limit > 0 && len(matches) > query.Limit {
+		matches = matches[:query.Limit]
+	}
+	todos := make([]domain.Todo, len(matches))
+	for i, m := range matches {
+		todos[i] = m.todo
+	}
+	return todos, nil
+}
+
+// searchScore rates how well the todo matches the search terms, zero meaning no match.
+func searchScore(item domain.Todo, terms []string) int {
+	titleWords := todoUC.SearchTerms(item.Title)
+	descriptionWords := todoUC.SearchTerms(item.Description)
+	score := 0
+	for _, term := range terms {
+		if hasWordWithPrefix(titleWords, term) {
+			score += 2
+		}
+		if hasWordWithPrefix(descriptionWords, term) {
+			score++
+		}
+	}
+	return score
+}
+
+func hasWordWithPrefix(words []string, prefix string) bool {
+	return slices.ContainsFunc(words, func(word string) bool {
+		return strings.HasPrefix(word, prefix)
+	})
+}
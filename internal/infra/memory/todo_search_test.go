@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestSearch(t *testing.T) {
+	repo := NewTodoRepository()
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	completedStatus := domain.TodoStatusCompleted
+	for i, td := range []domain.Todo{
+		{Title: "Buy milk", Status: domain.TodoStatusPending},
+		{Title: "Call mom", Description: "Ask about milk prices", Status: domain.TodoStatusPending},
+		{Title: "Bake bread", Description: "Needs milk and eggs", Status: domain.TodoStatusCompleted},
+		{Title: "Walk the dog", Status: domain.TodoStatusPending},
+		{Title: "Buy more milk", Status: domain.TodoStatusCompleted, ArchivedAt: &date},
+	} {
+		td.ID = strconv.Itoa(i)
+		td.CreatedAt = date.Add(time.Duration(i) * time.Hour)
+		repo.todos[td.ID] = td
+	}
+
+	testCases := []struct {
+		name   string
+		query  todoUC.SearchQuery
+		titles []string
+	}{
+		{"should rank title matches first", todoUC.SearchQuery{Terms: []string{"milk"}}, []string{"Buy milk", "Call mom", "Bake bread"}},
+		{"should leave out the archived todos", todoUC.SearchQuery{Terms: []string{"more"}}, []string{}},
+		{"should match word prefixes", todoUC.SearchQuery{Terms: []string{"bre"}}, []string{"Bake bread"}},
+		{"should not match inside words", todoUC.SearchQuery{Terms: []string{"ilk"}}, []string{}},
+		{"should match any of the terms", todoUC.SearchQuery{Terms: []string{"dog", "eggs"}}, []string{"Walk the dog", "Bake bread"}},
+		{"should combine with the status filter", todoUC.SearchQuery{Terms: []string{"milk"}, Status: &completedStatus}, []string{"Bake bread"}},
+		{"should apply the limit", todoUC.SearchQuery{Terms: []string{"milk"}, Limit: 1}, []string{"Buy milk"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			todos, err := repo.Search(context.Background(), tc.query)
+			assert.Nil(t, err)
+			titles := make([]string, 0, len(todos))
+			for _, td := range todos {
+				titles = append(titles, td.Title)
+			}
+			assert.Equal(t, tc.titles, titles)
+		})
+	}
+}
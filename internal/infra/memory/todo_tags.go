@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"context"
+
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+)
+
+// ListTags counts the todos labelled with each tag.
+func (r *todo) ListTags(_ context.Context) ([]todoUC.TagUsage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	counts := make(map[string]int)
+	for _, item := range r.todos {
+		for _, tag := range item.Tags {
+			counts[tag]++
+		}
+	}
+	tags := make([]todoUC.TagUsage, 0, len(counts))
+	for name, count := range counts {
+		tags = append(tags, todoUC.TagUsage{Name: name, Count: count})
+	}
+	return tags, nil
+}
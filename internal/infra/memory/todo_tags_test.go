@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestTags(t *testing.T) {
+	repo := NewTodoRepository()
+	ctx := context.Background()
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, td := range []domain.Todo{
+		{Title: "Write report", Tags: []string{"urgent", "work"}},
+		{Title: "Fix sink", Tags: []string{"home"}},
+		{Title: "Plan trip", Tags: []string{"home", "work"}},
+		{Title: "Read book"},
+	} {
+		td.ID = strconv.Itoa(i)
+		td.CreatedAt = date.Add(time.Duration(i) * time.Hour)
+		repo.todos[td.ID] = td
+	}
+
+	t.Run("should filter by tags", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			filter todoUC.ListFilter
+			titles []string
+		}{
+			{"any tag", todoUC.ListFilter{Tags: []string{"home", "urgent"}}, []string{"Write report", "Fix sink", "Plan trip"}},
+			{"all tags", todoUC.ListFilter{Tags: []string{"home", "work"}, TagMode: todoUC.TagMatchAll}, []string{"Plan trip"}},
+			{"unknown tag", todoUC.ListFilter{Tags: []string{"garden"}}, []string{}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				todos, err := repo.List(ctx, todoUC.ListQuery{Filter: tc.filter})
+				assert.Nil(t, err)
+				titles := make([]string, 0, len(todos))
+				for _, td := range todos {
+					titles = append(titles, td.Title)
+				}
+				assert.Equal(t, tc.titles, titles)
+			})
+		}
+	})
+
+	t.Run("should count the todos of each tag", func(t *testing.T) {
+		tags, err := repo.ListTags(ctx)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []todoUC.TagUsage{
+			{Name: "home", Count: 2},
+			{Name: "urgent", Count: 1},
+			{Name: "work", Count: 2},
+		}, tags)
+	})
+}
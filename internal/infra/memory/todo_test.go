@@ -2,9 +2,12 @@ package memory
 
 import (
 	"context"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	todoUC "github.com/wellingtonlope/todo-api/internal/app/usecase/todo"
 	"github.com/wellingtonlope/todo-api/internal/domain"
 )
 
@@ -31,7 +34,7 @@ func TestList(t *testing.T) {
 	repo := NewTodoRepository()
 
 	// Test empty list
-	todos, err := repo.List(context.Background(), nil)
+	todos, err := repo.List(context.Background(), todoUC.ListQuery{})
 	assert.Nil(t, err)
 	assert.Len(t, todos, 0)
 
@@ -44,7 +47,7 @@ func TestList(t *testing.T) {
 	repo.todos["3"] = todo3
 
 	// Test list all
-	todos, err = repo.List(context.Background(), nil)
+	todos, err = repo.List(context.Background(), todoUC.ListQuery{})
 	assert.Nil(t, err)
 	assert.Len(t, todos, 3)
 	assert.Contains(t, todos, todo1)
@@ -53,7 +56,7 @@ func TestList(t *testing.T) {
 
 	// Test filter by pending status
 	pendingStatus := domain.TodoStatusPending
-	pendingTodos, err := repo.List(context.Background(), &pendingStatus)
+	pendingTodos, err := repo.List(context.Background(), todoUC.ListQuery{Filter: todoUC.ListFilter{Status: &pendingStatus}})
 	assert.Nil(t, err)
 	assert.Len(t, pendingTodos, 2)
 	assert.Contains(t, pendingTodos, todo1)
@@ -61,12 +64,155 @@ func TestList(t *testing.T) {
 
 	// Test filter by completed status
 	completedStatus := domain.TodoStatusCompleted
-	completedTodos, err := repo.List(context.Background(), &completedStatus)
+	completedTodos, err := repo.List(context.Background(), todoUC.ListQuery{Filter: todoUC.ListFilter{Status: &completedStatus}})
 	assert.Nil(t, err)
 	assert.Len(t, completedTodos, 1)
 	assert.Contains(t, completedTodos, todo2)
 }
 
+func TestListFilter(t *testing.T) {
+	repo := NewTodoRepository()
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondCreated := date.Add(time.Hour)
+	now := date.Add(10 * 24 * time.Hour)
+	past := now.Add(-24 * time.Hour)
+	future := now.Add(24 * time.Hour)
+	pendingStatus := domain.TodoStatusPending
+	highPriority := domain.TodoPriorityHigh
+	projectID := "p1"
+	inputs := []domain.Todo{
+		{Title: "Overdue pending", Status: domain.TodoStatusPending, Priority: domain.TodoPriorityHigh, DueDate: &past},
+		{Title: "Overdue completed", Status: domain.TodoStatusCompleted, Priority: domain.TodoPriorityHigh, DueDate: &past, CompletedAt: &past},
+		{Title: "Due soon", Status: domain.TodoStatusPending, Priority: domain.TodoPriorityLow, DueDate: &future, ProjectID: &projectID},
+		{Title: "No due date", Status: domain.TodoStatusPending, Priority: domain.TodoPriorityNone},
+		{Title: "Archived", Status: domain.TodoStatusCompleted, Priority: domain.TodoPriorityNone, ArchivedAt: &past},
+	}
+	for i, td := range inputs {
+		td.ID = strconv.Itoa(i)
+		td.CreatedAt = date.Add(time.Duration(i) * time.Hour)
+		td.UpdatedAt = td.CreatedAt
+		repo.todos[td.ID] = td
+	}
+
+	testCases := []struct {
+		name   string
+		filter todoUC.ListFilter
+		titles []string
+	}{
+		{"due before", todoUC.ListFilter{DueBefore: &now}, []string{"Overdue pending", "Overdue completed"}},
+		{"due after", todoUC.ListFilter{DueAfter: &now}, []string{"Due soon"}},
+		{"overdue", todoUC.ListFilter{Overdue: true}, []string{"Overdue pending"}},
+		{"no due date", todoUC.ListFilter{NoDueDate: true}, []string{"No due date"}},
+		{"created after", todoUC.ListFilter{CreatedAfter: &secondCreated}, []string{"Due soon", "No due date"}},
+		{"updated since", todoUC.ListFilter{UpdatedSince: &secondCreated}, []string{"Overdue completed", "Due soon", "No due date"}},
+		{"priority", todoUC.ListFilter{Priority: &highPriority}, []string{"Overdue pending", "Overdue completed"}},
+		{"combined", todoUC.ListFilter{Status: &pendingStatus, DueBefore: &now}, []string{"Overdue pending"}},
+		{"include archived", todoUC.ListFilter{NoDueDate: true, IncludeArchived: true}, []string{"No due date", "Archived"}},
+		{"project", todoUC.ListFilter{ProjectID: &projectID}, []string{"Due soon"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			todos, err := repo.List(context.Background(), todoUC.ListQuery{Filter: tc.filter, Now: now})
+			assert.Nil(t, err)
+			titles := make([]string, 0, len(todos))
+			for _, td := range todos {
+				titles = append(titles, td.Title)
+			}
+			assert.Equal(t, tc.titles, titles)
+		})
+	}
+}
+
+func TestListPagination(t *testing.T) {
+	repo := NewTodoRepository()
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	todo1 := domain.Todo{ID: "1", Title: "Todo 1", CreatedAt: date}
+	todo2 := domain.Todo{ID: "2", Title: "Todo 2", CreatedAt: date.Add(time.Hour)}
+	todo3 := domain.Todo{ID: "3", Title: "Todo 3", CreatedAt: date.Add(time.Hour)}
+	todo4 := domain.Todo{ID: "4", Title: "Todo 4", CreatedAt: date.Add(2 * time.Hour)}
+	repo.todos["1"] = todo1
+	repo.todos["2"] = todo2
+	repo.todos["3"] = todo3
+	repo.todos["4"] = todo4
+
+	page, err := repo.List(context.Background(), todoUC.ListQuery{Limit: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.Todo{todo1, todo2}, page)
+
+	page, err = repo.List(context.Background(), todoUC.ListQuery{
+		Limit: 2,
+		After: &todoUC.ListCursor{CreatedAt: todo2.CreatedAt, ID: todo2.ID},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.Todo{todo3, todo4}, page)
+
+	page, err = repo.List(context.Background(), todoUC.ListQuery{
+		Limit: 2,
+		After: &todoUC.ListCursor{CreatedAt: todo4.CreatedAt, ID: todo4.ID},
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, page)
+}
+
+func TestListSort(t *testing.T) {
+	repo := NewTodoRepository()
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dueSoon := date.Add(24 * time.Hour)
+	dueLater := date.Add(48 * time.Hour)
+	repo.todos["1"] = domain.Todo{ID: "1", Title: "Charlie", Priority: domain.TodoPriorityLow, DueDate: &dueLater, CreatedAt: date, UpdatedAt: date.Add(3 * time.Hour)}
+	repo.todos["2"] = domain.Todo{ID: "2", Title: "Alpha", Priority: domain.TodoPriorityUrgent, CreatedAt: date.Add(time.Hour), UpdatedAt: date.Add(time.Hour)}
+	repo.todos["3"] = domain.Todo{ID: "3", Title: "Bravo", DueDate: &dueSoon, CreatedAt: date.Add(2 * time.Hour), UpdatedAt: date.Add(2 * time.Hour)}
+
+	testCases := []struct {
+		name   string
+		sort   todoUC.ListSort
+		titles []string
+	}{
+		{"due date ascending with null last", todoUC.ListSort{Field: todoUC.ListSortByDueDate, Direction: todoUC.SortAscending}, []string{"Bravo", "Charlie", "Alpha"}},
+		{"due date descending with null last", todoUC.ListSort{Field: todoUC.ListSortByDueDate, Direction: todoUC.SortDescending}, []string{"Charlie", "Bravo", "Alpha"}},
+		{"created at ascending", todoUC.ListSort{Field: todoUC.ListSortByCreatedAt, Direction: todoUC.SortAscending}, []string{"Charlie", "Alpha", "Bravo"}},
+		{"created at descending", todoUC.ListSort{Field: todoUC.ListSortByCreatedAt, Direction: todoUC.SortDescending}, []string{"Bravo", "Alpha", "Charlie"}},
+		{"updated at ascending", todoUC.ListSort{Field: todoUC.ListSortByUpdatedAt, Direction: todoUC.SortAscending}, []string{"Alpha", "Bravo", "Charlie"}},
+		{"updated at descending", todoUC.ListSort{Field: todoUC.ListSortByUpdatedAt, Direction: todoUC.SortDescending}, []string{"Charlie", "Bravo", "Alpha"}},
+		{"title ascending", todoUC.ListSort{Field: todoUC.ListSortByTitle, Direction: todoUC.SortAscending}, []string{"Alpha", "Bravo", "Charlie"}},
+		{"title descending", todoUC.ListSort{Field: todoUC.ListSortByTitle, Direction: todoUC.SortDescending}, []string{"Charlie", "Bravo", "Alpha"}},
+		{"priority ascending", todoUC.ListSort{Field: todoUC.ListSortByPriority, Direction: todoUC.SortAscending}, []string{"Bravo", "Charlie", "Alpha"}},
+		{"priority descending", todoUC.ListSort{Field: todoUC.ListSortByPriority, Direction: todoUC.SortDescending}, []string{"Alpha", "Charlie", "Bravo"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			all, err := repo.List(context.Background(), todoUC.ListQuery{Sort: tc.sort})
+			assert.Nil(t, err)
+			var titles []string
+			for _, td := range all {
+				titles = append(titles, td.Title)
+			}
+			assert.Equal(t, tc.titles, titles)
+
+			// Paginating one todo at a time must yield the same order
+			var paged []string
+			query := todoUC.ListQuery{Sort: tc.sort, Limit: 1}
+			for range len(tc.titles) + 1 {
+				page, err := repo.List(context.Background(), query)
+				assert.Nil(t, err)
+				if len(page) == 0 {
+					break
+				}
+				paged = append(paged, page[0].Title)
+				query.After = &todoUC.ListCursor{
+					ID:        page[0].ID,
+					Title:     page[0].Title,
+					Priority:  page[0].Priority,
+					DueDate:   page[0].DueDate,
+					CreatedAt: page[0].CreatedAt,
+					UpdatedAt: page[0].UpdatedAt,
+				}
+			}
+			assert.Equal(t, tc.titles, paged)
+		})
+	}
+}
+
 func TestGetByID(t *testing.T) {
 	repo := NewTodoRepository()
 	todo := domain.Todo{ID: "123", Title: "Test"}
@@ -96,12 +242,113 @@ func TestDeleteByID(t *testing.T) {
 	todo := domain.Todo{ID: "123", Title: "Test"}
 	repo.todos["123"] = todo
 
-	err := repo.DeleteByID(context.Background(), "123")
+	stale := 1
+	_, err := repo.DeleteByID(context.Background(), "123", []int{stale})
+	assert.Equal(t, domain.ErrTodoVersionConflict, err)
+
+	deleted, err := repo.DeleteByID(context.Background(), "123", nil)
 	assert.Nil(t, err)
+	assert.Equal(t, todo, deleted)
 	assert.Len(t, repo.todos, 0)
 
-	err = repo.DeleteByID(context.Background(), "999") // non-existing
+	_, err = repo.DeleteByID(context.Background(), "999", nil) // non-existing
+	assert.Equal(t, domain.ErrTodoNotFound, err)
+}
+
+func TestTrash(t *testing.T) {
+	repo := NewTodoRepository()
+	ctx := context.Background()
+	date := time.Now().UTC()
+	repo.todos["123"] = domain.Todo{ID: "123", Title: "Test", Version: 1}
+	repo.todos["456"] = domain.Todo{ID: "456", Title: "Other", Version: 1}
+
+	stale := 2
+	_, err := repo.Trash(ctx, "123", []int{stale}, date)
+	assert.Equal(t, domain.ErrTodoVersionConflict, err)
+	_, err = repo.Trash(ctx, "999", nil, date)
+	assert.Equal(t, domain.ErrTodoNotFound, err)
+
+	trashed, err := repo.Trash(ctx, "123", nil, date)
+	assert.Nil(t, err)
+	assert.Equal(t, domain.Todo{ID: "123", Title: "Test", Version: 1}, trashed)
+	_, err = repo.Trash(ctx, "456", nil, date.Add(time.Hour))
+	assert.Nil(t, err)
+	_, err = repo.GetByID(ctx, "123")
+	assert.Equal(t, domain.ErrTodoNotFound, err)
+	trash, _ := repo.ListTrash(ctx)
+	assert.Equal(t, []string{"456", "123"}, []string{trash[0].ID, trash[1].ID})
+	assert.Equal(t, 2, trash[1].Version)
+
+	trashed, err = repo.Restore(ctx, "123", date.Add(2*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, &date, trashed.DeletedAt)
+	assert.Equal(t, 2, trashed.Version)
+	restored, _ := repo.GetByID(ctx, "123")
+	assert.Nil(t, restored.DeletedAt)
+	assert.Equal(t, date.Add(2*time.Hour), restored.UpdatedAt)
+	assert.Equal(t, 3, restored.Version)
+	_, err = repo.Restore(ctx, "123", date)
 	assert.Equal(t, domain.ErrTodoNotFound, err)
+
+	_, err = repo.DeleteByID(ctx, "456", nil)
+	assert.Nil(t, err)
+	assert.Len(t, repo.trash, 0)
+}
+
+func TestListTrashedBefore(t *testing.T) {
+	repo := NewTodoRepository()
+	date := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	old, older := date.Add(-48*time.Hour), date.Add(-72*time.Hour)
+	repo.trash["1"] = domain.Todo{ID: "1", DeletedAt: &old, Version: 2}
+	repo.trash["2"] = domain.Todo{ID: "2", DeletedAt: &date, Version: 2}
+	repo.trash["3"] = domain.Todo{ID: "3", DeletedAt: &older, Version: 2}
+	repo.todos["4"] = domain.Todo{ID: "4", UpdatedAt: older, Version: 1}
+
+	todos, err := repo.ListTrashedBefore(context.Background(), date.Add(-24*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.Todo{repo.trash["3"], repo.trash["1"]}, todos)
+}
+
+func TestListCompletedBefore(t *testing.T) {
+	repo := NewTodoRepository()
+	date := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	old := date.Add(-48 * time.Hour)
+	repo.todos["1"] = domain.Todo{ID: "1", Status: domain.TodoStatusCompleted, CreatedAt: old, UpdatedAt: old, Version: 1}
+	repo.todos["2"] = domain.Todo{ID: "2", Status: domain.TodoStatusCompleted, UpdatedAt: date, Version: 1}
+	repo.todos["3"] = domain.Todo{ID: "3", Status: domain.TodoStatusPending, UpdatedAt: old, Version: 1}
+	repo.todos["4"] = domain.Todo{ID: "4", Status: domain.TodoStatusCompleted, UpdatedAt: old, ArchivedAt: &old, Version: 1}
+	repo.todos["5"] = domain.Todo{ID: "5", Status: domain.TodoStatusCompleted, UpdatedAt: old, CompletedAt: &date, Version: 1}
+	repo.todos["6"] = domain.Todo{
+		ID: "6", Status: domain.TodoStatusCompleted, CreatedAt: date, UpdatedAt: date, CompletedAt: &old, Version: 1,
+	}
+
+	todos, err := repo.ListCompletedBefore(context.Background(), date.Add(-24*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.Todo{repo.todos["1"], repo.todos["6"]}, todos)
+}
+
+func TestStatusHistory(t *testing.T) {
+	repo := NewTodoRepository()
+	ctx := context.Background()
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	completedDate := date.Add(time.Hour)
+	todo, _ := domain.NewTodo("Test", "", date, nil)
+	created, _ := repo.Create(ctx, todo)
+	completed, _ := created.Transition(domain.DefaultWorkflow(), domain.TodoStatusCompleted, completedDate)
+	_, err := repo.Update(ctx, completed)
+	assert.Nil(t, err)
+
+	history, err := repo.StatusHistory(ctx, created.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.StatusChange{
+		{TodoID: created.ID, To: domain.TodoStatusPending, ChangedAt: date},
+		{TodoID: created.ID, From: domain.TodoStatusPending, To: domain.TodoStatusCompleted, ChangedAt: completedDate},
+	}, history)
+
+	_, err = repo.DeleteByID(ctx, created.ID, nil)
+	assert.Nil(t, err)
+	history, _ = repo.StatusHistory(ctx, created.ID)
+	assert.Empty(t, history)
 }
 
 func TestUpdate(t *testing.T) {
@@ -112,10 +359,27 @@ func TestUpdate(t *testing.T) {
 	updatedTodo := domain.Todo{ID: "123", Title: "Updated", Description: "New Desc"}
 	result, err := repo.Update(context.Background(), updatedTodo)
 	assert.Nil(t, err)
-	assert.Equal(t, updatedTodo, result)
+	assert.Equal(t, domain.Todo{ID: "123", Title: "Updated", Description: "New Desc", Version: 1}, result)
 	retrieved, _ := repo.GetByID(context.Background(), "123")
-	assert.Equal(t, updatedTodo, retrieved)
+	assert.Equal(t, result, retrieved)
+
+	_, err = repo.Update(context.Background(), updatedTodo) // stale version
+	assert.Equal(t, domain.ErrTodoVersionConflict, err)
 
 	_, err = repo.Update(context.Background(), domain.Todo{ID: "999", Title: "Non-existing"})
 	assert.Equal(t, domain.ErrTodoNotFound, err)
 }
+
+func TestUpdateAssignsItemIDs(t *testing.T) {
+	repo := NewTodoRepository()
+	repo.todos["123"] = domain.Todo{ID: "123", Items: []domain.ChecklistItem{{ID: "1", Title: "first"}}}
+
+	updatedTodo := domain.Todo{ID: "123", Items: []domain.ChecklistItem{{ID: "1", Title: "first"}, {Title: "second"}}}
+	result, err := repo.Update(context.Background(), updatedTodo)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", result.Items[0].ID)
+	assert.NotEmpty(t, result.Items[1].ID)
+	assert.Empty(t, updatedTodo.Items[1].ID, "the given todo must not change")
+	retrieved, _ := repo.GetByID(context.Background(), "123")
+	assert.Equal(t, result, retrieved)
+}
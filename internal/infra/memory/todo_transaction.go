@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"context"
+	"maps"
+)
+
+type unitContextKey struct{}
+
+// Transaction runs fn as a unit of work on the todos. Units of work run one at a time and,
+// when fn fails, the todos and their status history are restored as they were before it. A unit of work started
+// inside another one is part of it and only undoes its own changes when it fails.
+func (r *todo) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(unitContextKey{}) != r {
+		r.unit.Lock()
+		defer r.unit.Unlock()
+		ctx = context.WithValue(ctx, unitContextKey{}, r)
+	}
+	r.mu.RLock()
+	todos, trash, history := maps.Clone(r.todos), maps.Clone(r.trash), maps.Clone(r.history)
+	r.mu.RUnlock()
+	if err := fn(ctx); err != nil {
+		r.mu.Lock()
+		r.todos, r.trash, r.history = todos, trash, history
+		r.mu.Unlock()
+		return err
+	}
+	return nil
+}
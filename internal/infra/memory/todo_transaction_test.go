@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestTransaction(t *testing.T) {
+	t.Run("should keep the changes when the work succeeds", func(t *testing.T) {
+		repo := NewTodoRepository()
+		var created domain.Todo
+		err := repo.Transaction(context.Background(), func(ctx context.Context) error {
+			var err error
+			created, err = repo.Create(ctx, domain.Todo{Title: "Committed"})
+			return err
+		})
+		assert.Nil(t, err)
+		got, err := repo.GetByID(context.Background(), created.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, "Committed", got.Title)
+	})
+
+	t.Run("should undo every change when the work fails", func(t *testing.T) {
+		repo := NewTodoRepository()
+		existing, _ := repo.Create(context.Background(), domain.Todo{Title: "Existing", Status: domain.TodoStatusPending})
+		history, _ := repo.StatusHistory(context.Background(), existing.ID)
+		err := repo.Transaction(context.Background(), func(ctx context.Context) error {
+			_, _ = repo.Create(ctx, domain.Todo{Title: "Rolled back", Status: domain.TodoStatusPending})
+			completed := existing
+			completed.Status = domain.TodoStatusCompleted
+			completed, err := repo.Update(ctx, completed)
+			if err != nil {
+				return err
+			}
+			if _, err := repo.DeleteByID(ctx, completed.ID, nil); err != nil {
+				return err
+			}
+			return assert.AnError
+		})
+		assert.Equal(t, assert.AnError, err)
+		assert.Len(t, repo.todos, 1)
+		assert.Equal(t, existing, repo.todos[existing.ID])
+		assert.Len(t, repo.history, 1)
+		got, _ := repo.StatusHistory(context.Background(), existing.ID)
+		assert.Equal(t, history, got)
+	})
+
+	t.Run("should only undo the changes of a failed inner unit of work", func(t *testing.T) {
+		repo := NewTodoRepository()
+		err := repo.Transaction(context.Background(), func(ctx context.Context) error {
+			_, _ = repo.Create(ctx, domain.Todo{Title: "Outer"})
+			innerErr := repo.Transaction(ctx, func(ctx context.Context) error {
+				_, _ = repo.Create(ctx, domain.Todo{Title: "Inner"})
+				return assert.AnError
+			})
+			assert.Equal(t, assert.AnError, innerErr)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Len(t, repo.todos, 1)
+		for _, item := range repo.todos {
+			assert.Equal(t, "Outer", item.Title)
+		}
+	})
+
+	t.Run("should run concurrent units of work one at a time", func(t *testing.T) {
+		repo := NewTodoRepository()
+		created, _ := repo.Create(context.Background(), domain.Todo{Title: "Counter"})
+		const workers = 50
+		var wg sync.WaitGroup
+		errs := make(chan error, workers)
+		for range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs <- repo.Transaction(context.Background(), func(ctx context.Context) error {
+					todo, err := repo.GetByID(ctx, created.ID)
+					if err != nil {
+						return err
+					}
+					todo.Title += "+"
+					_, err = repo.Update(ctx, todo)
+					return err
+				})
+			}()
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			assert.Nil(t, err)
+		}
+		got, _ := repo.GetByID(context.Background(), created.ID)
+		assert.Equal(t, 1+workers, got.Version)
+	})
+}
@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+)
+
+const (
+	// Timeout is how long a webhook has to answer a delivery before the attempt fails.
+	Timeout = 10 * time.Second
+	// HeaderDelivery identifies the delivery, the same for every attempt of it.
+	HeaderDelivery = "X-Webhook-ID"
+	// HeaderEvent is the type of the event delivered.
+	HeaderEvent = "X-Webhook-Event"
+	// HeaderSignature is the HMAC-SHA256 of the body with the secret of the webhook, as sha256=<hex>.
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+type sender struct {
+	client *http.Client
+}
+
+// NewSender returns a webhook.Sender which POSTs the deliveries with an HTTP client.
+func NewSender() *sender {
+	return &sender{client: &http.Client{Timeout: Timeout}}
+}
+
+func (s *sender) Send(ctx context.Context, request webhook.Request) (int, error) {
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Payload))
+	if err != nil {
+		return 0, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set(HeaderDelivery, request.DeliveryID)
+	httpRequest.Header.Set(HeaderEvent, string(request.EventType))
+	httpRequest.Header.Set(HeaderSignature, Sign(request.Secret, request.Payload))
+	response, err := s.client.Do(httpRequest)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	// the body is drained so that the connection is reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+	return response.StatusCode, nil
+}
+
+// Sign returns the signature of the body with the secret, as sent in HeaderSignature. Receivers
+// check a delivery computing it from the raw body and comparing it with hmac.Equal.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
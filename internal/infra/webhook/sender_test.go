@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/internal/app/usecase/webhook"
+	"github.com/wellingtonlope/todo-api/internal/domain"
+)
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		Sign("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
+
+func TestSender_Send(t *testing.T) {
+	payload := json.RawMessage(`{"id":"e1","type":"todo.created"}`)
+
+	t.Run("should post the signed payload to the webhook", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer receiver.Close()
+
+		status, err := NewSender().Send(context.Background(), webhook.Request{
+			URL:        receiver.URL + "/hooks",
+			Secret:     "s3cret",
+			DeliveryID: "d1",
+			EventType:  domain.EventTodoCreated,
+			Payload:    payload,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, status)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "/hooks", received.URL.Path)
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, "d1", received.Header.Get(HeaderDelivery))
+		assert.Equal(t, "todo.created", received.Header.Get(HeaderEvent))
+		assert.JSONEq(t, string(payload), string(body))
+		assert.True(t, hmac.Equal([]byte(Sign("s3cret", body)), []byte(received.Header.Get(HeaderSignature))))
+	})
+
+	t.Run("should return the status of an error response", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer receiver.Close()
+
+		status, err := NewSender().Send(context.Background(), webhook.Request{URL: receiver.URL, Secret: "s3cret", Payload: payload})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, status)
+	})
+
+	t.Run("should fail when the webhook cannot be reached", func(t *testing.T) {
+		receiver := httptest.NewServer(http.NotFoundHandler())
+		receiver.Close()
+
+		status, err := NewSender().Send(context.Background(), webhook.Request{URL: receiver.URL, Secret: "s3cret", Payload: payload})
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, status)
+	})
+}
@@ -0,0 +1,247 @@
+// Package jsonpatch applies JSON Patch documents (RFC 6902) with the add, remove,
+// replace and test operations.
+package jsonpatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	// OpAdd adds a value to an object or inserts it into an array.
+	OpAdd = "add"
+	// OpRemove removes the value at the path.
+	OpRemove = "remove"
+	// OpReplace replaces the value at the path, which must exist.
+	OpReplace = "replace"
+	// OpTest checks that the value at the path is equal to the given value.
+	OpTest = "test"
+)
+
+var (
+	// ErrInvalidOperation is returned for malformed operations and paths that cannot be applied.
+	ErrInvalidOperation = errors.New("invalid JSON patch operation")
+	// ErrTestFailed is returned when a test operation does not hold.
+	ErrTestFailed = errors.New("JSON patch test failed")
+)
+
+// Operation is one operation of a JSON Patch. Value is nil when the operation has no value,
+// which is different from a JSON null.
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Apply applies the operations in order to the JSON document. The patch is atomic: when an
+// operation fails none of them is applied.
+//
+// Returns:
+//   - []byte: the patched document
+//   - error: ErrInvalidOperation if an operation cannot be applied, ErrTestFailed if a test does not hold
+func Apply(document []byte, operations []Operation) ([]byte, error) {
+	var doc any
+	if err := json.Unmarshal(document, &doc); err != nil {
+		return nil, err
+	}
+	for _, op := range operations {
+		var err error
+		doc, err = apply(doc, op)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(doc)
+}
+
+func apply(doc any, op Operation) (any, error) {
+	tokens, err := parsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	switch op.Op {
+	case OpAdd, OpReplace, OpTest:
+		if op.Value == nil {
+			return nil, fmt.Errorf("%w: %s at %q must have a value", ErrInvalidOperation, op.Op, op.Path)
+		}
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return nil, fmt.Errorf("%w: %s at %q has an invalid value", ErrInvalidOperation, op.Op, op.Path)
+		}
+	case OpRemove:
+	default:
+		return nil, fmt.Errorf("%w: operation %q is not supported", ErrInvalidOperation, op.Op)
+	}
+
+	if op.Op == OpTest {
+		current, err := get(doc, tokens, op.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(current, value) {
+			return nil, fmt.Errorf("%w: value at %q is not %s", ErrTestFailed, op.Path, op.Value)
+		}
+		return doc, nil
+	}
+	if len(tokens) == 0 {
+		if op.Op == OpRemove {
+			return nil, fmt.Errorf("%w: the whole document cannot be removed", ErrInvalidOperation)
+		}
+		return value, nil
+	}
+	return change(doc, tokens, op.Path, func(parent any, key string) (any, error) {
+		switch op.Op {
+		case OpAdd:
+			return addTo(parent, key, value, op.Path)
+		case OpRemove:
+			return removeFrom(parent, key, op.Path)
+		default:
+			return replaceIn(parent, key, value, op.Path)
+		}
+	})
+}
+
+// parsePointer splits a JSON Pointer (RFC 6901) into its unescaped reference tokens.
+func parsePointer(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("%w: path %q must start with /", ErrInvalidOperation, path)
+	}
+	tokens := strings.Split(path[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func get(node any, tokens []string, path string) (any, error) {
+	for _, key := range tokens {
+		switch n := node.(type) {
+		case map[string]any:
+			child, ok := n[key]
+			if !ok {
+				return nil, notFound(path)
+			}
+			node = child
+		case []any:
+			i, err := arrayIndex(key, len(n)-1, path)
+			if err != nil {
+				return nil, err
+			}
+			node = n[i]
+		default:
+			return nil, notFound(path)
+		}
+	}
+	return node, nil
+}
+
+// change walks down to the parent of the last token and returns the node with leaf applied to that parent.
+func change(node any, tokens []string, path string, leaf func(parent any, key string) (any, error)) (any, error) {
+	if len(tokens) == 1 {
+		return leaf(node, tokens[0])
+	}
+	key := tokens[0]
+	switch n := node.(type) {
+	case map[string]any:
+		child, ok := n[key]
+		if !ok {
+			return nil, notFound(path)
+		}
+		child, err := change(child, tokens[1:], path, leaf)
+		if err != nil {
+			return nil, err
+		}
+		n[key] = child
+		return n, nil
+	case []any:
+		i, err := arrayIndex(key, len(n)-1, path)
+		if err != nil {
+			return nil, err
+		}
+		child, err := change(n[i], tokens[1:], path, leaf)
+		if err != nil {
+			return nil, err
+		}
+		n[i] = child
+		return n, nil
+	}
+	return nil, notFound(path)
+}
+
+func addTo(parent any, key string, value any, path string) (any, error) {
+	switch n := parent.(type) {
+	case map[string]any:
+		n[key] = value
+		return n, nil
+	case []any:
+		if key == "-" {
+			return append(n, value), nil
+		}
+		i, err := arrayIndex(key, len(n), path)
+		if err != nil {
+			return nil, err
+		}
+		n = append(n, nil)
+		copy(n[i+1:], n[i:])
+		n[i] = value
+		return n, nil
+	}
+	return nil, notFound(path)
+}
+
+func removeFrom(parent any, key string, path string) (any, error) {
+	switch n := parent.(type) {
+	case map[string]any:
+		if _, ok := n[key]; !ok {
+			return nil, notFound(path)
+		}
+		delete(n, key)
+		return n, nil
+	case []any:
+		i, err := arrayIndex(key, len(n)-1, path)
+		if err != nil {
+			return nil, err
+		}
+		return append(n[:i], n[i+1:]...), nil
+	}
+	return nil, notFound(path)
+}
+
+func replaceIn(parent any, key string, value any, path string) (any, error) {
+	switch n := parent.(type) {
+	case map[string]any:
+		if _, ok := n[key]; !ok {
+			return nil, notFound(path)
+		}
+		n[key] = value
+		return n, nil
+	case []any:
+		i, err := arrayIndex(key, len(n)-1, path)
+		if err != nil {
+			return nil, err
+		}
+		n[i] = value
+		return n, nil
+	}
+	return nil, notFound(path)
+}
+
+// arrayIndex parses an array index between 0 and maxIndex, without leading zeros.
+func arrayIndex(key string, maxIndex int, path string) (int, error) {
+	i, err := strconv.Atoi(key)
+	if err != nil || i < 0 || i > maxIndex || (len(key) > 1 && key[0] == '0') || key[0] == '+' {
+		return 0, notFound(path)
+	}
+	return i, nil
+}
+
+func notFound(path string) error {
+	return fmt.Errorf("%w: path %q does not exist", ErrInvalidOperation, path)
+}
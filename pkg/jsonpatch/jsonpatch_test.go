@@ -0,0 +1,103 @@
+package jsonpatch_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wellingtonlope/todo-api/pkg/jsonpatch"
+)
+
+func TestApply(t *testing.T) {
+	document := `{"title":"buy milk","tags":["home","shop"],"a/b":{"~c":1},"due_date":null}`
+	testCases := []struct {
+		name       string
+		operations string
+		result     string
+		err        error
+	}{
+		{
+			name:       "should replace a member",
+			operations: `[{"op":"replace","path":"/title","value":"buy bread"}]`,
+			result:     `{"a/b":{"~c":1},"due_date":null,"tags":["home","shop"],"title":"buy bread"}`,
+		},
+		{
+			name:       "should add a member and insert and append to arrays",
+			operations: `[{"op":"add","path":"/priority","value":"high"},{"op":"add","path":"/tags/1","value":"work"},{"op":"add","path":"/tags/-","value":"late"}]`,
+			result:     `{"a/b":{"~c":1},"due_date":null,"priority":"high","tags":["home","work","shop","late"],"title":"buy milk"}`,
+		},
+		{
+			name:       "should remove a member and an array element",
+			operations: `[{"op":"remove","path":"/title"},{"op":"remove","path":"/tags/0"}]`,
+			result:     `{"a/b":{"~c":1},"due_date":null,"tags":["shop"]}`,
+		},
+		{
+			name:       "should follow escaped pointers",
+			operations: `[{"op":"replace","path":"/a~1b/~0c","value":2}]`,
+			result:     `{"a/b":{"~c":2},"due_date":null,"tags":["home","shop"],"title":"buy milk"}`,
+		},
+		{
+			name:       "should apply the operations after a passing test",
+			operations: `[{"op":"test","path":"/due_date","value":null},{"op":"test","path":"/tags","value":["home","shop"]},{"op":"replace","path":"/due_date","value":"2030-01-01T00:00:00Z"}]`,
+			result:     `{"a/b":{"~c":1},"due_date":"2030-01-01T00:00:00Z","tags":["home","shop"],"title":"buy milk"}`,
+		},
+		{
+			name:       "should fail when a test does not hold",
+			operations: `[{"op":"replace","path":"/title","value":"buy bread"},{"op":"test","path":"/title","value":"buy milk"}]`,
+			err:        jsonpatch.ErrTestFailed,
+		},
+		{
+			name:       "should fail when replacing a missing member",
+			operations: `[{"op":"replace","path":"/status","value":"completed"}]`,
+			err:        jsonpatch.ErrInvalidOperation,
+		},
+		{
+			name:       "should fail when an array index is out of range",
+			operations: `[{"op":"add","path":"/tags/3","value":"work"}]`,
+			err:        jsonpatch.ErrInvalidOperation,
+		},
+		{
+			name:       "should fail when an array index has leading zeros",
+			operations: `[{"op":"remove","path":"/tags/01"}]`,
+			err:        jsonpatch.ErrInvalidOperation,
+		},
+		{
+			name:       "should fail when the value is missing",
+			operations: `[{"op":"add","path":"/status"}]`,
+			err:        jsonpatch.ErrInvalidOperation,
+		},
+		{
+			name:       "should fail when the path is not a pointer",
+			operations: `[{"op":"remove","path":"title"}]`,
+			err:        jsonpatch.ErrInvalidOperation,
+		},
+		{
+			name:       "should fail when the operation is not supported",
+			operations: `[{"op":"move","from":"/title","path":"/name"}]`,
+			err:        jsonpatch.ErrInvalidOperation,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var operations []jsonpatch.Operation
+			assert.NoError(t, json.Unmarshal([]byte(tc.operations), &operations))
+			result, err := jsonpatch.Apply([]byte(document), operations)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "expected %v, got %v", tc.err, err)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.result, string(result))
+		})
+	}
+}
+
+func TestApply_ReplaceDocument(t *testing.T) {
+	result, err := jsonpatch.Apply([]byte(`{"a":1}`), []jsonpatch.Operation{
+		{Op: jsonpatch.OpReplace, Path: "", Value: json.RawMessage(`{"b":2}`)},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"b":2}`, string(result))
+}
@@ -0,0 +1,55 @@
+Feature: Audit Log
+
+  Background:
+    Given the database is reset
+
+  Scenario: Record who created, changed and deleted a todo
+    Given "alice" has created a todo "Write report"
+    And "bob" has updated the title of the todo "Write report" to "Write the report"
+    And "bob" has completed the todo "Write report"
+    And "alice" has deleted the todo "Write report"
+    When I list the audit log of the todo "Write report"
+    Then the response should have status 200
+    And the audit log should be "alice create, bob update, bob complete, alice delete"
+    And the audit record 2 should change "title" from "Write report" to "Write the report"
+    And the audit record 3 should change "status" from "pending" to "completed"
+
+  Scenario: Record the archive, move and restore of a todo
+    Given "alice" has created a todo "Write report"
+    And "bob" has archived the todo "Write report"
+    And "bob" has unarchived the todo "Write report"
+    And "bob" has moved the todo "Write report" to a new project "Work"
+    And "alice" has deleted the todo "Write report"
+    And "alice" has restored the todo "Write report"
+    When I list the audit log of the todo "Write report"
+    Then the response should have status 200
+    And the audit log should be "alice create, bob archive, bob unarchive, bob move, alice delete, alice restore"
+
+  Scenario: Record the changes to the todos of a deleted project
+    Given "alice" has created a todo "Write report"
+    And "alice" has created a todo "Plan trip"
+    And "bob" has moved the todo "Write report" to a new project "Work"
+    And "bob" has moved the todo "Plan trip" to a new project "Home"
+    And "carol" has deleted the project of the todo "Write report" with todos policy "cascade"
+    And "carol" has deleted the project of the todo "Plan trip" with todos policy "orphan"
+    When I list the audit log of the todo "Write report"
+    Then the audit log should be "alice create, bob move, carol delete"
+    When I list the audit log of the todo "Plan trip"
+    Then the audit log should be "alice create, bob move, carol move"
+
+  Scenario: Record the changes of requests without an actor as anonymous
+    Given "" has created a todo "Write report"
+    When I list the audit log of the todo "Write report"
+    Then the response should have status 200
+    And the audit log should be "anonymous create"
+
+  Scenario: List the records since a date
+    Given "alice" has created a todo "Write report"
+    When I list the audit log since "2999-01-01T00:00:00Z"
+    Then the response should have status 200
+    And the audit log should be ""
+
+  Scenario: Refuse an invalid since date
+    When I list the audit log since "yesterday"
+    Then the response should have status 400
+    And the response should contain error message "invalid since: must be an RFC 3339 date"
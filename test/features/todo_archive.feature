@@ -0,0 +1,33 @@
+Feature: Todo Archive
+
+  Background:
+    Given the database is reset
+
+  Scenario: Archived todos are hidden from the list
+    Given I have created a todo "Buy groceries"
+    And I have created a todo "Write report"
+    And I have completed the todo "Buy groceries"
+    When I archive the todo "Buy groceries"
+    Then the response should have status 200
+    And the todo should be archived with status "completed"
+    And the todos should be "Write report"
+    And the todos including the archived ones should be "Buy groceries, Write report"
+
+  Scenario: Archive a pending todo
+    Given I have created a todo "Write report"
+    When I archive the todo "Write report"
+    Then the response should have status 200
+    And the todo should be archived with status "pending"
+
+  Scenario: Unarchive a todo
+    Given I have created a todo "Buy groceries"
+    And I have archived the todo "Buy groceries"
+    When I unarchive the todo "Buy groceries"
+    Then the response should have status 200
+    And the todo should not be archived
+    And the todos should be "Buy groceries"
+
+  Scenario: Fail to archive a todo that does not exist
+    When I archive the todo with ID "nonexistent-id"
+    Then the response should have status 404
+    And the response should contain error message "todo not found with id nonexistent-id"
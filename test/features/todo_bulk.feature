@@ -0,0 +1,73 @@
+Feature: Todo Bulk Operations
+
+  Background:
+    Given the database is reset
+
+  Scenario: Run several operations reporting each result
+    Given I have created the todos "Buy milk" and "Walk dog"
+    When I send the bulk operations:
+      | op       | todo     | title      |
+      | create   |          | Pay rent   |
+      | update   | Walk dog | Walk cat   |
+      | complete | Buy milk |            |
+      | delete   | Missing  |            |
+    Then the response should have status 200
+    And the bulk results should be:
+      | op       | status | error                             |
+      | create   | 201    |                                   |
+      | update   | 200    |                                   |
+      | complete | 200    |                                   |
+      | delete   | 404    | todo not found with id Missing-id |
+    And the todos should be:
+      | title    | status    |
+      | Buy milk | completed |
+      | Pay rent | pending   |
+      | Walk cat | pending   |
+
+  Scenario: Commit an atomic request when every operation succeeds
+    Given I have created the todos "Buy milk" and "Walk dog"
+    When I send the atomic bulk operations:
+      | op       | todo     | title    |
+      | create   |          | Pay rent |
+      | complete | Buy milk |          |
+      | delete   | Walk dog |          |
+    Then the response should have status 200
+    And the bulk results should be:
+      | op       | status | error |
+      | create   | 201    |       |
+      | complete | 200    |       |
+      | delete   | 204    |       |
+    And the todos should be:
+      | title    | status    |
+      | Buy milk | completed |
+      | Pay rent | pending   |
+
+  Scenario: Roll back an atomic request on the first failure
+    Given I have created the todos "Buy milk" and "Walk dog"
+    When I send the atomic bulk operations:
+      | op       | todo     | title    |
+      | create   |          | Pay rent |
+      | delete   | Buy milk |          |
+      | update   | Walk dog |          |
+      | complete | Walk dog |          |
+    Then the response should have status 200
+    And the bulk results should be:
+      | op       | status | error                           |
+      | create   | 424    | rolled back: operation 2 failed |
+      | delete   | 424    | rolled back: operation 2 failed |
+      | update   | 400    | todo invalid input: title       |
+      | complete | 424    | not run: operation 2 failed     |
+    And the todos should be:
+      | title    | status  |
+      | Buy milk | pending |
+      | Walk dog | pending |
+
+  Scenario: Fail a request with an unknown operation
+    When I send the bulk operations:
+      | op      | todo | title    |
+      | create  |      | Pay rent |
+      | archive |      |          |
+    Then the response should have status 400
+    And the response should contain error message "invalid op of operation 1: must be 'create', 'update', 'complete', 'pending' or 'delete'"
+    And the todos should be:
+      | title | status |
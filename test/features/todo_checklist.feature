@@ -0,0 +1,84 @@
+Feature: Todo Checklist
+
+  Background:
+    Given the database is reset
+
+  Scenario: Add checklist items to a todo
+    Given I have created a todo "Paint the room" with the checklist ""
+    When I add the item "buy paint"
+    And I add the item "paint the walls"
+    Then the response should have status 201
+    And the checklist should be "[ ] buy paint, [ ] paint the walls"
+
+  Scenario: Fail to add a checklist item without title
+    Given I have created a todo "Paint the room" with the checklist ""
+    When I add the item "  "
+    Then the response should have status 400
+    And the response should contain error message "todo invalid input: item title must have between 1 and 200 characters"
+
+  Scenario: Toggle a checklist item
+    Given I have created a todo "Paint the room" with the checklist "buy paint,paint the walls"
+    When I toggle the item "buy paint"
+    Then the response should have status 200
+    And the checklist should be "[x] buy paint, [ ] paint the walls"
+    When I toggle the item "buy paint"
+    Then the checklist should be "[ ] buy paint, [ ] paint the walls"
+
+  Scenario: Remove a checklist item
+    Given I have created a todo "Paint the room" with the checklist "buy paint,paint the walls,clean up"
+    When I remove the item "paint the walls"
+    Then the response should have status 200
+    And the checklist should be "[ ] buy paint, [ ] clean up"
+
+  Scenario: Reorder the checklist items
+    Given I have created a todo "Paint the room" with the checklist "buy paint,paint the walls,clean up"
+    When I reorder the items as "clean up,buy paint,paint the walls"
+    Then the response should have status 200
+    When I get the todo
+    Then the checklist should be "[ ] clean up, [ ] buy paint, [ ] paint the walls"
+
+  Scenario: Fail to reorder without every checklist item
+    Given I have created a todo "Paint the room" with the checklist "buy paint,paint the walls"
+    When I reorder the items as "paint the walls"
+    Then the response should have status 400
+    And the response should contain error message "todo invalid input: item ids must list every checklist item exactly once"
+
+  Scenario: Fail to toggle an unknown checklist item
+    Given I have created a todo "Paint the room" with the checklist "buy paint"
+    When I toggle the item "unknown"
+    Then the response should have status 404
+    And the response should contain error message "checklist item not found with id unknown"
+
+  Scenario: Complete a todo leaving its open items by default
+    Given I have created a todo "Paint the room" with the checklist "buy paint,paint the walls"
+    When I complete the todo
+    Then the response should have status 200
+    And the todo status should be "completed"
+    And the checklist should be "[ ] buy paint, [ ] paint the walls"
+
+  Scenario: Refuse to complete a todo with open items
+    Given I have created a todo "Paint the room" with the checklist "buy paint,paint the walls"
+    And I toggle the item "buy paint"
+    When I complete the todo with open items "refuse"
+    Then the response should have status 409
+    And the response should contain error message "cannot complete a todo with 1 open checklist items"
+
+  Scenario: Complete a todo when every item is done
+    Given I have created a todo "Paint the room" with the checklist "buy paint"
+    And I toggle the item "buy paint"
+    When I complete the todo with open items "refuse"
+    Then the response should have status 200
+    And the todo status should be "completed"
+
+  Scenario: Complete the open items together with the todo
+    Given I have created a todo "Paint the room" with the checklist "buy paint,paint the walls"
+    When I complete the todo with open items "cascade"
+    Then the response should have status 200
+    And the todo status should be "completed"
+    And the checklist should be "[x] buy paint, [x] paint the walls"
+
+  Scenario: Fail to complete with an invalid open items policy
+    Given I have created a todo "Paint the room" with the checklist ""
+    When I complete the todo with open items "ignore"
+    Then the response should have status 400
+    And the response should contain error message "invalid open_items: must be 'allow', 'refuse' or 'cascade'"
@@ -0,0 +1,83 @@
+Feature: Todo Optimistic Concurrency
+
+  Background:
+    Given the database is reset
+
+  Scenario: Get a todo with its ETag
+    Given I have created a todo "Buy milk"
+    When I request the todo
+    Then the response should have status 200
+    And the response should have the ETag of version 1
+
+  Scenario: Update a todo at its current version
+    Given I have created a todo "Buy milk"
+    When I rename the todo to "Buy oat milk" at version 1
+    Then the response should have status 200
+    And the response should have the ETag of version 2
+
+  Scenario: Fail to update a todo changed since it was read
+    Given I have created a todo "Buy milk"
+    And the todo has been renamed to "Buy bread"
+    When I rename the todo to "Buy oat milk" at version 1
+    Then the response should have status 412
+    And the response should contain error message "todo has changed: expected version 1, current version is 2"
+    And the todo should be titled "Buy bread"
+
+  Scenario: Update a todo without If-Match
+    Given I have created a todo "Buy milk"
+    And the todo has been renamed to "Buy bread"
+    When I rename the todo to "Buy oat milk"
+    Then the response should have status 200
+    And the response should have the ETag of version 3
+
+  Scenario: Update a todo with If-Match any version
+    Given I have created a todo "Buy milk"
+    When I rename the todo to "Buy oat milk" with the If-Match header '*'
+    Then the response should have status 200
+    And the response should have the ETag of version 2
+
+  Scenario: Fail to update a todo with an invalid If-Match
+    Given I have created a todo "Buy milk"
+    When I rename the todo to "Buy oat milk" with the If-Match header 'v1'
+    Then the response should have status 400
+    And the response should contain error message "invalid If-Match header: must be a list of ETags or *"
+
+  Scenario: Fail to update a todo with a weak If-Match
+    Given I have created a todo "Buy milk"
+    When I rename the todo to "Buy oat milk" with the If-Match header 'W/"1"'
+    Then the response should have status 412
+
+  Scenario: Update a todo at one of the If-Match versions
+    Given I have created a todo "Buy milk"
+    And the todo has been renamed to "Buy bread"
+    When I rename the todo to "Buy oat milk" with the If-Match header '"1", "2"'
+    Then the response should have status 200
+    And the response should have the ETag of version 3
+
+  Scenario: Complete and reopen a todo at their current versions
+    Given I have created a todo "Buy milk"
+    When I complete the todo at version 1
+    Then the response should have status 200
+    And the response should have the ETag of version 2
+    When I mark the todo as pending at version 2
+    Then the response should have status 200
+    And the response should have the ETag of version 3
+
+  Scenario: Fail to complete a todo changed since it was read
+    Given I have created a todo "Buy milk"
+    And the todo has been renamed to "Buy bread"
+    When I complete the todo at version 1
+    Then the response should have status 412
+
+  Scenario: Fail to delete a todo changed since it was read
+    Given I have created a todo "Buy milk"
+    And the todo has been renamed to "Buy bread"
+    When I delete the todo at version 1
+    Then the response should have status 412
+    And the response should contain error message "todo has changed: expected version 1"
+    And the todo should be titled "Buy bread"
+
+  Scenario: Delete a todo at its current version
+    Given I have created a todo "Buy milk"
+    When I delete the todo at version 1
+    Then the response should have status 204
@@ -22,4 +22,23 @@
      Examples:
        | title | description | due_date              |
        |       |             |                       |
-       | Test  | Desc        | 2020-01-01T00:00:00Z  |
\ No newline at end of file
+       | Test  | Desc        | 2020-01-01T00:00:00Z  |
+   Scenario Outline: Successfully create a todo with a priority
+     Given I have a todo input with title "Pay rent", description "" and due_date ""
+     And the todo input has priority "<priority>"
+     When I create the todo
+     Then the todo should be created successfully
+
+     Examples:
+       | priority |
+       | none     |
+       | low      |
+       | medium   |
+       | high     |
+       | urgent   |
+
+   Scenario: Fail to create a todo with an invalid priority
+     Given I have a todo input with title "Pay rent", description "" and due_date ""
+     And the todo input has priority "critical"
+     When I create the todo
+     Then the creation should fail with validation error
@@ -0,0 +1,31 @@
+Feature: Todo Events Stream
+
+  Background:
+    Given the database is reset
+
+  Scenario: Push the changes of the todos
+    Given I connect to the todo events stream
+    And I have created a todo "Write report"
+    And I have completed the todo "Write report"
+    And I have deleted the todo "Write report"
+    Then the stream response should have status 200
+    And the stream should push the events "todo.created,todo.completed,todo.deleted"
+    And the events should be about the todo "Write report"
+
+  Scenario: Only push the events of the todos with a status
+    Given I connect to the todo events stream with the status "completed"
+    And I have created a todo "Write report"
+    And I have completed the todo "Write report"
+    Then the stream should push the events "todo.completed"
+
+  Scenario: Resume after the last event received
+    Given I connect to the todo events stream
+    And I have created a todo "Write report"
+    And I have completed the todo "Write report"
+    And the stream should push the events "todo.created,todo.completed"
+    When I reconnect to the todo events stream after the first event received
+    Then the stream should push the events "todo.completed"
+
+  Scenario: Refuse an invalid Last-Event-ID
+    When I connect to the todo events stream with the Last-Event-ID "yesterday"
+    Then the stream response should have status 400
@@ -0,0 +1,39 @@
+Feature: Todo GraphQL API
+
+  Background:
+    Given the database is reset
+
+  Scenario: Create a todo and get only the selected fields
+    When I create the todo "Write report" with GraphQL
+    Then the GraphQL response should have no errors
+    And querying the todo "Write report" with GraphQL should return only the fields "id,title,status"
+    And the GraphQL todo should have the status "pending"
+
+  Scenario: Complete a todo and list the completed ones
+    Given I have created the todo "Write report" with GraphQL
+    And I have created the todo "Plan sprint" with GraphQL
+    When I complete the todo "Write report" with GraphQL
+    Then the GraphQL response should have no errors
+    And querying the todos with the status "completed" with GraphQL should return "Write report"
+
+  Scenario: Mark a completed todo as pending
+    Given I have created the todo "Write report" with GraphQL
+    And I complete the todo "Write report" with GraphQL
+    When I mark the todo "Write report" as pending with GraphQL
+    Then the GraphQL response should have no errors
+    And querying the todos with the status "pending" with GraphQL should return "Write report"
+
+  Scenario: Report the usecase error type in the error extensions
+    When I query the todo "Unknown" with GraphQL
+    Then the GraphQL response should have the error code "not_found"
+
+  Scenario: Refuse to update a todo at another version
+    Given I have created the todo "Write report" with GraphQL
+    When I update the todo "Write report" at the version 9 with GraphQL
+    Then the GraphQL response should have the error code "precondition_failed"
+
+  Scenario: Delete a todo
+    Given I have created the todo "Write report" with GraphQL
+    When I delete the todo "Write report" with GraphQL
+    Then the GraphQL response should have no errors
+    And querying the todo "Write report" with GraphQL should have the error code "not_found"
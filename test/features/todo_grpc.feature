@@ -0,0 +1,32 @@
+Feature: Todo gRPC API
+
+  Background:
+    Given the database is reset
+
+  Scenario: Create and get a todo
+    When I create the todo "Write report" over gRPC
+    Then the gRPC call should succeed
+    And getting the todo "Write report" over gRPC should return it with status "pending"
+
+  Scenario: Complete a todo and list the completed ones
+    Given I have created the todo "Write report" over gRPC
+    And I have created the todo "Plan sprint" over gRPC
+    When I complete the todo "Write report" over gRPC
+    Then the gRPC call should succeed
+    And listing the todos with the status "completed" over gRPC should return "Write report"
+
+  Scenario: Fail with the code of the error
+    When I get the todo "Unknown" over gRPC
+    Then the gRPC call should fail with the code "NotFound"
+
+  Scenario: Refuse to update a todo at another version
+    Given I have created the todo "Write report" over gRPC
+    When I update the todo "Write report" at the version 9 over gRPC
+    Then the gRPC call should fail with the code "FailedPrecondition"
+
+  Scenario: Watch the events of the todos
+    Given I watch the todos over gRPC
+    And I have created the todo "Write report" over gRPC
+    When I complete the todo "Write report" over gRPC
+    And I delete the todo "Write report" over gRPC
+    Then the watch should receive the events "todo.created,todo.completed,todo.deleted"
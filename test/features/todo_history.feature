@@ -0,0 +1,37 @@
+Feature: Todo Status History
+
+  Background:
+    Given the database is reset
+
+  Scenario: Record the creation of a todo
+    Given I have created a todo "Write report"
+    When I get the history of the todo "Write report"
+    Then the response should have status 200
+    And the history should be "->pending"
+
+  Scenario: Record every status change of a todo
+    Given I have created a todo "Write report"
+    And I have moved the todo "Write report" to "in_progress"
+    And I have moved the todo "Write report" to "completed"
+    And I have moved the todo "Write report" to "pending"
+    When I get the history of the todo "Write report"
+    Then the response should have status 200
+    And the history should be "->pending, pending->in_progress, in_progress->completed, completed->pending"
+
+  Scenario: Set the completion date when a todo is completed
+    Given I have created a todo "Write report"
+    When I move the todo "Write report" to "completed"
+    Then the response should have status 200
+    And the todo should have a completion date
+
+  Scenario: Clear the completion date when a todo is reopened
+    Given I have created a todo "Write report"
+    And I have moved the todo "Write report" to "completed"
+    When I move the todo "Write report" to "pending"
+    Then the response should have status 200
+    And the todo should not have a completion date
+
+  Scenario: Get the history of a todo that does not exist
+    When I get the history of the todo with id "missing"
+    Then the response should have status 404
+    And the response should contain error message "todo not found with id missing"
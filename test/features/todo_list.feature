@@ -54,4 +54,120 @@ Feature: Todo List
   Scenario: Get error when filtering by invalid status
     When I request todos with status "invalid"
     Then the response should fail with status 400
-    And the response should contain error message "invalid status: must be 'pending' or 'completed'"
+    And the response should contain error message "invalid status: must be 'pending', 'in_progress', 'blocked', 'completed' or 'cancelled'"
+
+  Scenario: Paginate through todos with a cursor
+    Given I have created a todo with title "Task 1", description "" and due_date ""
+    And I have created a todo with title "Task 2", description "" and due_date ""
+    And I have created a todo with title "Task 3", description "" and due_date ""
+    When I request todos with limit 2
+    Then the response should be successful with status 200
+    And the response should contain a page with 2 todos
+    And the todo at position 1 of the page should have title "Task 1"
+    And the todo at position 2 of the page should have title "Task 2"
+    And the response should have a next cursor
+    When I request the next page with limit 2
+    Then the response should be successful with status 200
+    And the response should contain a page with 1 todo
+    And the todo at position 1 of the page should have title "Task 3"
+    And the response should not have a next cursor
+
+  Scenario: Get error when the limit is out of range
+    When I request todos with limit 101
+    Then the response should fail with status 400
+    And the response should contain error message "limit must be between 1 and 100"
+
+  Scenario: Get error when the cursor is malformed
+    When I request todos with cursor "not-a-cursor"
+    Then the response should fail with status 400
+    And the response should contain error message "invalid cursor"
+
+  Scenario Outline: List todos sorted by a field
+    Given I have created a todo with title "Bravo", description "" and due_date "2030-12-31T23:59:59Z"
+    And I have created a todo with title "Alpha", description "" and due_date ""
+    And I have created a todo with title "Charlie", description "" and due_date "2030-06-30T23:59:59Z"
+    When I request todos sorted by "<sort>" in "<order>" order
+    Then the response should be successful with status 200
+    And the todos should be in the order "<titles>"
+
+    Examples:
+      | sort       | order | titles                |
+      | title      | asc   | Alpha, Bravo, Charlie |
+      | title      | desc  | Charlie, Bravo, Alpha |
+      | created_at | desc  | Charlie, Alpha, Bravo |
+      | due_date   | asc   | Charlie, Bravo, Alpha |
+      | due_date   | desc  | Bravo, Charlie, Alpha |
+
+  Scenario Outline: List todos sorted by priority
+    Given I have created a todo with title "Water plants" and priority "low"
+    And I have created a todo with title "Pay rent" and priority "urgent"
+    And I have created a todo with title "Read book" and priority "none"
+    And I have created a todo with title "Fix bike" and priority "medium"
+    When I request todos sorted by "priority" in "<order>" order
+    Then the response should be successful with status 200
+    And the todos should be in the order "<titles>"
+
+    Examples:
+      | order | titles                                      |
+      | asc   | Read book, Water plants, Fix bike, Pay rent |
+      | desc  | Pay rent, Fix bike, Water plants, Read book |
+
+  Scenario: Get only todos with a given priority
+    Given I have created a todo with title "Water plants" and priority "low"
+    And I have created a todo with title "Pay rent" and priority "urgent"
+    And I have created a todo with title "Call bank" and priority "urgent"
+    When I request todos with "priority" set to "urgent"
+    Then the response should be successful with status 200
+    And the todos should be in the order "Pay rent, Call bank"
+
+  Scenario: Get error when filtering by invalid priority
+    When I request todos with "priority" set to "critical"
+    Then the response should fail with status 400
+    And the response should contain error message "invalid priority: must be one of none, low, medium, high, urgent"
+
+  Scenario: Get error when sorting by an invalid field
+    When I request todos sorted by "status" in "asc" order
+    Then the response should fail with status 400
+    And the response should contain error message "invalid sort: must be one of due_date, created_at, updated_at, title, priority"
+
+  Scenario: Get error when sorting in an invalid direction
+    When I request todos sorted by "title" in "sideways" order
+    Then the response should fail with status 400
+    And the response should contain error message "invalid order: must be 'asc' or 'desc'"
+
+  Scenario Outline: Filter todos by due date
+    Given I have created a todo with title "Due in June", description "" and due_date "2030-06-30T23:59:59Z"
+    And I have created a todo with title "Due in December", description "" and due_date "2030-12-31T23:59:59Z"
+    And I have created a todo with title "No deadline", description "" and due_date ""
+    When I request todos with "<param>" set to "<value>"
+    Then the response should be successful with status 200
+    And the todos should be in the order "<titles>"
+
+    Examples:
+      | param       | value                | titles                       |
+      | due_before  | 2030-09-01T00:00:00Z | Due in June                  |
+      | due_after   | 2030-09-01T00:00:00Z | Due in December              |
+      | due_after   | 2030-01-01T00:00:00Z | Due in June, Due in December |
+      | no_due_date | true                 | No deadline                  |
+
+  Scenario: Get only todos created after a date
+    Given I have created a todo with title "Recent task", description "" and due_date ""
+    When I request todos with "created_after" set to "2000-01-01T00:00:00Z"
+    Then the response should be successful with status 200
+    And the todos should be in the order "Recent task"
+
+  Scenario: Get empty list when no todo was updated since a date
+    Given I have created a todo with title "Old task", description "" and due_date ""
+    When I request todos with "updated_since" set to "2100-01-01T00:00:00Z"
+    Then the response should be successful with status 200
+    And the response should contain an empty list of todos
+
+  Scenario: Get error when a date filter is malformed
+    When I request todos with "due_before" set to "next week"
+    Then the response should fail with status 400
+    And the response should contain error message "invalid due_before: must be an RFC 3339 date"
+
+  Scenario: Get error when combining contradicting due date filters
+    When I request todos with "no_due_date" set to "true" and "overdue" set to "true"
+    Then the response should fail with status 400
+    And the response should contain error message "no_due_date cannot be combined with due_before, due_after or overdue"
@@ -0,0 +1,124 @@
+Feature: Todo Patch
+
+  Background:
+    Given the database is reset
+    And I have created a todo:
+      """
+      {"title": "Buy milk", "description": "Oat milk", "priority": "high", "tags": ["home"], "due_date": "2030-12-31T23:59:59Z"}
+      """
+
+  Scenario: Patch only the title of a todo
+    When I patch the todo with "application/merge-patch+json":
+      """
+      {"title": "Buy oat milk"}
+      """
+    Then the response should have status 200
+    And the patched todo should have:
+      | field       | value                |
+      | title       | Buy oat milk         |
+      | description | Oat milk             |
+      | priority    | high                 |
+      | tags        | home                 |
+      | due_date    | 2030-12-31T23:59:59Z |
+
+  Scenario: Clear the due date and the tags with null
+    When I patch the todo with "application/merge-patch+json":
+      """
+      {"due_date": null, "tags": null}
+      """
+    Then the response should have status 200
+    And the patched todo should have:
+      | field       | value    |
+      | title       | Buy milk |
+      | description | Oat milk |
+      | tags        |          |
+      | due_date    |          |
+
+  Scenario: Change the due date and the priority
+    When I patch the todo with "application/merge-patch+json":
+      """
+      {"due_date": "2031-01-15T09:00:00Z", "priority": "low"}
+      """
+    Then the response should have status 200
+    And the patched todo should have:
+      | field    | value                |
+      | title    | Buy milk             |
+      | priority | low                  |
+      | due_date | 2031-01-15T09:00:00Z |
+
+  Scenario: Fail to clear the title
+    When I patch the todo with "application/merge-patch+json":
+      """
+      {"title": null}
+      """
+    Then the response should have status 400
+    And the response should contain error message "todo invalid input: title"
+
+  Scenario: Fail to patch with an invalid priority
+    When I patch the todo with "application/merge-patch+json":
+      """
+      {"priority": "critical"}
+      """
+    Then the response should have status 400
+
+  Scenario: Fail to patch with another content type
+    When I patch the todo with "text/plain":
+      """
+      {"title": "Buy oat milk"}
+      """
+    Then the response should have status 400
+    And the response should contain error message "invalid content type: must be application/merge-patch+json or application/json-patch+json"
+
+  Scenario: Fail to patch an unknown todo
+    When I patch the todo with ID "unknown" with "application/merge-patch+json":
+      """
+      {"title": "Buy oat milk"}
+      """
+    Then the response should have status 404
+    And the response should contain error message "todo not found with id unknown"
+
+  Scenario: Complete a todo with a JSON Patch only if it is still pending
+    When I patch the todo with "application/json-patch+json":
+      """
+      [
+        {"op": "test", "path": "/status", "value": "pending"},
+        {"op": "replace", "path": "/status", "value": "completed"},
+        {"op": "add", "path": "/tags/-", "value": "done"},
+        {"op": "remove", "path": "/due_date"}
+      ]
+      """
+    Then the response should have status 200
+    And the patched todo should have:
+      | field    | value     |
+      | title    | Buy milk  |
+      | status   | completed |
+      | tags     | done,home |
+      | due_date |           |
+
+  Scenario: Fail a JSON Patch whose test does not hold
+    When I patch the todo with "application/json-patch+json":
+      """
+      [
+        {"op": "test", "path": "/status", "value": "completed"},
+        {"op": "replace", "path": "/title", "value": "Buy oat milk"}
+      ]
+      """
+    Then the response should have status 409
+    And the response should contain error message "JSON patch test failed: value at "/status" is not "completed""
+    And the todo should still be titled "Buy milk"
+
+  Scenario: Fail a JSON Patch on a read only field
+    When I patch the todo with "application/json-patch+json":
+      """
+      [{"op": "replace", "path": "/created_at", "value": "2020-01-01T00:00:00Z"}]
+      """
+    Then the response should have status 400
+    And the response should contain error message "invalid JSON patch operation: "/created_at" is read only"
+
+  Scenario: Fail a JSON Patch with an unsupported operation
+    When I patch the todo with "application/json-patch+json":
+      """
+      [{"op": "move", "from": "/title", "path": "/description"}]
+      """
+    Then the response should have status 400
+    And the response should contain error message "invalid JSON patch operation: operation "move" is not supported"
@@ -0,0 +1,112 @@
+Feature: Todo Projects
+
+  Background:
+    Given the database is reset
+
+  Scenario: Create a project
+    When I create a project " Home "
+    Then the response should have status 201
+    And the project should be named "Home"
+
+  Scenario: Fail to create a project without name
+    When I create a project "  "
+    Then the response should have status 400
+    And the response should contain error message "project invalid input: name must have between 1 and 100 characters"
+
+  Scenario: List the projects by name
+    Given I have created a project "Work"
+    And I have created a project "Home"
+    When I request all projects
+    Then the response should have status 200
+    And the projects should be "Home, Work"
+
+  Scenario: Rename a project
+    Given I have created a project "Work"
+    When I rename the project "Work" to "Office"
+    Then the response should have status 200
+    And the project should be named "Office"
+
+  Scenario: Fail to get an unknown project
+    When I request the project "unknown"
+    Then the response should have status 404
+    And the response should contain error message "project not found with id unknown"
+
+  Scenario: Create a todo in a project
+    Given I have created a project "Home"
+    When I create a todo "Fix sink" in the project "Home"
+    Then the response should have status 201
+    And the todo should belong to the project "Home"
+
+  Scenario: Fail to create a todo in an unknown project
+    When I create a todo "Fix sink" in the project "unknown"
+    Then the response should have status 404
+    And the response should contain error message "project not found with id unknown"
+
+  Scenario: List the todos of a project
+    Given I have created a project "Home"
+    And I have created a project "Work"
+    And I have created a todo "Fix sink" in the project "Home"
+    And I have created a todo "Write report" in the project "Work"
+    And I have created a todo "Paint fence" in the project "Home"
+    And I have created a todo "Read book" without project
+    When I request the todos of the project "Home"
+    Then the response should have status 200
+    And the todos should be "Fix sink, Paint fence"
+
+  Scenario: Move a todo between projects
+    Given I have created a project "Home"
+    And I have created a todo "Fix sink" without project
+    When I move the todo "Fix sink" to the project "Home"
+    Then the response should have status 200
+    And the todo should belong to the project "Home"
+    And the todo should be at version 2
+    When I remove the todo "Fix sink" from its project
+    Then the response should have status 200
+    And the todo should not belong to any project
+    And the todo should be at version 3
+
+  Scenario: Fail to move a todo to an unknown project
+    Given I have created a todo "Fix sink" without project
+    When I move the todo "Fix sink" to the project "unknown"
+    Then the response should have status 404
+    And the response should contain error message "project not found with id unknown"
+
+  Scenario: Refuse to delete a project with todos by default
+    Given I have created a project "Home"
+    And I have created a todo "Fix sink" in the project "Home"
+    And I have created a todo "Paint fence" in the project "Home"
+    When I delete the project "Home"
+    Then the response should have status 409
+    And the response should contain error message "cannot delete a project with 2 todos"
+
+  Scenario: Delete an empty project
+    Given I have created a project "Home"
+    When I delete the project "Home"
+    Then the response should have status 204
+    When I request the project "Home"
+    Then the response should have status 404
+
+  Scenario: Delete a project keeping its todos
+    Given I have created a project "Home"
+    And I have created a todo "Fix sink" in the project "Home"
+    When I delete the project "Home" with todos policy "orphan"
+    Then the response should have status 204
+    When I request the todo "Fix sink"
+    Then the response should have status 200
+    And the todo should not belong to any project
+    And the todo should be at version 2
+
+  Scenario: Delete a project with its todos
+    Given I have created a project "Home"
+    And I have created a todo "Fix sink" in the project "Home"
+    When I delete the project "Home" with todos policy "cascade"
+    Then the response should have status 204
+    When I request the todo "Fix sink"
+    Then the response should have status 404
+    And the trash should be "Fix sink"
+
+  Scenario: Fail to delete a project with an invalid todos policy
+    Given I have created a project "Home"
+    When I delete the project "Home" with todos policy "keep"
+    Then the response should have status 400
+    And the response should contain error message "invalid todos policy: must be 'cascade', 'orphan' or 'refuse'"
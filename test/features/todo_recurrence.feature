@@ -0,0 +1,44 @@
+Feature: Recurring Todos
+
+  Background:
+    Given the database is reset
+
+  Scenario: Create a recurring todo
+    When I create a todo "Water the plants" due "2030-01-07T09:00:00Z" recurring with "rrule:freq=weekly;byday=th,mo"
+    Then the response should have status 201
+    And the todo should recur with "FREQ=WEEKLY;BYDAY=MO,TH"
+
+  Scenario: Fail to create a todo with an invalid recurrence
+    When I create a todo "Water the plants" due "2030-01-07T09:00:00Z" recurring with "FREQ=HOURLY"
+    Then the response should have status 400
+    And the response should contain error message "todo invalid input: recurrence FREQ must be DAILY, WEEKLY or MONTHLY"
+
+  Scenario Outline: Completing a recurring todo creates its next occurrence
+    Given I have created a todo "Water the plants" due "<due>" recurring with "<rule>"
+    When I complete the todo
+    Then the response should have status 200
+    And there should be 1 pending todo
+    And the pending todo should be "Water the plants" due "<next>" recurring with "<next rule>"
+
+    Examples:
+      | due                  | rule                            | next                 | next rule                       |
+      | 2030-01-07T09:00:00Z | FREQ=DAILY                      | 2030-01-08T09:00:00Z | FREQ=DAILY                      |
+      | 2030-01-07T09:00:00Z | FREQ=WEEKLY;INTERVAL=2          | 2030-01-21T09:00:00Z | FREQ=WEEKLY;INTERVAL=2          |
+      | 2030-01-07T09:00:00Z | FREQ=WEEKLY;BYDAY=MO,FR         | 2030-01-11T09:00:00Z | FREQ=WEEKLY;BYDAY=MO,FR         |
+      | 2030-01-31T09:00:00Z | FREQ=MONTHLY                    | 2030-03-31T09:00:00Z | FREQ=MONTHLY                    |
+      | 2030-01-07T09:00:00Z | FREQ=DAILY;COUNT=3              | 2030-01-08T09:00:00Z | FREQ=DAILY;COUNT=2              |
+      | 2030-01-07T09:00:00Z | FREQ=DAILY;UNTIL=20300108       | 2030-01-08T09:00:00Z | FREQ=DAILY;UNTIL=20300108T235959Z |
+
+  Scenario: A recurrence ends after its last occurrence
+    Given I have created a todo "Water the plants" due "2030-01-07T09:00:00Z" recurring with "FREQ=DAILY;COUNT=2"
+    When I complete the todo
+    And I complete the next occurrence
+    Then the response should have status 200
+    And there should be 0 pending todos
+
+  Scenario: Completing a completed recurring todo does not repeat it again
+    Given I have created a todo "Water the plants" due "2030-01-07T09:00:00Z" recurring with "FREQ=WEEKLY"
+    When I complete the todo
+    And I complete the todo
+    Then the response should have status 200
+    And there should be 1 pending todo
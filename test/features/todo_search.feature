@@ -0,0 +1,48 @@
+Feature: Todo Search
+
+  Background:
+    Given the database is reset
+    And I have created a todo with title "Buy milk" and description ""
+    And I have created a todo with title "Call mom" and description "Ask about milk prices"
+    And I have created a completed todo with title "Bake bread" and description "Needs milk and eggs"
+    And I have created a todo with title "Walk the dog" and description ""
+
+  Scenario: Title matches come first
+    When I search todos for "milk"
+    Then the response should have status 200
+    And the results should be "Buy milk, Call mom, Bake bread"
+
+  Scenario: Words are matched by prefix and case-insensitively
+    When I search todos for "BRE"
+    Then the response should have status 200
+    And the results should be "Bake bread"
+
+  Scenario: Any word of the query can match
+    When I search todos for "dog eggs"
+    Then the response should have status 200
+    And the results should be "Walk the dog, Bake bread"
+
+  Scenario: Search combined with a status filter
+    When I search todos for "milk" with "status" set to "completed"
+    Then the response should have status 200
+    And the results should be "Bake bread"
+
+  Scenario: Search limited to the best match
+    When I search todos for "milk" with "limit" set to "1"
+    Then the response should have status 200
+    And the results should be "Buy milk"
+
+  Scenario: No todo matches the query
+    When I search todos for "groceries"
+    Then the response should have status 200
+    And the response should contain no results
+
+  Scenario: Fail when the query has no words
+    When I search todos for "  !! "
+    Then the response should have status 400
+    And the response should contain error message "search query must contain at least one word"
+
+  Scenario: Fail when the limit is invalid
+    When I search todos for "milk" with "limit" set to "0"
+    Then the response should have status 400
+    And the response should contain error message "invalid limit: must be between 1 and 100"
@@ -0,0 +1,33 @@
+Feature: Todo WebSocket
+
+  Background:
+    Given the database is reset
+
+  Scenario: Create a todo over the socket
+    Given I open the todo socket
+    When I send the message "m1" creating the todo "Write report"
+    Then the socket should answer "m1" with the todo "Write report" with status "pending"
+
+  Scenario: Push the events of the subscribed todos
+    Given I have created a todo "Write report"
+    And I have created a todo "Plan sprint"
+    And I open the todo socket
+    When I send the message "m1" subscribing to the todo "Write report"
+    And the socket should answer "m1" with the subscribed todos "Write report"
+    And I have completed the todo "Plan sprint"
+    And I have completed the todo "Write report"
+    Then the socket should push the events "todo.completed" about the todo "Write report"
+
+  Scenario: Change a subscribed todo over the socket
+    Given I have created a todo "Write report"
+    And I open the todo socket
+    And I send the message "m1" subscribing to the todo "Write report"
+    And the socket should answer "m1" with the subscribed todos "Write report"
+    When I send the message "m2" completing the todo "Write report"
+    Then the socket should answer "m2" with the todo "Write report" with status "completed"
+    And the socket should push the events "todo.completed" about the todo "Write report"
+
+  Scenario: Answer the error of a message
+    Given I open the todo socket
+    When I send the message "m1" completing the todo "Unknown"
+    Then the socket should answer "m1" with the error "not_found"
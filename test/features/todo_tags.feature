@@ -0,0 +1,64 @@
+Feature: Todo Tags
+
+  Background:
+    Given the database is reset
+
+  Scenario: Create a todo with normalized tags
+    When I create a todo "Write report" tagged with "Work, urgent,work"
+    Then the response should have status 201
+    And the todo should have tags "urgent, work"
+
+  Scenario: Fail to create a todo with an invalid tag
+    When I create a todo "Write report" tagged with "to#do"
+    Then the response should have status 400
+    And the response should contain error message "todo invalid input: tag \"to#do\" has invalid characters"
+
+  Scenario: Replace the tags of a todo
+    Given I have created a todo "Write report" tagged with "work,urgent"
+    When I retag the todo "Write report" with "work,blocked"
+    Then the response should have status 200
+    And the todo should have tags "blocked, work"
+
+  Scenario Outline: Filter todos by tags
+    Given I have created a todo "Write report" tagged with "work,urgent"
+    And I have created a todo "Fix sink" tagged with "home"
+    And I have created a todo "Plan trip" tagged with "home,work"
+    And I have created a todo "Read book" tagged with ""
+    When I request todos tagged with "<tags>" in "<mode>" mode
+    Then the response should have status 200
+    And the todos should be "<titles>"
+
+    Examples:
+      | tags        | mode | titles                            |
+      | work        | any  | Write report, Plan trip           |
+      | home,urgent | any  | Write report, Fix sink, Plan trip |
+      | HOME,work   | all  | Plan trip                         |
+      | garden      | any  |                                   |
+
+  Scenario: Match any tag by default
+    Given I have created a todo "Write report" tagged with "work"
+    And I have created a todo "Fix sink" tagged with "home"
+    When I request todos tagged with "work,home"
+    Then the response should have status 200
+    And the todos should be "Write report, Fix sink"
+
+  Scenario: Fail to filter with an invalid tag mode
+    When I request todos tagged with "work" in "some" mode
+    Then the response should have status 400
+    And the response should contain error message "invalid tag_mode: must be 'any' or 'all'"
+
+  Scenario: List the tags with their usage counts
+    Given I have created a todo "Write report" tagged with "work,urgent"
+    And I have created a todo "Fix sink" tagged with "home"
+    And I have created a todo "Plan trip" tagged with "home,work"
+    And I have created a todo "Pay bills" tagged with "home"
+    When I request all tags
+    Then the response should have status 200
+    And the tags should be "home (3), work (2), urgent (1)"
+
+  Scenario: Deleted todos no longer count for their tags
+    Given I have created a todo "Write report" tagged with "work"
+    And I have created a todo "Fix sink" tagged with "home"
+    When I delete the todo "Fix sink"
+    And I request all tags
+    Then the tags should be "work (1)"
@@ -0,0 +1,43 @@
+Feature: Todo Trash
+
+  Background:
+    Given the database is reset
+
+  Scenario: Deleting a todo moves it to the trash
+    Given I have created a todo "Buy groceries" tagged with "home"
+    And I have created a todo "Write report" tagged with "work"
+    When I delete the todo "Buy groceries"
+    Then the response should have status 204
+    And getting the todo "Buy groceries" should fail with status 404
+    And the todos should be "Write report"
+    And the tags should be "work (1)"
+    And the trash should be "Buy groceries"
+
+  Scenario: Restore a todo from the trash
+    Given I have created a todo "Buy groceries" tagged with "home"
+    And I have deleted the todo "Buy groceries"
+    When I restore the todo "Buy groceries"
+    Then the response should have status 200
+    And the restored todo should have tags "home"
+    And the todos should be "Buy groceries"
+    And the trash should be ""
+
+  Scenario: Fail to restore a todo that is not in the trash
+    Given I have created a todo "Buy groceries" tagged with "home"
+    When I restore the todo "Buy groceries"
+    Then the response should have status 404
+    And the response should contain error message "todo not found in the trash with id" and the todo id
+
+  Scenario: Delete a todo for good
+    Given I have created a todo "Buy groceries" tagged with "home"
+    When I permanently delete the todo "Buy groceries"
+    Then the response should have status 204
+    And the todos should be ""
+    And the trash should be ""
+
+  Scenario: Delete a todo in the trash for good
+    Given I have created a todo "Buy groceries" tagged with "home"
+    And I have deleted the todo "Buy groceries"
+    When I permanently delete the todo "Buy groceries"
+    Then the response should have status 204
+    And the trash should be ""
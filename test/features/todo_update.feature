@@ -27,6 +27,21 @@ Feature: Todo Update
     When I update the todo with ID from the created todo
     Then the todo should be updated successfully with title "Updated Title", description "Updated description only" and due_date ""
 
+  Scenario: Successfully change the priority of a todo
+    Given I have created a todo with title "Original Title", description "" and due_date ""
+    And I have a todo update input with title "Original Title", description "" and due_date ""
+    And the todo update input has priority "urgent"
+    When I update the todo with ID from the created todo
+    Then the todo should be updated successfully with title "Original Title", description "" and due_date ""
+    And the updated todo should have priority "urgent"
+
+  Scenario: Fail to update a todo with an invalid priority
+    Given I have created a todo with title "Original Title", description "" and due_date ""
+    And I have a todo update input with title "Original Title", description "" and due_date ""
+    And the todo update input has priority "critical"
+    When I update the todo with ID from the created todo
+    Then the update should fail with validation error
+
   Scenario: Fail to update a todo when not found
     When I update the todo with ID "nonexistent-id" with title "Updated Title", description "Updated Description" and due_date ""
     Then the update should fail with not found error
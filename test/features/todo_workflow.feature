@@ -0,0 +1,43 @@
+Feature: Todo Workflow
+
+  Background:
+    Given the database is reset
+
+  Scenario: Move a todo through the workflow
+    Given I have created a todo "Write report"
+    When I move the todo "Write report" to "in_progress"
+    Then the response should have status 200
+    And the todo should have status "in_progress"
+    When I move the todo "Write report" to "blocked"
+    Then the response should have status 200
+    And the todo should have status "blocked"
+    And the todos with status "blocked" should be "Write report"
+
+  Scenario: Refuse a transition the workflow does not allow
+    Given I have created a todo "Write report"
+    And I have moved the todo "Write report" to "in_progress"
+    And I have moved the todo "Write report" to "blocked"
+    When I move the todo "Write report" to "completed"
+    Then the response should have status 409
+    And the response should contain error message "cannot move a todo from blocked to completed"
+
+  Scenario: Refuse a status that is not in the workflow
+    Given I have created a todo "Write report"
+    When I move the todo "Write report" to "done"
+    Then the response should have status 400
+    And the response should contain error message "invalid status: must be 'pending', 'in_progress', 'blocked', 'completed' or 'cancelled'"
+
+  Scenario: Complete a todo with the complete alias
+    Given I have created a todo "Write report"
+    And I have moved the todo "Write report" to "in_progress"
+    When I complete the todo "Write report"
+    Then the response should have status 200
+    And the todo should have status "completed"
+
+  Scenario: Refuse to complete a blocked todo with the complete alias
+    Given I have created a todo "Write report"
+    And I have moved the todo "Write report" to "in_progress"
+    And I have moved the todo "Write report" to "blocked"
+    When I complete the todo "Write report"
+    Then the response should have status 409
+    And the response should contain error message "cannot move a todo from blocked to completed"
@@ -0,0 +1,48 @@
+Feature: Webhooks
+
+  Background:
+    Given the database is reset
+
+  Scenario: Deliver the signed events a webhook is subscribed to
+    Given a webhook receiver answering with status 204
+    And I have created a webhook for "todo.created,todo.completed" with the secret "s3cret"
+    And I have created a todo "Write report"
+    And I have completed the todo "Write report"
+    Then the receiver should get the events "todo.created,todo.completed"
+    And every event should be signed with the secret "s3cret"
+    And the deliveries of the webhook should be "todo.completed succeeded after 1 attempt with status 204, todo.created succeeded after 1 attempt with status 204"
+
+  Scenario: Only deliver the events of the subscribed types
+    Given a webhook receiver answering with status 200
+    And I have created a webhook for "todo.deleted" with the secret "s3cret"
+    And I have created a todo "Write report"
+    And I have deleted the todo "Write report"
+    Then the receiver should get the events "todo.deleted"
+
+  Scenario: Keep a failed delivery pending until its retry
+    Given a webhook receiver answering with status 500
+    And I have created a webhook for "todo.created" with the secret "s3cret"
+    And I have created a todo "Write report"
+    Then the deliveries of the webhook should be "todo.created pending after 1 attempt with status 500"
+    And the next attempt of the delivery should be scheduled
+
+  Scenario: Fail to create a webhook for an unknown event
+    Given a webhook receiver answering with status 200
+    When I create a webhook for "todo.archived" with the secret "s3cret"
+    Then the response should have status 400
+    And the response should contain error message 'webhook invalid input: unknown event "todo.archived"'
+
+  Scenario: Change the events of a webhook
+    Given a webhook receiver answering with status 200
+    And I have created a webhook for "todo.created" with the secret "s3cret"
+    When I update the webhook to receive "todo.deleted,todo.updated"
+    Then the response should have status 200
+    And the webhook should receive "todo.updated,todo.deleted" without its secret
+
+  Scenario: Delete a webhook
+    Given a webhook receiver answering with status 200
+    And I have created a webhook for "todo.created" with the secret "s3cret"
+    When I delete the webhook
+    Then the response should have status 204
+    When I request the webhook
+    Then the response should have status 404
@@ -30,13 +30,98 @@ type TodoUpdateInput struct {
 }
 
 type TodoResponse struct {
-	ID          string     `json:"id"`
-	Title       string     `json:"title"`
-	Description string     `json:"description"`
-	Status      string     `json:"status"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	DueDate     *time.Time `json:"due_date,omitempty"`
+	ID          string                  `json:"id"`
+	Title       string                  `json:"title"`
+	Description string                  `json:"description"`
+	Status      string                  `json:"status"`
+	Priority    string                  `json:"priority"`
+	Tags        []string                `json:"tags"`
+	Items       []ChecklistItemResponse `json:"items"`
+	Recurrence  string                  `json:"recurrence"`
+	ProjectID   *string                 `json:"project_id"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+	CompletedAt *time.Time              `json:"completed_at,omitempty"`
+	DueDate     *time.Time              `json:"due_date,omitempty"`
+	ArchivedAt  *time.Time              `json:"archived_at,omitempty"`
+	DeletedAt   *time.Time              `json:"deleted_at,omitempty"`
+	Version     int                     `json:"version"`
+}
+
+type TodoPageResponse struct {
+	Data       []TodoResponse `json:"data"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+type ChecklistItemResponse struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Done  bool   `json:"done"`
+}
+
+type TagResponse struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type ProjectResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type TodoBulkResponse struct {
+	Results []TodoBulkResultResponse `json:"results"`
+}
+
+type TodoBulkResultResponse struct {
+	Op     string        `json:"op"`
+	Status int           `json:"status"`
+	Todo   *TodoResponse `json:"todo"`
+	Error  string        `json:"error"`
+}
+
+type StatusChangeResponse struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type AuditRecordResponse struct {
+	TodoID    string                      `json:"todo_id"`
+	Actor     string                      `json:"actor"`
+	Operation string                      `json:"operation"`
+	Diff      map[string]AuditFieldChange `json:"diff"`
+	CreatedAt time.Time                   `json:"created_at"`
+}
+
+type AuditFieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+type WebhookResponse struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret,omitempty"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string          `json:"id"`
+	WebhookID      string          `json:"webhook_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus int             `json:"response_status"`
+	LastError      string          `json:"last_error"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at"`
 }
 
 type ErrorResponse struct {
@@ -59,6 +144,78 @@ func ParseTodoListResponse(response *httptest.ResponseRecorder) ([]TodoResponse,
 	return todos, nil
 }
 
+func ParseTodoPageResponse(response *httptest.ResponseRecorder) (TodoPageResponse, error) {
+	var page TodoPageResponse
+	if err := json.Unmarshal(response.Body.Bytes(), &page); err != nil {
+		return page, fmt.Errorf("failed to parse todo page response: %w", err)
+	}
+	return page, nil
+}
+
+func ParseTagListResponse(response *httptest.ResponseRecorder) ([]TagResponse, error) {
+	var tags []TagResponse
+	if err := json.Unmarshal(response.Body.Bytes(), &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse tag list response: %w", err)
+	}
+	return tags, nil
+}
+
+func ParseProjectResponse(response *httptest.ResponseRecorder) (ProjectResponse, error) {
+	var resp ProjectResponse
+	if err := json.Unmarshal(response.Body.Bytes(), &resp); err != nil {
+		return resp, fmt.Errorf("failed to parse project response: %w", err)
+	}
+	return resp, nil
+}
+
+func ParseProjectListResponse(response *httptest.ResponseRecorder) ([]ProjectResponse, error) {
+	var projects []ProjectResponse
+	if err := json.Unmarshal(response.Body.Bytes(), &projects); err != nil {
+		return nil, fmt.Errorf("failed to parse project list response: %w", err)
+	}
+	return projects, nil
+}
+
+func ParseTodoBulkResponse(response *httptest.ResponseRecorder) (TodoBulkResponse, error) {
+	var resp TodoBulkResponse
+	if err := json.Unmarshal(response.Body.Bytes(), &resp); err != nil {
+		return resp, fmt.Errorf("failed to parse bulk response: %w", err)
+	}
+	return resp, nil
+}
+
+func ParseStatusHistoryResponse(response *httptest.ResponseRecorder) ([]StatusChangeResponse, error) {
+	var changes []StatusChangeResponse
+	if err := json.Unmarshal(response.Body.Bytes(), &changes); err != nil {
+		return nil, fmt.Errorf("failed to parse status history response: %w", err)
+	}
+	return changes, nil
+}
+
+func ParseAuditListResponse(response *httptest.ResponseRecorder) ([]AuditRecordResponse, error) {
+	var records []AuditRecordResponse
+	if err := json.Unmarshal(response.Body.Bytes(), &records); err != nil {
+		return nil, fmt.Errorf("failed to parse audit list response: %w", err)
+	}
+	return records, nil
+}
+
+func ParseWebhookResponse(response *httptest.ResponseRecorder) (WebhookResponse, error) {
+	var resp WebhookResponse
+	if err := json.Unmarshal(response.Body.Bytes(), &resp); err != nil {
+		return resp, fmt.Errorf("failed to parse webhook response: %w", err)
+	}
+	return resp, nil
+}
+
+func ParseWebhookDeliveryListResponse(response *httptest.ResponseRecorder) ([]WebhookDeliveryResponse, error) {
+	var deliveries []WebhookDeliveryResponse
+	if err := json.Unmarshal(response.Body.Bytes(), &deliveries); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook delivery list response: %w", err)
+	}
+	return deliveries, nil
+}
+
 func ParseErrorResponse(response *httptest.ResponseRecorder) (ErrorResponse, error) {
 	var resp ErrorResponse
 	if err := json.Unmarshal(response.Body.Bytes(), &resp); err != nil {
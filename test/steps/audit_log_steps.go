@@ -0,0 +1,208 @@
+package steps
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type AuditLogContext struct {
+	BaseTestContext
+	CreatedTodoIDs map[string]string
+}
+
+func (tc *AuditLogContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	return tc.ResetDatabase()
+}
+
+// clientOf returns a client acting on behalf of actor, or without an actor when it is empty.
+func (tc *AuditLogContext) clientOf(actor string) *HTTPClient {
+	if actor == "" {
+		return tc.UseHTTPClient()
+	}
+	return tc.UseHTTPClient().AsActor(actor)
+}
+
+func (tc *AuditLogContext) HasCreatedATodo(actor, title string) error {
+	rec, err := tc.clientOf(actor).CreateTodo(map[string]interface{}{"title": title})
+	if err != nil {
+		return err
+	}
+	if err := validateResponseHeaders(rec, helpers.StatusCreated); err != nil {
+		return err
+	}
+	todo, err := helpers.ParseTodoResponse(rec)
+	if err != nil {
+		return err
+	}
+	tc.CreatedTodoIDs[title] = todo.ID
+	return nil
+}
+
+func (tc *AuditLogContext) HasUpdatedTheTitleOfTheTodo(actor, title, newTitle string) error {
+	rec, err := tc.clientOf(actor).UpdateTodo(tc.CreatedTodoIDs[title], map[string]interface{}{"title": newTitle})
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusOK)
+}
+
+func (tc *AuditLogContext) HasCompletedTheTodo(actor, title string) error {
+	rec, err := tc.clientOf(actor).CompleteTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusOK)
+}
+
+func (tc *AuditLogContext) HasDeletedTheTodo(actor, title string) error {
+	rec, err := tc.clientOf(actor).DeleteTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusNoContent)
+}
+
+func (tc *AuditLogContext) HasArchivedTheTodo(actor, title string) error {
+	rec, err := tc.clientOf(actor).ArchiveTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusOK)
+}
+
+func (tc *AuditLogContext) HasUnarchivedTheTodo(actor, title string) error {
+	rec, err := tc.clientOf(actor).UnarchiveTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusOK)
+}
+
+func (tc *AuditLogContext) HasMovedTheTodoToANewProject(actor, title, name string) error {
+	rec, err := tc.clientOf(actor).CreateProject(map[string]interface{}{"name": name})
+	if err != nil {
+		return err
+	}
+	if err := validateResponseHeaders(rec, helpers.StatusCreated); err != nil {
+		return err
+	}
+	project, err := helpers.ParseProjectResponse(rec)
+	if err != nil {
+		return err
+	}
+	rec, err = tc.clientOf(actor).MoveTodo(tc.CreatedTodoIDs[title], &project.ID)
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusOK)
+}
+
+func (tc *AuditLogContext) HasRestoredTheTodo(actor, title string) error {
+	rec, err := tc.clientOf(actor).RestoreTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusOK)
+}
+
+func (tc *AuditLogContext) HasDeletedTheProjectOfTheTodo(actor, title, policy string) error {
+	rec, err := tc.UseHTTPClient().GetTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	todo, err := helpers.ParseTodoResponse(rec)
+	if err != nil {
+		return err
+	}
+	if todo.ProjectID == nil {
+		return fmt.Errorf("expected todo %q to belong to a project", title)
+	}
+	rec, err = tc.clientOf(actor).DeleteProject(*todo.ProjectID, policy)
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusNoContent)
+}
+
+func (tc *AuditLogContext) IListTheAuditLogOfTheTodo(title string) error {
+	return tc.listAudit(url.Values{"todo_id": {tc.CreatedTodoIDs[title]}})
+}
+
+func (tc *AuditLogContext) IListTheAuditLogSince(since string) error {
+	return tc.listAudit(url.Values{"since": {since}})
+}
+
+func (tc *AuditLogContext) listAudit(query url.Values) error {
+	rec, err := tc.UseHTTPClient().ListAudit(query)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *AuditLogContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *AuditLogContext) TheAuditLogShouldBe(expected string) error {
+	records, err := helpers.ParseAuditListResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	got := make([]string, 0, len(records))
+	for _, record := range records {
+		got = append(got, record.Actor+" "+record.Operation)
+	}
+	if strings.Join(got, ", ") != expected {
+		return fmt.Errorf("expected audit log %q, got %q", expected, strings.Join(got, ", "))
+	}
+	return nil
+}
+
+func (tc *AuditLogContext) TheAuditRecordShouldChange(position int, field, before, after string) error {
+	records, err := helpers.ParseAuditListResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if position < 1 || position > len(records) {
+		return fmt.Errorf("expected at least %d audit records, got %d", position, len(records))
+	}
+	change, ok := records[position-1].Diff[field]
+	if !ok {
+		return fmt.Errorf("expected audit record %d to change %q, got %v", position, field, records[position-1].Diff)
+	}
+	if change.Before != before || change.After != after {
+		return fmt.Errorf("expected %q to change from %q to %q, got %v to %v", field, before, after, change.Before, change.After)
+	}
+	return nil
+}
+
+func (tc *AuditLogContext) TheResponseShouldContainErrorMessage(message string) error {
+	return validateErrorResponse(tc.Response, tc.Response.Code, message)
+}
+
+func (tc *AuditLogContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^"([^"]*)" has created a todo "([^"]*)"$`, tc.HasCreatedATodo)
+	ctx.Step(`^"([^"]*)" has updated the title of the todo "([^"]*)" to "([^"]*)"$`, tc.HasUpdatedTheTitleOfTheTodo)
+	ctx.Step(`^"([^"]*)" has completed the todo "([^"]*)"$`, tc.HasCompletedTheTodo)
+	ctx.Step(`^"([^"]*)" has deleted the todo "([^"]*)"$`, tc.HasDeletedTheTodo)
+	ctx.Step(`^"([^"]*)" has archived the todo "([^"]*)"$`, tc.HasArchivedTheTodo)
+	ctx.Step(`^"([^"]*)" has unarchived the todo "([^"]*)"$`, tc.HasUnarchivedTheTodo)
+	ctx.Step(`^"([^"]*)" has moved the todo "([^"]*)" to a new project "([^"]*)"$`, tc.HasMovedTheTodoToANewProject)
+	ctx.Step(`^"([^"]*)" has restored the todo "([^"]*)"$`, tc.HasRestoredTheTodo)
+	ctx.Step(`^"([^"]*)" has deleted the project of the todo "([^"]*)" with todos policy "([^"]*)"$`, tc.HasDeletedTheProjectOfTheTodo)
+	ctx.Step(`^I list the audit log of the todo "([^"]*)"$`, tc.IListTheAuditLogOfTheTodo)
+	ctx.Step(`^I list the audit log since "([^"]*)"$`, tc.IListTheAuditLogSince)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the audit log should be "([^"]*)"$`, tc.TheAuditLogShouldBe)
+	ctx.Step(`^the audit record (\d+) should change "([^"]*)" from "([^"]*)" to "([^"]*)"$`, tc.TheAuditRecordShouldChange)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
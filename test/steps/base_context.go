@@ -29,8 +29,10 @@ func (btc *BaseTestContext) SetTodoInput(title, desc, dueDate string) {
 }
 
 func (btc *BaseTestContext) ResetDatabase() error {
-	if err := btc.DB.Exec("DELETE FROM todos").Error; err != nil {
-		return err
+	for _, table := range []string{"webhook_deliveries", "webhooks", "audit_log", "todo_status_history", "todo_items", "todo_tags", "tags", "todos", "projects"} {
+		if err := btc.DB.Exec("DELETE FROM " + table).Error; err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -3,19 +3,31 @@ package steps
 import (
 	"bytes"
 	"encoding/json"
+	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 )
 
 type HTTPClient struct {
-	app *echo.Echo
+	app http.Handler
 }
 
 func NewHTTPClient(app *echo.Echo) *HTTPClient {
 	return &HTTPClient{app: app}
 }
 
+// AsActor returns a client sending its requests on behalf of actor, with the X-Actor header.
+func (c *HTTPClient) AsActor(actor string) *HTTPClient {
+	app := c.app
+	return &HTTPClient{app: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Set("X-Actor", actor)
+		app.ServeHTTP(w, r)
+	})}
+}
+
 func (c *HTTPClient) CreateTodo(input map[string]interface{}) (*httptest.ResponseRecorder, error) {
 	body, _ := json.Marshal(input)
 	req := httptest.NewRequest("POST", "/todos", bytes.NewReader(body))
@@ -41,6 +53,27 @@ func (c *HTTPClient) UpdateTodo(id string, input map[string]interface{}) (*httpt
 	return rec, nil
 }
 
+func (c *HTTPClient) PatchTodo(id, contentType, patch string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("PATCH", "/todos/"+id, strings.NewReader(patch))
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) BulkTodos(operations []map[string]interface{}, atomic bool) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(map[string]interface{}{"operations": operations})
+	path := "/todos/bulk"
+	if atomic {
+		path += "?atomic=true"
+	}
+	req := httptest.NewRequest("POST", path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
 func (c *HTTPClient) DeleteTodo(id string) (*httptest.ResponseRecorder, error) {
 	req := httptest.NewRequest("DELETE", "/todos/"+id, nil)
 	rec := httptest.NewRecorder()
@@ -48,6 +81,64 @@ func (c *HTTPClient) DeleteTodo(id string) (*httptest.ResponseRecorder, error) {
 	return rec, nil
 }
 
+func (c *HTTPClient) DeleteTodoPermanently(id string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("DELETE", "/todos/"+id+"?permanent=true", nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) ListTrash() (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/todos/trash", nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) RestoreTodo(id string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("POST", "/todos/"+id+"/restore", nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) ArchiveTodo(id string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("POST", "/todos/"+id+"/archive", nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) UnarchiveTodo(id string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("DELETE", "/todos/"+id+"/archive", nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) TransitionTodo(id, status string) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(map[string]interface{}{"status": status})
+	req := httptest.NewRequest("POST", "/todos/"+id+"/transition", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) ListAudit(query url.Values) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/audit?"+query.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) TodoHistory(id string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/todos/"+id+"/history", nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
 func (c *HTTPClient) CompleteTodo(id string) (*httptest.ResponseRecorder, error) {
 	req := httptest.NewRequest("POST", "/todos/"+id+"/complete", nil)
 	rec := httptest.NewRecorder()
@@ -55,6 +146,45 @@ func (c *HTTPClient) CompleteTodo(id string) (*httptest.ResponseRecorder, error)
 	return rec, nil
 }
 
+func (c *HTTPClient) CompleteTodoWithOpenItems(id, openItems string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("POST", "/todos/"+id+"/complete?open_items="+url.QueryEscape(openItems), nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) AddTodoItem(id, title string) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(map[string]interface{}{"title": title})
+	req := httptest.NewRequest("POST", "/todos/"+id+"/items", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) ToggleTodoItem(id, itemID string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("POST", "/todos/"+id+"/items/"+itemID+"/toggle", nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) RemoveTodoItem(id, itemID string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("DELETE", "/todos/"+id+"/items/"+itemID, nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) ReorderTodoItems(id string, itemIDs []string) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(map[string]interface{}{"item_ids": itemIDs})
+	req := httptest.NewRequest("PUT", "/todos/"+id+"/items/order", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
 func (c *HTTPClient) MarkPendingTodo(id string) (*httptest.ResponseRecorder, error) {
 	req := httptest.NewRequest("POST", "/todos/"+id+"/pending", nil)
 	rec := httptest.NewRecorder()
@@ -75,3 +205,159 @@ func (c *HTTPClient) ListTodosWithStatus(status string) (*httptest.ResponseRecor
 	c.app.ServeHTTP(rec, req)
 	return rec, nil
 }
+
+func (c *HTTPClient) ListTodosWithQuery(query url.Values) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/todos?"+query.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) SearchTodos(query url.Values) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/todos/search?"+query.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) ListTags() (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/tags", nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) CreateProject(input map[string]interface{}) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest("POST", "/projects", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) GetProject(id string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/projects/"+id, nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) ListProjects() (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/projects", nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) UpdateProject(id string, input map[string]interface{}) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest("PUT", "/projects/"+id, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) DeleteProject(id, todosPolicy string) (*httptest.ResponseRecorder, error) {
+	path := "/projects/" + id
+	if todosPolicy != "" {
+		path += "?todos=" + url.QueryEscape(todosPolicy)
+	}
+	req := httptest.NewRequest("DELETE", path, nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) ListProjectTodos(id string, query url.Values) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/projects/"+id+"/todos?"+query.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) CreateProjectTodo(id string, input map[string]interface{}) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest("POST", "/projects/"+id+"/todos", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) MoveTodo(id string, projectID *string) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(map[string]interface{}{"project_id": projectID})
+	req := httptest.NewRequest("PUT", "/todos/"+id+"/project", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) CreateWebhook(input map[string]interface{}) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest("POST", "/webhooks", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) GetWebhook(id string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/webhooks/"+id, nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) UpdateWebhook(id string, input map[string]interface{}) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest("PUT", "/webhooks/"+id, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) DeleteWebhook(id string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("DELETE", "/webhooks/"+id, nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+func (c *HTTPClient) ListWebhookDeliveries(id string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest("GET", "/webhooks/"+id+"/deliveries", nil)
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+// SendWithIfMatch sends a request to the todo API with an If-Match header, left out when ifMatch is empty.
+func (c *HTTPClient) SendWithIfMatch(method, path string, input map[string]interface{}, ifMatch string) (*httptest.ResponseRecorder, error) {
+	var body []byte
+	if input != nil {
+		body, _ = json.Marshal(input)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	if input != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+// GraphQL posts a GraphQL operation with its variables to the todo API.
+func (c *HTTPClient) GraphQL(query string, variables map[string]interface{}) (*httptest.ResponseRecorder, error) {
+	body, _ := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	req := httptest.NewRequest("POST", "/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c.app.ServeHTTP(rec, req)
+	return rec, nil
+}
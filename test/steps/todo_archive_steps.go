@@ -0,0 +1,131 @@
+package steps
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoArchiveContext struct {
+	BaseTestContext
+	CreatedTodoIDs map[string]string
+}
+
+func (tc *TodoArchiveContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	return tc.ResetDatabase()
+}
+
+func (tc *TodoArchiveContext) IHaveCreatedATodo(title string) error {
+	id, err := tc.CreateTodoWithInput(map[string]interface{}{"title": title})
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	tc.CreatedTodoIDs[title] = id
+	return nil
+}
+
+func (tc *TodoArchiveContext) IHaveCompletedTheTodo(title string) error {
+	rec, err := tc.UseHTTPClient().CompleteTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusOK)
+}
+
+func (tc *TodoArchiveContext) IArchiveTheTodo(title string) error {
+	return tc.IArchiveTheTodoWithID(tc.CreatedTodoIDs[title])
+}
+
+func (tc *TodoArchiveContext) IArchiveTheTodoWithID(id string) error {
+	rec, err := tc.UseHTTPClient().ArchiveTodo(id)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoArchiveContext) IHaveArchivedTheTodo(title string) error {
+	if err := tc.IArchiveTheTodo(title); err != nil {
+		return err
+	}
+	return validateResponseHeaders(tc.Response, helpers.StatusOK)
+}
+
+func (tc *TodoArchiveContext) IUnarchiveTheTodo(title string) error {
+	rec, err := tc.UseHTTPClient().UnarchiveTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoArchiveContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *TodoArchiveContext) TheTodoShouldBeArchivedWithStatus(status string) error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.ArchivedAt == nil {
+		return fmt.Errorf("expected the todo to be archived")
+	}
+	if todo.Status != status {
+		return fmt.Errorf("expected status %q, got %q", status, todo.Status)
+	}
+	return nil
+}
+
+func (tc *TodoArchiveContext) TheTodoShouldNotBeArchived() error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.ArchivedAt != nil {
+		return fmt.Errorf("expected the todo not to be archived, got archived_at %s", todo.ArchivedAt)
+	}
+	return nil
+}
+
+func (tc *TodoArchiveContext) TheTodosShouldBe(titles string) error {
+	rec, err := tc.UseHTTPClient().ListTodos()
+	if err != nil {
+		return err
+	}
+	return validateTodoTitles(rec, titles)
+}
+
+func (tc *TodoArchiveContext) TheTodosIncludingTheArchivedOnesShouldBe(titles string) error {
+	rec, err := tc.UseHTTPClient().ListTodosWithQuery(url.Values{"include_archived": {"true"}})
+	if err != nil {
+		return err
+	}
+	return validateTodoTitles(rec, titles)
+}
+
+func (tc *TodoArchiveContext) TheResponseShouldContainErrorMessage(message string) error {
+	return validateErrorResponse(tc.Response, tc.Response.Code, message)
+}
+
+func (tc *TodoArchiveContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I have created a todo "([^"]*)"$`, tc.IHaveCreatedATodo)
+	ctx.Step(`^I have completed the todo "([^"]*)"$`, tc.IHaveCompletedTheTodo)
+	ctx.Step(`^I have archived the todo "([^"]*)"$`, tc.IHaveArchivedTheTodo)
+	ctx.Step(`^I archive the todo "([^"]*)"$`, tc.IArchiveTheTodo)
+	ctx.Step(`^I archive the todo with ID "([^"]*)"$`, tc.IArchiveTheTodoWithID)
+	ctx.Step(`^I unarchive the todo "([^"]*)"$`, tc.IUnarchiveTheTodo)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the todo should be archived with status "([^"]*)"$`, tc.TheTodoShouldBeArchivedWithStatus)
+	ctx.Step(`^the todo should not be archived$`, tc.TheTodoShouldNotBeArchived)
+	ctx.Step(`^the todos should be "([^"]*)"$`, tc.TheTodosShouldBe)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+	ctx.Step(`^the todos including the archived ones should be "([^"]*)"$`, tc.TheTodosIncludingTheArchivedOnesShouldBe)
+}
@@ -0,0 +1,142 @@
+package steps
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoBulkContext struct {
+	BaseTestContext
+	// TodoIDs maps the title of each todo created by the scenario to its id
+	TodoIDs map[string]string
+}
+
+func (tc *TodoBulkContext) ResetDatabaseAndContext() error {
+	tc.TodoIDs = map[string]string{}
+	return tc.ResetDatabase()
+}
+
+func (tc *TodoBulkContext) IHaveCreatedTheTodos(first, second string) error {
+	for _, title := range []string{first, second} {
+		id, err := tc.CreateTodoWithInput(map[string]interface{}{"title": title})
+		if err != nil {
+			return fmt.Errorf("failed to create todo for test: %v", err)
+		}
+		tc.TodoIDs[title] = id
+	}
+	return nil
+}
+
+func (tc *TodoBulkContext) ISendTheBulkOperations(table *godog.Table) error {
+	return tc.sendBulk(table, false)
+}
+
+func (tc *TodoBulkContext) ISendTheAtomicBulkOperations(table *godog.Table) error {
+	return tc.sendBulk(table, true)
+}
+
+// sendBulk sends the operations of the table, whose todo column is the title of the todo
+// changed. A title not created by the scenario stands for the missing id "<title>-id".
+func (tc *TodoBulkContext) sendBulk(table *godog.Table, atomic bool) error {
+	operations := make([]map[string]interface{}, 0, len(table.Rows)-1)
+	for _, row := range table.Rows[1:] {
+		op, todo, title := row.Cells[0].Value, row.Cells[1].Value, row.Cells[2].Value
+		operation := map[string]interface{}{"op": op}
+		if todo != "" {
+			id, ok := tc.TodoIDs[todo]
+			if !ok {
+				id = todo + "-id"
+			}
+			operation["id"] = id
+		}
+		if op == "create" || op == "update" {
+			operation["title"] = title
+		}
+		operations = append(operations, operation)
+	}
+	rec, err := tc.UseHTTPClient().BulkTodos(operations, atomic)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoBulkContext) TheResponseShouldHaveStatus(status int) error {
+	return helpers.ValidateStatus(tc.Response, status)
+}
+
+func (tc *TodoBulkContext) TheBulkResultsShouldBe(table *godog.Table) error {
+	resp, err := helpers.ParseTodoBulkResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if len(resp.Results) != len(table.Rows)-1 {
+		return fmt.Errorf("expected %d results, got %d", len(table.Rows)-1, len(resp.Results))
+	}
+	for i, row := range table.Rows[1:] {
+		op, status, message := row.Cells[0].Value, row.Cells[1].Value, row.Cells[2].Value
+		result := resp.Results[i]
+		if result.Op != op || strconv.Itoa(result.Status) != status || result.Error != message {
+			return fmt.Errorf("expected result %d to be %s %s %q, got %s %d %q",
+				i, op, status, message, result.Op, result.Status, result.Error)
+		}
+		if (result.Todo != nil) != (result.Status == http.StatusOK || result.Status == http.StatusCreated) {
+			return fmt.Errorf("result %d with status %d has an unexpected todo", i, result.Status)
+		}
+	}
+	return nil
+}
+
+func (tc *TodoBulkContext) TheTodosShouldBe(table *godog.Table) error {
+	rec, err := tc.UseHTTPClient().ListTodos()
+	if err != nil {
+		return err
+	}
+	todos, err := helpers.ParseTodoListResponse(rec)
+	if err != nil {
+		return err
+	}
+	actual := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		actual = append(actual, todo.Title+":"+todo.Status)
+	}
+	expected := make([]string, 0, len(table.Rows)-1)
+	for _, row := range table.Rows[1:] {
+		expected = append(expected, row.Cells[0].Value+":"+row.Cells[1].Value)
+	}
+	slices.Sort(actual)
+	slices.Sort(expected)
+	if !slices.Equal(actual, expected) {
+		return fmt.Errorf("expected todos %v, got %v", expected, actual)
+	}
+	return nil
+}
+
+func (tc *TodoBulkContext) TheResponseShouldContainErrorMessage(message string) error {
+	errResp, err := helpers.ParseErrorResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if errResp.Message != message {
+		return fmt.Errorf("expected error message '%s', got '%s'", message, errResp.Message)
+	}
+	return nil
+}
+
+func (tc *TodoBulkContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I have created the todos "([^"]*)" and "([^"]*)"$`, tc.IHaveCreatedTheTodos)
+	ctx.Step(`^I send the bulk operations:$`, tc.ISendTheBulkOperations)
+	ctx.Step(`^I send the atomic bulk operations:$`, tc.ISendTheAtomicBulkOperations)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the bulk results should be:$`, tc.TheBulkResultsShouldBe)
+	ctx.Step(`^the todos should be:$`, tc.TheTodosShouldBe)
+	ctx.Step(`^the response should contain error message "(.*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
@@ -0,0 +1,190 @@
+package steps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoChecklistContext struct {
+	BaseTestContext
+	TodoID  string
+	ItemIDs map[string]string
+}
+
+func (tc *TodoChecklistContext) ResetDatabaseAndContext() error {
+	tc.TodoID = ""
+	tc.ItemIDs = map[string]string{}
+	return tc.ResetDatabase()
+}
+
+func (tc *TodoChecklistContext) IHaveCreatedATodoWithTheChecklist(title, items string) error {
+	id, err := tc.CreateTodoWithInput(map[string]interface{}{"title": title})
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	tc.TodoID = id
+	for _, item := range splitList(items) {
+		if err := tc.IAddTheItem(item); err != nil {
+			return err
+		}
+		if err := validateResponseHeaders(tc.Response, helpers.StatusCreated); err != nil {
+			return fmt.Errorf("failed to add item %q for test: %v", item, err)
+		}
+	}
+	return nil
+}
+
+func (tc *TodoChecklistContext) IAddTheItem(title string) error {
+	rec, err := tc.UseHTTPClient().AddTodoItem(tc.TodoID, title)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return tc.rememberItemIDs()
+}
+
+func (tc *TodoChecklistContext) IToggleTheItem(title string) error {
+	rec, err := tc.UseHTTPClient().ToggleTodoItem(tc.TodoID, tc.itemID(title))
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoChecklistContext) IRemoveTheItem(title string) error {
+	rec, err := tc.UseHTTPClient().RemoveTodoItem(tc.TodoID, tc.itemID(title))
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoChecklistContext) IReorderTheItemsAs(titles string) error {
+	ids := make([]string, 0)
+	for _, title := range splitList(titles) {
+		ids = append(ids, tc.itemID(title))
+	}
+	rec, err := tc.UseHTTPClient().ReorderTodoItems(tc.TodoID, ids)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoChecklistContext) ICompleteTheTodo() error {
+	rec, err := tc.UseHTTPClient().CompleteTodo(tc.TodoID)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoChecklistContext) ICompleteTheTodoWithOpenItems(policy string) error {
+	rec, err := tc.UseHTTPClient().CompleteTodoWithOpenItems(tc.TodoID, policy)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoChecklistContext) IGetTheTodo() error {
+	rec, err := tc.UseHTTPClient().GetTodo(tc.TodoID)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoChecklistContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *TodoChecklistContext) TheChecklistShouldBe(expected string) error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	actual := make([]string, 0, len(todo.Items))
+	for _, item := range todo.Items {
+		mark := " "
+		if item.Done {
+			mark = "x"
+		}
+		actual = append(actual, fmt.Sprintf("[%s] %s", mark, item.Title))
+	}
+	if strings.Join(actual, ", ") != expected {
+		return fmt.Errorf("expected checklist %q, got %q", expected, strings.Join(actual, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoChecklistContext) TheTodoStatusShouldBe(status string) error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.Status != status {
+		return fmt.Errorf("expected status %s, got %s", status, todo.Status)
+	}
+	return nil
+}
+
+func (tc *TodoChecklistContext) TheResponseShouldContainErrorMessage(message string) error {
+	errResp, err := helpers.ParseErrorResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if errResp.Message != message {
+		return fmt.Errorf("expected error message '%s', got '%s'", message, errResp.Message)
+	}
+	return nil
+}
+
+// rememberItemIDs maps the titles of the checklist in the last response to their ids.
+func (tc *TodoChecklistContext) rememberItemIDs() error {
+	if tc.Response.Code != helpers.StatusCreated {
+		return nil
+	}
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	for _, item := range todo.Items {
+		tc.ItemIDs[item.Title] = item.ID
+	}
+	return nil
+}
+
+// itemID returns the id of the item with the title, or the title itself for unknown items.
+func (tc *TodoChecklistContext) itemID(title string) string {
+	if id, ok := tc.ItemIDs[title]; ok {
+		return id
+	}
+	return title
+}
+
+func (tc *TodoChecklistContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I have created a todo "([^"]*)" with the checklist "([^"]*)"$`, tc.IHaveCreatedATodoWithTheChecklist)
+	ctx.Step(`^I add the item "([^"]*)"$`, tc.IAddTheItem)
+	ctx.Step(`^I toggle the item "([^"]*)"$`, tc.IToggleTheItem)
+	ctx.Step(`^I remove the item "([^"]*)"$`, tc.IRemoveTheItem)
+	ctx.Step(`^I reorder the items as "([^"]*)"$`, tc.IReorderTheItemsAs)
+	ctx.Step(`^I complete the todo$`, tc.ICompleteTheTodo)
+	ctx.Step(`^I complete the todo with open items "([^"]*)"$`, tc.ICompleteTheTodoWithOpenItems)
+	ctx.Step(`^I get the todo$`, tc.IGetTheTodo)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the checklist should be "([^"]*)"$`, tc.TheChecklistShouldBe)
+	ctx.Step(`^the todo status should be "([^"]*)"$`, tc.TheTodoStatusShouldBe)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
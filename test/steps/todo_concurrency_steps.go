@@ -0,0 +1,162 @@
+package steps
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoConcurrencyContext struct {
+	BaseTestContext
+	CreatedTodoID string
+}
+
+func (tc *TodoConcurrencyContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoID = ""
+	return tc.ResetDatabase()
+}
+
+// etag formats a todo version the way the API writes it in the ETag header.
+func etag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+func (tc *TodoConcurrencyContext) IHaveCreatedATodo(title string) error {
+	id, err := tc.CreateTodoWithInput(map[string]interface{}{"title": title})
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	tc.CreatedTodoID = id
+	return nil
+}
+
+func (tc *TodoConcurrencyContext) TheTodoHasBeenRenamedTo(title string) error {
+	rec, err := tc.UseHTTPClient().UpdateTodo(tc.CreatedTodoID, map[string]interface{}{"title": title})
+	if err != nil {
+		return err
+	}
+	if rec.Code != http.StatusOK {
+		return fmt.Errorf("failed to rename todo for test: %s", rec.Body.String())
+	}
+	return nil
+}
+
+func (tc *TodoConcurrencyContext) IRequestTheTodo() error {
+	rec, err := tc.UseHTTPClient().GetTodo(tc.CreatedTodoID)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoConcurrencyContext) IRenameTheTodoTo(title string) error {
+	return tc.rename(title, "")
+}
+
+func (tc *TodoConcurrencyContext) IRenameTheTodoToAtVersion(title string, version int) error {
+	return tc.rename(title, etag(version))
+}
+
+func (tc *TodoConcurrencyContext) IRenameTheTodoToWithTheIfMatchHeader(title, ifMatch string) error {
+	return tc.rename(title, ifMatch)
+}
+
+func (tc *TodoConcurrencyContext) rename(title, ifMatch string) error {
+	rec, err := tc.UseHTTPClient().SendWithIfMatch(http.MethodPut, "/todos/"+tc.CreatedTodoID,
+		map[string]interface{}{"title": title}, ifMatch)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoConcurrencyContext) ICompleteTheTodoAtVersion(version int) error {
+	return tc.send(http.MethodPost, "/todos/"+tc.CreatedTodoID+"/complete", version)
+}
+
+func (tc *TodoConcurrencyContext) IMarkTheTodoAsPendingAtVersion(version int) error {
+	return tc.send(http.MethodPost, "/todos/"+tc.CreatedTodoID+"/pending", version)
+}
+
+func (tc *TodoConcurrencyContext) IDeleteTheTodoAtVersion(version int) error {
+	return tc.send(http.MethodDelete, "/todos/"+tc.CreatedTodoID, version)
+}
+
+func (tc *TodoConcurrencyContext) send(method, path string, version int) error {
+	rec, err := tc.UseHTTPClient().SendWithIfMatch(method, path, nil, etag(version))
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoConcurrencyContext) TheResponseShouldHaveStatus(status int) error {
+	if tc.Response.Code != status {
+		return fmt.Errorf("expected status %d, got %d: %s", status, tc.Response.Code, tc.Response.Body.String())
+	}
+	return nil
+}
+
+func (tc *TodoConcurrencyContext) TheResponseShouldHaveTheETagOfVersion(version int) error {
+	if got := tc.Response.Header().Get("ETag"); got != etag(version) {
+		return fmt.Errorf("expected ETag %s, got %q", etag(version), got)
+	}
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.Version != version {
+		return fmt.Errorf("expected version %d, got %d", version, todo.Version)
+	}
+	return nil
+}
+
+func (tc *TodoConcurrencyContext) TheTodoShouldBeTitled(title string) error {
+	rec, err := tc.UseHTTPClient().GetTodo(tc.CreatedTodoID)
+	if err != nil {
+		return err
+	}
+	todo, err := helpers.ParseTodoResponse(rec)
+	if err != nil {
+		return err
+	}
+	if todo.Title != title {
+		return fmt.Errorf("expected title %q, got %q", title, todo.Title)
+	}
+	return nil
+}
+
+func (tc *TodoConcurrencyContext) TheResponseShouldContainErrorMessage(message string) error {
+	errResp, err := helpers.ParseErrorResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if errResp.Message != message {
+		return fmt.Errorf("expected error message '%s', got '%s'", message, errResp.Message)
+	}
+	return nil
+}
+
+func (tc *TodoConcurrencyContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I have created a todo "([^"]*)"$`, tc.IHaveCreatedATodo)
+	ctx.Step(`^the todo has been renamed to "([^"]*)"$`, tc.TheTodoHasBeenRenamedTo)
+	ctx.Step(`^I request the todo$`, tc.IRequestTheTodo)
+	ctx.Step(`^I rename the todo to "([^"]*)"$`, tc.IRenameTheTodoTo)
+	ctx.Step(`^I rename the todo to "([^"]*)" at version (\d+)$`, tc.IRenameTheTodoToAtVersion)
+	ctx.Step(`^I rename the todo to "([^"]*)" with the If-Match header '([^']*)'$`, tc.IRenameTheTodoToWithTheIfMatchHeader)
+	ctx.Step(`^I complete the todo at version (\d+)$`, tc.ICompleteTheTodoAtVersion)
+	ctx.Step(`^I mark the todo as pending at version (\d+)$`, tc.IMarkTheTodoAsPendingAtVersion)
+	ctx.Step(`^I delete the todo at version (\d+)$`, tc.IDeleteTheTodoAtVersion)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the response should have the ETag of version (\d+)$`, tc.TheResponseShouldHaveTheETagOfVersion)
+	ctx.Step(`^the todo should be titled "([^"]*)"$`, tc.TheTodoShouldBeTitled)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
@@ -15,6 +15,11 @@ func (tc *TodoCreationContext) IHaveATodoInput(title, desc, dueDate string) erro
 	return nil
 }
 
+func (tc *TodoCreationContext) TheTodoInputHasPriority(priority string) error {
+	tc.TodoInput["priority"] = priority
+	return nil
+}
+
 func (tc *TodoCreationContext) ICreateTheTodo() error {
 	client := tc.UseHTTPClient()
 	rec, err := client.CreateTodo(tc.TodoInput)
@@ -40,6 +45,7 @@ func (tc *TodoCreationContext) TheCreationShouldFailWithValidationError() error
 func (tc *TodoCreationContext) InitializeScenario(ctx *godog.ScenarioContext) {
 	ctx.Step(`^the database is reset$`, tc.ResetDatabase)
 	ctx.Step(`^I have a todo input with title "([^"]*)", description "([^"]*)" and due_date "([^"]*)"$`, tc.IHaveATodoInput)
+	ctx.Step(`^the todo input has priority "([^"]*)"$`, tc.TheTodoInputHasPriority)
 	ctx.Step(`^I create the todo$`, tc.ICreateTheTodo)
 	ctx.Step(`^the todo should be created successfully$`, tc.TheTodoShouldBeCreatedSuccessfully)
 	ctx.Step(`^the creation should fail with validation error$`, tc.TheCreationShouldFailWithValidationError)
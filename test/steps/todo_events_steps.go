@@ -0,0 +1,213 @@
+package steps
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+// streamTimeout is how long the steps wait for the events pushed on the stream.
+const streamTimeout = 2 * time.Second
+
+// streamedEvent is an event read from the todo events stream.
+type streamedEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+type TodoEventsContext struct {
+	BaseTestContext
+	CreatedTodoIDs map[string]string
+	server         *httptest.Server
+	disconnect     context.CancelFunc
+	events         chan streamedEvent
+	received       []streamedEvent
+	status         int
+}
+
+func (tc *TodoEventsContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	tc.close()
+	tc.server = httptest.NewServer(tc.EchoApp)
+	return tc.ResetDatabase()
+}
+
+// close disconnects from the stream and stops the server.
+func (tc *TodoEventsContext) close() {
+	if tc.disconnect != nil {
+		tc.disconnect()
+		tc.disconnect = nil
+	}
+	if tc.server != nil {
+		tc.server.Close()
+		tc.server = nil
+	}
+}
+
+// connect opens the stream with the query and the Last-Event-ID header, left out when empty, and
+// reads its events in the background.
+func (tc *TodoEventsContext) connect(query url.Values, lastEventID string) error {
+	if tc.disconnect != nil {
+		tc.disconnect()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	tc.disconnect = cancel
+	tc.events = make(chan streamedEvent, 100)
+	tc.received = nil
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tc.server.URL+"/todos/events?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	tc.status = resp.StatusCode
+	go func(events chan<- streamedEvent) {
+		defer resp.Body.Close()
+		scanner := bufio.NewScanner(resp.Body)
+		var event streamedEvent
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				if event.event != "" {
+					events <- event
+				}
+				event = streamedEvent{}
+			case strings.HasPrefix(line, "id: "):
+				event.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				event.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				event.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}(tc.events)
+	return nil
+}
+
+func (tc *TodoEventsContext) IConnectToTheTodoEventsStream() error {
+	return tc.connect(url.Values{}, "")
+}
+
+func (tc *TodoEventsContext) IConnectToTheTodoEventsStreamWithTheStatus(status string) error {
+	return tc.connect(url.Values{"status": {status}}, "")
+}
+
+func (tc *TodoEventsContext) IConnectToTheTodoEventsStreamWithLastEventID(lastEventID string) error {
+	return tc.connect(url.Values{}, lastEventID)
+}
+
+func (tc *TodoEventsContext) IReconnectToTheTodoEventsStreamAfterTheFirstEventReceived() error {
+	if len(tc.received) == 0 {
+		return fmt.Errorf("expected an event received before reconnecting")
+	}
+	return tc.connect(url.Values{}, tc.received[0].id)
+}
+
+func (tc *TodoEventsContext) IHaveCreatedATodo(title string) error {
+	rec, err := tc.UseHTTPClient().CreateTodo(map[string]interface{}{"title": title})
+	if err != nil {
+		return err
+	}
+	if err := validateResponseHeaders(rec, helpers.StatusCreated); err != nil {
+		return err
+	}
+	todo, err := helpers.ParseTodoResponse(rec)
+	if err != nil {
+		return err
+	}
+	tc.CreatedTodoIDs[title] = todo.ID
+	return nil
+}
+
+func (tc *TodoEventsContext) IHaveCompletedTheTodo(title string) error {
+	rec, err := tc.UseHTTPClient().CompleteTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusOK)
+}
+
+func (tc *TodoEventsContext) IHaveDeletedTheTodo(title string) error {
+	rec, err := tc.UseHTTPClient().DeleteTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusNoContent)
+}
+
+func (tc *TodoEventsContext) TheStreamResponseShouldHaveStatus(status int) error {
+	if tc.status != status {
+		return fmt.Errorf("expected status %d, got %d", status, tc.status)
+	}
+	return nil
+}
+
+// TheStreamShouldPushTheEvents waits for the events pushed on the stream, and checks they are
+// the expected ones in order.
+func (tc *TodoEventsContext) TheStreamShouldPushTheEvents(expected string) error {
+	want := splitList(expected)
+	timeout := time.After(streamTimeout)
+	for len(tc.received) < len(want) {
+		select {
+		case event := <-tc.events:
+			tc.received = append(tc.received, event)
+		case <-timeout:
+			return fmt.Errorf("expected the events %q, got %q after %s", expected, tc.receivedTypes(), streamTimeout)
+		}
+	}
+	if tc.receivedTypes() != expected {
+		return fmt.Errorf("expected the events %q, got %q", expected, tc.receivedTypes())
+	}
+	return nil
+}
+
+func (tc *TodoEventsContext) TheEventsShouldBeAboutTheTodo(title string) error {
+	for _, event := range tc.received {
+		if !strings.Contains(event.data, `"todo_id":"`+tc.CreatedTodoIDs[title]+`"`) {
+			return fmt.Errorf("expected the %s event about the todo %q, got %s", event.event, title, event.data)
+		}
+	}
+	return nil
+}
+
+func (tc *TodoEventsContext) receivedTypes() string {
+	types := make([]string, 0, len(tc.received))
+	for _, event := range tc.received {
+		types = append(types, event.event)
+	}
+	return strings.Join(types, ",")
+}
+
+func (tc *TodoEventsContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.After(func(ctx context.Context, _ *godog.Scenario, err error) (context.Context, error) {
+		tc.close()
+		return ctx, err
+	})
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I connect to the todo events stream$`, tc.IConnectToTheTodoEventsStream)
+	ctx.Step(`^I connect to the todo events stream with the status "([^"]*)"$`, tc.IConnectToTheTodoEventsStreamWithTheStatus)
+	ctx.Step(`^I connect to the todo events stream with the Last-Event-ID "([^"]*)"$`, tc.IConnectToTheTodoEventsStreamWithLastEventID)
+	ctx.Step(`^I reconnect to the todo events stream after the first event received$`, tc.IReconnectToTheTodoEventsStreamAfterTheFirstEventReceived)
+	ctx.Step(`^I have created a todo "([^"]*)"$`, tc.IHaveCreatedATodo)
+	ctx.Step(`^I have completed the todo "([^"]*)"$`, tc.IHaveCompletedTheTodo)
+	ctx.Step(`^I have deleted the todo "([^"]*)"$`, tc.IHaveDeletedTheTodo)
+	ctx.Step(`^the stream response should have status (\d+)$`, tc.TheStreamResponseShouldHaveStatus)
+	ctx.Step(`^the stream should push the events "([^"]*)"$`, tc.TheStreamShouldPushTheEvents)
+	ctx.Step(`^the events should be about the todo "([^"]*)"$`, tc.TheEventsShouldBeAboutTheTodo)
+}
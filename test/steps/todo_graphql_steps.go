@@ -0,0 +1,210 @@
+package steps
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cucumber/godog"
+)
+
+// graphqlResponse is the response of a GraphQL operation.
+type graphqlResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string `json:"message"`
+		Extensions struct {
+			Code string `json:"code"`
+		} `json:"extensions"`
+	} `json:"errors"`
+}
+
+type TodoGraphQLContext struct {
+	BaseTestContext
+	CreatedTodoIDs map[string]string
+	response       graphqlResponse
+	// todo are the fields of the last todo returned
+	todo map[string]interface{}
+}
+
+func (tc *TodoGraphQLContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	tc.response = graphqlResponse{}
+	tc.todo = nil
+	tc.ResetHTTPClient()
+	return tc.ResetDatabase()
+}
+
+// todoID is the ID of the todo created with the title, or the title itself when there is none.
+func (tc *TodoGraphQLContext) todoID(title string) string {
+	if id, ok := tc.CreatedTodoIDs[title]; ok {
+		return id
+	}
+	return title
+}
+
+// run posts the operation and keeps its response, along with the todo returned by the field.
+func (tc *TodoGraphQLContext) run(field, query string, variables map[string]interface{}) error {
+	rec, err := tc.UseHTTPClient().GraphQL(query, variables)
+	if err != nil {
+		return err
+	}
+	if rec.Code != http.StatusOK {
+		return fmt.Errorf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	tc.response = graphqlResponse{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &tc.response); err != nil {
+		return err
+	}
+	tc.todo = nil
+	if data, ok := tc.response.Data[field]; ok {
+		_ = json.Unmarshal(data, &tc.todo)
+	}
+	return nil
+}
+
+func (tc *TodoGraphQLContext) ICreateTheTodoWithGraphQL(title string) error {
+	err := tc.run("createTodo", `mutation Create($title: String!) { createTodo(input: {title: $title}) { id } }`,
+		map[string]interface{}{"title": title})
+	if err != nil {
+		return err
+	}
+	if id, ok := tc.todo["id"].(string); ok {
+		tc.CreatedTodoIDs[title] = id
+	}
+	return nil
+}
+
+func (tc *TodoGraphQLContext) IHaveCreatedTheTodoWithGraphQL(title string) error {
+	if err := tc.ICreateTheTodoWithGraphQL(title); err != nil {
+		return err
+	}
+	return tc.TheGraphQLResponseShouldHaveNoErrors()
+}
+
+func (tc *TodoGraphQLContext) IQueryTheTodoWithGraphQL(title string) error {
+	return tc.run("todo", `query Get($id: ID!) { todo(id: $id) { id title status } }`,
+		map[string]interface{}{"id": tc.todoID(title)})
+}
+
+func (tc *TodoGraphQLContext) ICompleteTheTodoWithGraphQL(title string) error {
+	return tc.run("completeTodo", `mutation Complete($id: ID!) { completeTodo(id: $id) { id status } }`,
+		map[string]interface{}{"id": tc.todoID(title)})
+}
+
+func (tc *TodoGraphQLContext) IMarkTheTodoAsPendingWithGraphQL(title string) error {
+	return tc.run("markTodoAsPending", `mutation Pending($id: ID!) { markTodoAsPending(id: $id) { id status } }`,
+		map[string]interface{}{"id": tc.todoID(title)})
+}
+
+func (tc *TodoGraphQLContext) IUpdateTheTodoAtTheVersionWithGraphQL(title string, version int) error {
+	return tc.run("updateTodo",
+		`mutation Update($id: ID!, $title: String!, $version: Int) {
+			updateTodo(id: $id, version: $version, input: {title: $title}) { id version }
+		}`,
+		map[string]interface{}{"id": tc.todoID(title), "title": title, "version": version})
+}
+
+func (tc *TodoGraphQLContext) IDeleteTheTodoWithGraphQL(title string) error {
+	return tc.run("deleteTodo", `mutation Delete($id: ID!) { deleteTodo(id: $id) }`,
+		map[string]interface{}{"id": tc.todoID(title)})
+}
+
+func (tc *TodoGraphQLContext) TheGraphQLResponseShouldHaveNoErrors() error {
+	if len(tc.response.Errors) > 0 {
+		return fmt.Errorf("expected no GraphQL errors, got %q", tc.response.Errors[0].Message)
+	}
+	return nil
+}
+
+func (tc *TodoGraphQLContext) TheGraphQLResponseShouldHaveTheErrorCode(code string) error {
+	if len(tc.response.Errors) != 1 {
+		return fmt.Errorf("expected one GraphQL error, got %d", len(tc.response.Errors))
+	}
+	if got := tc.response.Errors[0].Extensions.Code; got != code {
+		return fmt.Errorf("expected the error code %q, got %q (%s)", code, got, tc.response.Errors[0].Message)
+	}
+	return nil
+}
+
+func (tc *TodoGraphQLContext) QueryingTheTodoWithGraphQLShouldReturnOnlyTheFields(title, fields string) error {
+	if err := tc.IQueryTheTodoWithGraphQL(title); err != nil {
+		return err
+	}
+	if err := tc.TheGraphQLResponseShouldHaveNoErrors(); err != nil {
+		return err
+	}
+	got := make([]string, 0, len(tc.todo))
+	for field := range tc.todo {
+		got = append(got, field)
+	}
+	expected := splitList(fields)
+	slices.Sort(got)
+	slices.Sort(expected)
+	if !slices.Equal(got, expected) {
+		return fmt.Errorf("expected the fields %q, got %q", expected, got)
+	}
+	if tc.todo["title"] != title {
+		return fmt.Errorf("expected the todo %q, got %v", title, tc.todo["title"])
+	}
+	return nil
+}
+
+func (tc *TodoGraphQLContext) TheGraphQLTodoShouldHaveTheStatus(todoStatus string) error {
+	if tc.todo["status"] != todoStatus {
+		return fmt.Errorf("expected the status %q, got %v", todoStatus, tc.todo["status"])
+	}
+	return nil
+}
+
+func (tc *TodoGraphQLContext) QueryingTheTodosWithTheStatusWithGraphQLShouldReturn(todoStatus, titles string) error {
+	if err := tc.run("todos", `query List($status: String) { todos(filter: {status: $status}) { todos { title } } }`,
+		map[string]interface{}{"status": todoStatus}); err != nil {
+		return err
+	}
+	if err := tc.TheGraphQLResponseShouldHaveNoErrors(); err != nil {
+		return err
+	}
+	var page struct {
+		Todos []struct {
+			Title string `json:"title"`
+		} `json:"todos"`
+	}
+	if err := json.Unmarshal(tc.response.Data["todos"], &page); err != nil {
+		return err
+	}
+	got := make([]string, 0, len(page.Todos))
+	for _, todo := range page.Todos {
+		got = append(got, todo.Title)
+	}
+	if strings.Join(got, ",") != titles {
+		return fmt.Errorf("expected the todos %q, got %q", titles, strings.Join(got, ","))
+	}
+	return nil
+}
+
+func (tc *TodoGraphQLContext) QueryingTheTodoWithGraphQLShouldHaveTheErrorCode(title, code string) error {
+	if err := tc.IQueryTheTodoWithGraphQL(title); err != nil {
+		return err
+	}
+	return tc.TheGraphQLResponseShouldHaveTheErrorCode(code)
+}
+
+func (tc *TodoGraphQLContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I create the todo "([^"]*)" with GraphQL$`, tc.ICreateTheTodoWithGraphQL)
+	ctx.Step(`^I have created the todo "([^"]*)" with GraphQL$`, tc.IHaveCreatedTheTodoWithGraphQL)
+	ctx.Step(`^I query the todo "([^"]*)" with GraphQL$`, tc.IQueryTheTodoWithGraphQL)
+	ctx.Step(`^I complete the todo "([^"]*)" with GraphQL$`, tc.ICompleteTheTodoWithGraphQL)
+	ctx.Step(`^I mark the todo "([^"]*)" as pending with GraphQL$`, tc.IMarkTheTodoAsPendingWithGraphQL)
+	ctx.Step(`^I update the todo "([^"]*)" at the version (\d+) with GraphQL$`, tc.IUpdateTheTodoAtTheVersionWithGraphQL)
+	ctx.Step(`^I delete the todo "([^"]*)" with GraphQL$`, tc.IDeleteTheTodoWithGraphQL)
+	ctx.Step(`^the GraphQL response should have no errors$`, tc.TheGraphQLResponseShouldHaveNoErrors)
+	ctx.Step(`^the GraphQL response should have the error code "([^"]*)"$`, tc.TheGraphQLResponseShouldHaveTheErrorCode)
+	ctx.Step(`^querying the todo "([^"]*)" with GraphQL should return only the fields "([^"]*)"$`, tc.QueryingTheTodoWithGraphQLShouldReturnOnlyTheFields)
+	ctx.Step(`^the GraphQL todo should have the status "([^"]*)"$`, tc.TheGraphQLTodoShouldHaveTheStatus)
+	ctx.Step(`^querying the todos with the status "([^"]*)" with GraphQL should return "([^"]*)"$`, tc.QueryingTheTodosWithTheStatusWithGraphQLShouldReturn)
+	ctx.Step(`^querying the todo "([^"]*)" with GraphQL should have the error code "([^"]*)"$`, tc.QueryingTheTodoWithGraphQLShouldHaveTheErrorCode)
+}
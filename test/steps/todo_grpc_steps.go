@@ -0,0 +1,215 @@
+package steps
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/cucumber/godog"
+	todov1 "github.com/wellingtonlope/todo-api/api/todo/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+type TodoGRPCContext struct {
+	BaseTestContext
+	// Listener is the in-memory listener the gRPC server of the application serves
+	Listener       *bufconn.Listener
+	CreatedTodoIDs map[string]string
+	conn           *grpc.ClientConn
+	client         todov1.TodoServiceClient
+	err            error
+	todo           *todov1.Todo
+	watchCancel    context.CancelFunc
+	events         chan *todov1.Event
+}
+
+func (tc *TodoGRPCContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	tc.err = nil
+	tc.todo = nil
+	tc.close()
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return tc.Listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return err
+	}
+	tc.conn = conn
+	tc.client = todov1.NewTodoServiceClient(conn)
+	return tc.ResetDatabase()
+}
+
+// close stops watching and closes the connection.
+func (tc *TodoGRPCContext) close() {
+	if tc.watchCancel != nil {
+		tc.watchCancel()
+		tc.watchCancel = nil
+	}
+	if tc.conn != nil {
+		_ = tc.conn.Close()
+		tc.conn = nil
+	}
+}
+
+// todoID is the ID of the todo created with the title, or the title itself when there is none.
+func (tc *TodoGRPCContext) todoID(title string) string {
+	if id, ok := tc.CreatedTodoIDs[title]; ok {
+		return id
+	}
+	return title
+}
+
+func (tc *TodoGRPCContext) ICreateTheTodoOverGRPC(title string) error {
+	tc.todo, tc.err = tc.client.Create(context.Background(), &todov1.CreateRequest{Title: title})
+	if tc.err == nil {
+		tc.CreatedTodoIDs[title] = tc.todo.GetId()
+	}
+	return nil
+}
+
+func (tc *TodoGRPCContext) IHaveCreatedTheTodoOverGRPC(title string) error {
+	if err := tc.ICreateTheTodoOverGRPC(title); err != nil {
+		return err
+	}
+	return tc.TheGRPCCallShouldSucceed()
+}
+
+func (tc *TodoGRPCContext) IGetTheTodoOverGRPC(title string) error {
+	tc.todo, tc.err = tc.client.GetByID(context.Background(), &todov1.GetByIDRequest{Id: tc.todoID(title)})
+	return nil
+}
+
+func (tc *TodoGRPCContext) ICompleteTheTodoOverGRPC(title string) error {
+	tc.todo, tc.err = tc.client.Complete(context.Background(), &todov1.CompleteRequest{Id: tc.todoID(title)})
+	return nil
+}
+
+func (tc *TodoGRPCContext) IDeleteTheTodoOverGRPC(title string) error {
+	_, tc.err = tc.client.DeleteByID(context.Background(), &todov1.DeleteByIDRequest{Id: tc.todoID(title)})
+	return nil
+}
+
+func (tc *TodoGRPCContext) IUpdateTheTodoAtTheVersionOverGRPC(title string, version int) error {
+	tc.todo, tc.err = tc.client.Update(context.Background(), &todov1.UpdateRequest{
+		Id:      tc.todoID(title),
+		Title:   title,
+		Version: proto.Int32(int32(version)),
+	})
+	return nil
+}
+
+func (tc *TodoGRPCContext) TheGRPCCallShouldSucceed() error {
+	if tc.err != nil {
+		return fmt.Errorf("expected the gRPC call to succeed, got %v", tc.err)
+	}
+	return nil
+}
+
+func (tc *TodoGRPCContext) TheGRPCCallShouldFailWithTheCode(code string) error {
+	if got := status.Code(tc.err).String(); got != code {
+		return fmt.Errorf("expected the gRPC call to fail with the code %s, got %s (%v)", code, got, tc.err)
+	}
+	return nil
+}
+
+func (tc *TodoGRPCContext) GettingTheTodoOverGRPCShouldReturnItWithStatus(title, todoStatus string) error {
+	if err := tc.IGetTheTodoOverGRPC(title); err != nil {
+		return err
+	}
+	if err := tc.TheGRPCCallShouldSucceed(); err != nil {
+		return err
+	}
+	if tc.todo.GetTitle() != title || tc.todo.GetStatus() != todoStatus {
+		return fmt.Errorf("expected the %s todo %q, got the %s todo %q",
+			todoStatus, title, tc.todo.GetStatus(), tc.todo.GetTitle())
+	}
+	return nil
+}
+
+func (tc *TodoGRPCContext) ListingTheTodosWithTheStatusOverGRPCShouldReturn(todoStatus, titles string) error {
+	response, err := tc.client.List(context.Background(), &todov1.ListRequest{Status: proto.String(todoStatus)})
+	if err != nil {
+		return fmt.Errorf("expected the gRPC call to succeed, got %v", err)
+	}
+	got := make([]string, 0, len(response.GetTodos()))
+	for _, todo := range response.GetTodos() {
+		got = append(got, todo.GetTitle())
+	}
+	if strings.Join(got, ",") != titles {
+		return fmt.Errorf("expected the todos %q, got %q", titles, strings.Join(got, ","))
+	}
+	return nil
+}
+
+// IWatchTheTodosOverGRPC starts watching and waits for the headers of the call, sent once it is
+// subscribed, before receiving the events in the background.
+func (tc *TodoGRPCContext) IWatchTheTodosOverGRPC() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	tc.watchCancel = cancel
+	watch, err := tc.client.Watch(ctx, &todov1.WatchRequest{})
+	if err != nil {
+		return err
+	}
+	if _, err := watch.Header(); err != nil {
+		return err
+	}
+	tc.events = make(chan *todov1.Event, 100)
+	go func(events chan<- *todov1.Event) {
+		for {
+			event, err := watch.Recv()
+			if err != nil {
+				return
+			}
+			events <- event
+		}
+	}(tc.events)
+	return nil
+}
+
+// TheWatchShouldReceiveTheEvents waits for the events of the watch, and checks they are the expected
+// ones in order.
+func (tc *TodoGRPCContext) TheWatchShouldReceiveTheEvents(expected string) error {
+	var received []string
+	timeout := time.After(streamTimeout)
+	for len(received) < len(splitList(expected)) {
+		select {
+		case event := <-tc.events:
+			received = append(received, event.GetType())
+		case <-timeout:
+			return fmt.Errorf("expected the events %q, got %q after %s", expected, strings.Join(received, ","), streamTimeout)
+		}
+	}
+	if strings.Join(received, ",") != expected {
+		return fmt.Errorf("expected the events %q, got %q", expected, strings.Join(received, ","))
+	}
+	return nil
+}
+
+func (tc *TodoGRPCContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.After(func(ctx context.Context, _ *godog.Scenario, err error) (context.Context, error) {
+		tc.close()
+		return ctx, err
+	})
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I create the todo "([^"]*)" over gRPC$`, tc.ICreateTheTodoOverGRPC)
+	ctx.Step(`^I have created the todo "([^"]*)" over gRPC$`, tc.IHaveCreatedTheTodoOverGRPC)
+	ctx.Step(`^I get the todo "([^"]*)" over gRPC$`, tc.IGetTheTodoOverGRPC)
+	ctx.Step(`^I complete the todo "([^"]*)" over gRPC$`, tc.ICompleteTheTodoOverGRPC)
+	ctx.Step(`^I delete the todo "([^"]*)" over gRPC$`, tc.IDeleteTheTodoOverGRPC)
+	ctx.Step(`^I update the todo "([^"]*)" at the version (\d+) over gRPC$`, tc.IUpdateTheTodoAtTheVersionOverGRPC)
+	ctx.Step(`^I watch the todos over gRPC$`, tc.IWatchTheTodosOverGRPC)
+	ctx.Step(`^the gRPC call should succeed$`, tc.TheGRPCCallShouldSucceed)
+	ctx.Step(`^the gRPC call should fail with the code "([^"]*)"$`, tc.TheGRPCCallShouldFailWithTheCode)
+	ctx.Step(`^getting the todo "([^"]*)" over gRPC should return it with status "([^"]*)"$`, tc.GettingTheTodoOverGRPCShouldReturnItWithStatus)
+	ctx.Step(`^listing the todos with the status "([^"]*)" over gRPC should return "([^"]*)"$`, tc.ListingTheTodosWithTheStatusOverGRPCShouldReturn)
+	ctx.Step(`^the watch should receive the events "([^"]*)"$`, tc.TheWatchShouldReceiveTheEvents)
+}
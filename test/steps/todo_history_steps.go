@@ -0,0 +1,120 @@
+package steps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoHistoryContext struct {
+	BaseTestContext
+	CreatedTodoIDs map[string]string
+}
+
+func (tc *TodoHistoryContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	return tc.ResetDatabase()
+}
+
+func (tc *TodoHistoryContext) IHaveCreatedATodo(title string) error {
+	id, err := tc.CreateTodoWithInput(map[string]interface{}{"title": title})
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	tc.CreatedTodoIDs[title] = id
+	return nil
+}
+
+func (tc *TodoHistoryContext) IMoveTheTodoTo(title, status string) error {
+	rec, err := tc.UseHTTPClient().TransitionTodo(tc.CreatedTodoIDs[title], status)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoHistoryContext) IHaveMovedTheTodoTo(title, status string) error {
+	if err := tc.IMoveTheTodoTo(title, status); err != nil {
+		return err
+	}
+	return validateResponseHeaders(tc.Response, helpers.StatusOK)
+}
+
+func (tc *TodoHistoryContext) IGetTheHistoryOfTheTodo(title string) error {
+	return tc.IGetTheHistoryOfTheTodoWithID(tc.CreatedTodoIDs[title])
+}
+
+func (tc *TodoHistoryContext) IGetTheHistoryOfTheTodoWithID(id string) error {
+	rec, err := tc.UseHTTPClient().TodoHistory(id)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoHistoryContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *TodoHistoryContext) TheHistoryShouldBe(expected string) error {
+	changes, err := helpers.ParseStatusHistoryResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	got := make([]string, 0, len(changes))
+	for _, change := range changes {
+		if change.ChangedAt.IsZero() {
+			return fmt.Errorf("expected the change %s->%s to have a date", change.From, change.To)
+		}
+		got = append(got, change.From+"->"+change.To)
+	}
+	if strings.Join(got, ", ") != expected {
+		return fmt.Errorf("expected history %q, got %q", expected, strings.Join(got, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoHistoryContext) TheTodoShouldHaveACompletionDate() error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.CompletedAt == nil {
+		return fmt.Errorf("expected the todo to have a completion date")
+	}
+	return nil
+}
+
+func (tc *TodoHistoryContext) TheTodoShouldNotHaveACompletionDate() error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.CompletedAt != nil {
+		return fmt.Errorf("expected the todo to have no completion date, got %v", *todo.CompletedAt)
+	}
+	return nil
+}
+
+func (tc *TodoHistoryContext) TheResponseShouldContainErrorMessage(message string) error {
+	return validateErrorResponse(tc.Response, tc.Response.Code, message)
+}
+
+func (tc *TodoHistoryContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I have created a todo "([^"]*)"$`, tc.IHaveCreatedATodo)
+	ctx.Step(`^I move the todo "([^"]*)" to "([^"]*)"$`, tc.IMoveTheTodoTo)
+	ctx.Step(`^I have moved the todo "([^"]*)" to "([^"]*)"$`, tc.IHaveMovedTheTodoTo)
+	ctx.Step(`^I get the history of the todo "([^"]*)"$`, tc.IGetTheHistoryOfTheTodo)
+	ctx.Step(`^I get the history of the todo with id "([^"]*)"$`, tc.IGetTheHistoryOfTheTodoWithID)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the history should be "([^"]*)"$`, tc.TheHistoryShouldBe)
+	ctx.Step(`^the todo should have a completion date$`, tc.TheTodoShouldHaveACompletionDate)
+	ctx.Step(`^the todo should not have a completion date$`, tc.TheTodoShouldNotHaveACompletionDate)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
@@ -3,6 +3,9 @@ package steps
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cucumber/godog"
@@ -14,6 +17,7 @@ type TodoListContext struct {
 	BaseTestContext
 	CreatedTodoIDs []string
 	ResetStoreFunc func()
+	NextCursor     string
 }
 
 func (tc *TodoListContext) IRequestAllTodos() error {
@@ -71,6 +75,15 @@ func (tc *TodoListContext) IHaveCreatedATodoWith(title, desc, dueDate string) er
 	return nil
 }
 
+func (tc *TodoListContext) IHaveCreatedATodoWithPriority(title, priority string) error {
+	id, err := tc.CreateTodoWithInput(map[string]interface{}{"title": title, "priority": priority})
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	tc.CreatedTodoIDs = append(tc.CreatedTodoIDs, id)
+	return nil
+}
+
 func (tc *TodoListContext) IHaveCreatedACompletedTodoWith(title, desc, dueDate string) error {
 	id, err := tc.CreateTodoForTest(title, desc, dueDate)
 	if err != nil {
@@ -173,8 +186,128 @@ func (tc *TodoListContext) validateTodoAtIndex(index int, expectedTitle, expecte
 	return nil
 }
 
+func (tc *TodoListContext) IRequestTodosWithLimit(limit int) error {
+	client := tc.UseHTTPClient()
+	rec, err := client.ListTodosWithQuery(url.Values{"limit": {strconv.Itoa(limit)}})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoListContext) IRequestTheNextPageWithLimit(limit int) error {
+	client := tc.UseHTTPClient()
+	rec, err := client.ListTodosWithQuery(url.Values{
+		"limit":  {strconv.Itoa(limit)},
+		"cursor": {tc.NextCursor},
+	})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoListContext) IRequestTodosSortedByInOrder(sort, order string) error {
+	client := tc.UseHTTPClient()
+	rec, err := client.ListTodosWithQuery(url.Values{"sort": {sort}, "order": {order}})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoListContext) IRequestTodosWithFilterSetTo(param, value string) error {
+	client := tc.UseHTTPClient()
+	rec, err := client.ListTodosWithQuery(url.Values{param: {value}})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoListContext) IRequestTodosWithFiltersSetTo(param1, value1, param2, value2 string) error {
+	client := tc.UseHTTPClient()
+	rec, err := client.ListTodosWithQuery(url.Values{param1: {value1}, param2: {value2}})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoListContext) TheTodosShouldBeInTitleOrder(titles string) error {
+	todos, err := helpers.ParseTodoListResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	expected := strings.Split(titles, ", ")
+	actual := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		actual = append(actual, todo.Title)
+	}
+	if strings.Join(actual, ", ") != strings.Join(expected, ", ") {
+		return fmt.Errorf("expected todos in order %q, got %q", strings.Join(expected, ", "), strings.Join(actual, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoListContext) IRequestTodosWithCursor(cursor string) error {
+	client := tc.UseHTTPClient()
+	rec, err := client.ListTodosWithQuery(url.Values{"cursor": {cursor}})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoListContext) TheResponseShouldContainAPageWithTodos(count int) error {
+	page, err := helpers.ParseTodoPageResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if len(page.Data) != count {
+		return fmt.Errorf("expected %d todos in the page, got %d", count, len(page.Data))
+	}
+	tc.NextCursor = page.NextCursor
+	return nil
+}
+
+func (tc *TodoListContext) ThePageTodoAtPositionShouldHaveTitle(position int, title string) error {
+	page, err := helpers.ParseTodoPageResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if position < 1 || position > len(page.Data) {
+		return fmt.Errorf("todo position %d out of range, only %d todos available", position, len(page.Data))
+	}
+	if page.Data[position-1].Title != title {
+		return fmt.Errorf("expected title %s, got %s", title, page.Data[position-1].Title)
+	}
+	return nil
+}
+
+func (tc *TodoListContext) TheResponseShouldHaveANextCursor() error {
+	if tc.NextCursor == "" {
+		return fmt.Errorf("expected a next cursor, got none")
+	}
+	return nil
+}
+
+func (tc *TodoListContext) TheResponseShouldNotHaveANextCursor() error {
+	if tc.NextCursor != "" {
+		return fmt.Errorf("expected no next cursor, got %s", tc.NextCursor)
+	}
+	return nil
+}
+
 func (tc *TodoListContext) ResetDatabaseAndContext() error {
 	tc.CreatedTodoIDs = []string{}
+	tc.NextCursor = ""
 	// Reset both GORM database and in-memory store
 	if tc.ResetStoreFunc != nil {
 		tc.ResetStoreFunc()
@@ -198,7 +331,19 @@ func (tc *TodoListContext) InitializeScenario(ctx *godog.ScenarioContext) {
 	ctx.Step(`^the response should contain a list with (\d+) todos$`, tc.TheResponseShouldContainAListWithTodos)
 	ctx.Step(`^the response should contain a list with (\d+) todo$`, tc.TheResponseShouldContainAListWithTodos)
 	ctx.Step(`^I have created a todo with title "([^"]*)", description "([^"]*)" and due_date "([^"]*)"$`, tc.IHaveCreatedATodoWith)
+	ctx.Step(`^I have created a todo with title "([^"]*)" and priority "([^"]*)"$`, tc.IHaveCreatedATodoWithPriority)
 	ctx.Step(`^I have created a completed todo with title "([^"]*)", description "([^"]*)" and due_date "([^"]*)"$`, tc.IHaveCreatedACompletedTodoWith)
 	ctx.Step(`^the first todo should have title "([^"]*)", description "([^"]*)" and due_date "([^"]*)"$`, tc.TheFirstTodoShouldHaveTitleDescDueDate)
+	ctx.Step(`^I request todos with limit (\d+)$`, tc.IRequestTodosWithLimit)
+	ctx.Step(`^I request the next page with limit (\d+)$`, tc.IRequestTheNextPageWithLimit)
+	ctx.Step(`^I request todos sorted by "([^"]*)" in "([^"]*)" order$`, tc.IRequestTodosSortedByInOrder)
+	ctx.Step(`^I request todos with "([^"]*)" set to "([^"]*)"$`, tc.IRequestTodosWithFilterSetTo)
+	ctx.Step(`^I request todos with "([^"]*)" set to "([^"]*)" and "([^"]*)" set to "([^"]*)"$`, tc.IRequestTodosWithFiltersSetTo)
+	ctx.Step(`^the todos should be in the order "([^"]*)"$`, tc.TheTodosShouldBeInTitleOrder)
+	ctx.Step(`^I request todos with cursor "([^"]*)"$`, tc.IRequestTodosWithCursor)
+	ctx.Step(`^the response should contain a page with (\d+) todos?$`, tc.TheResponseShouldContainAPageWithTodos)
+	ctx.Step(`^the todo at position (\d+) of the page should have title "([^"]*)"$`, tc.ThePageTodoAtPositionShouldHaveTitle)
+	ctx.Step(`^the response should have a next cursor$`, tc.TheResponseShouldHaveANextCursor)
+	ctx.Step(`^the response should not have a next cursor$`, tc.TheResponseShouldNotHaveANextCursor)
 	ctx.Step(`^the second todo should have title "([^"]*)", description "([^"]*)" and due_date "([^"]*)"$`, tc.TheSecondTodoShouldHaveTitleDescDueDate)
 }
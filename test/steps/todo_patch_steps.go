@@ -0,0 +1,125 @@
+package steps
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoPatchContext struct {
+	BaseTestContext
+	CreatedTodoID string
+}
+
+func (tc *TodoPatchContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoID = ""
+	return tc.ResetDatabase()
+}
+
+func (tc *TodoPatchContext) IHaveCreatedATodo(body *godog.DocString) error {
+	var input map[string]interface{}
+	if err := json.Unmarshal([]byte(body.Content), &input); err != nil {
+		return err
+	}
+	id, err := tc.CreateTodoWithInput(input)
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	tc.CreatedTodoID = id
+	return nil
+}
+
+func (tc *TodoPatchContext) IPatchTheTodoWith(contentType string, patch *godog.DocString) error {
+	return tc.patch(tc.CreatedTodoID, contentType, patch)
+}
+
+func (tc *TodoPatchContext) IPatchTheTodoWithIDWith(id, contentType string, patch *godog.DocString) error {
+	return tc.patch(id, contentType, patch)
+}
+
+func (tc *TodoPatchContext) patch(id, contentType string, patch *godog.DocString) error {
+	rec, err := tc.UseHTTPClient().PatchTodo(id, contentType, patch.Content)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoPatchContext) TheResponseShouldHaveStatus(status int) error {
+	if tc.Response.Code != status {
+		return fmt.Errorf("expected status %d, got %d: %s", status, tc.Response.Code, tc.Response.Body.String())
+	}
+	return nil
+}
+
+func (tc *TodoPatchContext) ThePatchedTodoShouldHave(table *godog.Table) error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	dueDate := ""
+	if todo.DueDate != nil {
+		dueDate = todo.DueDate.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	actual := map[string]string{
+		"title":       todo.Title,
+		"description": todo.Description,
+		"status":      todo.Status,
+		"priority":    todo.Priority,
+		"tags":        strings.Join(todo.Tags, ","),
+		"due_date":    dueDate,
+	}
+	for _, row := range table.Rows[1:] {
+		field, expected := row.Cells[0].Value, row.Cells[1].Value
+		got, ok := actual[field]
+		if !ok {
+			return fmt.Errorf("unknown todo field %q", field)
+		}
+		if got != expected {
+			return fmt.Errorf("expected %s %q, got %q", field, expected, got)
+		}
+	}
+	return nil
+}
+
+func (tc *TodoPatchContext) TheTodoShouldStillBeTitled(title string) error {
+	rec, err := tc.UseHTTPClient().GetTodo(tc.CreatedTodoID)
+	if err != nil {
+		return err
+	}
+	todo, err := helpers.ParseTodoResponse(rec)
+	if err != nil {
+		return err
+	}
+	if todo.Title != title {
+		return fmt.Errorf("expected title %q, got %q", title, todo.Title)
+	}
+	return nil
+}
+
+func (tc *TodoPatchContext) TheResponseShouldContainErrorMessage(message string) error {
+	errResp, err := helpers.ParseErrorResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if errResp.Message != message {
+		return fmt.Errorf("expected error message '%s', got '%s'", message, errResp.Message)
+	}
+	return nil
+}
+
+func (tc *TodoPatchContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I have created a todo:$`, tc.IHaveCreatedATodo)
+	ctx.Step(`^I patch the todo with "([^"]*)":$`, tc.IPatchTheTodoWith)
+	ctx.Step(`^I patch the todo with ID "([^"]*)" with "([^"]*)":$`, tc.IPatchTheTodoWithIDWith)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the patched todo should have:$`, tc.ThePatchedTodoShouldHave)
+	ctx.Step(`^the todo should still be titled "([^"]*)"$`, tc.TheTodoShouldStillBeTitled)
+	ctx.Step(`^the response should contain error message "(.*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
@@ -0,0 +1,298 @@
+package steps
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoProjectsContext struct {
+	BaseTestContext
+	CreatedProjectIDs map[string]string
+	CreatedTodoIDs    map[string]string
+}
+
+func (tc *TodoProjectsContext) ResetDatabaseAndContext() error {
+	tc.CreatedProjectIDs = map[string]string{}
+	tc.CreatedTodoIDs = map[string]string{}
+	return tc.ResetDatabase()
+}
+
+// projectID returns the id of a project created by the scenario, or the name itself for unknown projects.
+func (tc *TodoProjectsContext) projectID(name string) string {
+	if id, ok := tc.CreatedProjectIDs[name]; ok {
+		return id
+	}
+	return name
+}
+
+func (tc *TodoProjectsContext) IHaveCreatedAProject(name string) error {
+	rec, err := tc.UseHTTPClient().CreateProject(map[string]interface{}{"name": name})
+	if err != nil {
+		return err
+	}
+	project, err := helpers.ParseProjectResponse(rec)
+	if err != nil || project.ID == "" {
+		return fmt.Errorf("failed to create project for test: %s", rec.Body.String())
+	}
+	tc.CreatedProjectIDs[name] = project.ID
+	return nil
+}
+
+func (tc *TodoProjectsContext) IHaveCreatedATodoInTheProject(title, project string) error {
+	rec, err := tc.UseHTTPClient().CreateProjectTodo(tc.projectID(project), map[string]interface{}{"title": title})
+	if err != nil {
+		return err
+	}
+	return tc.rememberTodo(title, rec.Body.Bytes())
+}
+
+func (tc *TodoProjectsContext) IHaveCreatedATodoWithoutProject(title string) error {
+	id, err := tc.CreateTodoWithInput(map[string]interface{}{"title": title})
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	tc.CreatedTodoIDs[title] = id
+	return nil
+}
+
+func (tc *TodoProjectsContext) rememberTodo(title string, body []byte) error {
+	var todo helpers.TodoResponse
+	if err := json.Unmarshal(body, &todo); err != nil || todo.ID == "" {
+		return fmt.Errorf("failed to create todo for test: %s", body)
+	}
+	tc.CreatedTodoIDs[title] = todo.ID
+	return nil
+}
+
+func (tc *TodoProjectsContext) ICreateAProject(name string) error {
+	rec, err := tc.UseHTTPClient().CreateProject(map[string]interface{}{"name": name})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) IRenameTheProjectTo(name, newName string) error {
+	rec, err := tc.UseHTTPClient().UpdateProject(tc.projectID(name), map[string]interface{}{"name": newName})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) IRequestTheProject(name string) error {
+	rec, err := tc.UseHTTPClient().GetProject(tc.projectID(name))
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) IRequestAllProjects() error {
+	rec, err := tc.UseHTTPClient().ListProjects()
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) ICreateATodoInTheProject(title, project string) error {
+	rec, err := tc.UseHTTPClient().CreateProjectTodo(tc.projectID(project), map[string]interface{}{"title": title})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) IRequestTheTodosOfTheProject(project string) error {
+	rec, err := tc.UseHTTPClient().ListProjectTodos(tc.projectID(project), url.Values{})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) IMoveTheTodoToTheProject(title, project string) error {
+	projectID := tc.projectID(project)
+	rec, err := tc.UseHTTPClient().MoveTodo(tc.CreatedTodoIDs[title], &projectID)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) IRemoveTheTodoFromItsProject(title string) error {
+	rec, err := tc.UseHTTPClient().MoveTodo(tc.CreatedTodoIDs[title], nil)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) IDeleteTheProject(name string) error {
+	rec, err := tc.UseHTTPClient().DeleteProject(tc.projectID(name), "")
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) IDeleteTheProjectWithTodosPolicy(name, policy string) error {
+	rec, err := tc.UseHTTPClient().DeleteProject(tc.projectID(name), policy)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) IRequestTheTodo(title string) error {
+	rec, err := tc.UseHTTPClient().GetTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoProjectsContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *TodoProjectsContext) TheProjectShouldBeNamed(name string) error {
+	project, err := helpers.ParseProjectResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if project.Name != name {
+		return fmt.Errorf("expected project name %q, got %q", name, project.Name)
+	}
+	return nil
+}
+
+func (tc *TodoProjectsContext) TheProjectsShouldBe(names string) error {
+	projects, err := helpers.ParseProjectListResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	actual := make([]string, 0, len(projects))
+	for _, project := range projects {
+		actual = append(actual, project.Name)
+	}
+	if strings.Join(actual, ", ") != names {
+		return fmt.Errorf("expected projects %q, got %q", names, strings.Join(actual, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoProjectsContext) TheTodoShouldBelongToTheProject(project string) error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.ProjectID == nil || *todo.ProjectID != tc.projectID(project) {
+		return fmt.Errorf("expected todo in project %q, got %v", project, todo.ProjectID)
+	}
+	return nil
+}
+
+func (tc *TodoProjectsContext) TheTodoShouldNotBelongToAnyProject() error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.ProjectID != nil {
+		return fmt.Errorf("expected todo without project, got %q", *todo.ProjectID)
+	}
+	return nil
+}
+
+func (tc *TodoProjectsContext) TheTodoShouldBeAtVersion(version int) error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.Version != version {
+		return fmt.Errorf("expected todo at version %d, got %d", version, todo.Version)
+	}
+	return nil
+}
+
+func (tc *TodoProjectsContext) TheTrashShouldBe(titles string) error {
+	rec, err := tc.UseHTTPClient().ListTrash()
+	if err != nil {
+		return err
+	}
+	if err := validateTodoTitles(rec, titles); err != nil {
+		return fmt.Errorf("trash: %w", err)
+	}
+	return nil
+}
+
+func (tc *TodoProjectsContext) TheTodosShouldBe(titles string) error {
+	todos, err := helpers.ParseTodoListResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	actual := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		actual = append(actual, todo.Title)
+	}
+	if strings.Join(actual, ", ") != titles {
+		return fmt.Errorf("expected todos %q, got %q", titles, strings.Join(actual, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoProjectsContext) TheResponseShouldContainErrorMessage(message string) error {
+	errResp, err := helpers.ParseErrorResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if errResp.Message != message {
+		return fmt.Errorf("expected error message '%s', got '%s'", message, errResp.Message)
+	}
+	return nil
+}
+
+func (tc *TodoProjectsContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I have created a project "([^"]*)"$`, tc.IHaveCreatedAProject)
+	ctx.Step(`^I have created a todo "([^"]*)" in the project "([^"]*)"$`, tc.IHaveCreatedATodoInTheProject)
+	ctx.Step(`^I have created a todo "([^"]*)" without project$`, tc.IHaveCreatedATodoWithoutProject)
+	ctx.Step(`^I create a project "([^"]*)"$`, tc.ICreateAProject)
+	ctx.Step(`^I rename the project "([^"]*)" to "([^"]*)"$`, tc.IRenameTheProjectTo)
+	ctx.Step(`^I request the project "([^"]*)"$`, tc.IRequestTheProject)
+	ctx.Step(`^I request all projects$`, tc.IRequestAllProjects)
+	ctx.Step(`^I create a todo "([^"]*)" in the project "([^"]*)"$`, tc.ICreateATodoInTheProject)
+	ctx.Step(`^I request the todos of the project "([^"]*)"$`, tc.IRequestTheTodosOfTheProject)
+	ctx.Step(`^I move the todo "([^"]*)" to the project "([^"]*)"$`, tc.IMoveTheTodoToTheProject)
+	ctx.Step(`^I remove the todo "([^"]*)" from its project$`, tc.IRemoveTheTodoFromItsProject)
+	ctx.Step(`^I delete the project "([^"]*)"$`, tc.IDeleteTheProject)
+	ctx.Step(`^I delete the project "([^"]*)" with todos policy "([^"]*)"$`, tc.IDeleteTheProjectWithTodosPolicy)
+	ctx.Step(`^I request the todo "([^"]*)"$`, tc.IRequestTheTodo)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the project should be named "([^"]*)"$`, tc.TheProjectShouldBeNamed)
+	ctx.Step(`^the projects should be "([^"]*)"$`, tc.TheProjectsShouldBe)
+	ctx.Step(`^the todo should belong to the project "([^"]*)"$`, tc.TheTodoShouldBelongToTheProject)
+	ctx.Step(`^the todo should not belong to any project$`, tc.TheTodoShouldNotBelongToAnyProject)
+	ctx.Step(`^the todo should be at version (\d+)$`, tc.TheTodoShouldBeAtVersion)
+	ctx.Step(`^the todos should be "([^"]*)"$`, tc.TheTodosShouldBe)
+	ctx.Step(`^the trash should be "([^"]*)"$`, tc.TheTrashShouldBe)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
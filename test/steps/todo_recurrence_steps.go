@@ -0,0 +1,158 @@
+package steps
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoRecurrenceContext struct {
+	BaseTestContext
+	TodoID string
+}
+
+func (tc *TodoRecurrenceContext) ICreateATodoDueRecurringWith(title, dueDate, recurrence string) error {
+	rec, err := tc.UseHTTPClient().CreateTodo(map[string]interface{}{
+		"title":      title,
+		"due_date":   dueDate,
+		"recurrence": recurrence,
+	})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	if rec.Code == helpers.StatusCreated {
+		todo, err := helpers.ParseTodoResponse(rec)
+		if err != nil {
+			return err
+		}
+		tc.TodoID = todo.ID
+	}
+	return nil
+}
+
+func (tc *TodoRecurrenceContext) IHaveCreatedATodoDueRecurringWith(title, dueDate, recurrence string) error {
+	if err := tc.ICreateATodoDueRecurringWith(title, dueDate, recurrence); err != nil {
+		return err
+	}
+	return validateResponseHeaders(tc.Response, helpers.StatusCreated)
+}
+
+func (tc *TodoRecurrenceContext) ICompleteTheTodo() error {
+	rec, err := tc.UseHTTPClient().CompleteTodo(tc.TodoID)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoRecurrenceContext) ICompleteTheNextOccurrence() error {
+	todo, err := tc.pendingTodo()
+	if err != nil {
+		return err
+	}
+	tc.TodoID = todo.ID
+	return tc.ICompleteTheTodo()
+}
+
+func (tc *TodoRecurrenceContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *TodoRecurrenceContext) TheTodoShouldRecurWith(recurrence string) error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.Recurrence != recurrence {
+		return fmt.Errorf("expected recurrence %q, got %q", recurrence, todo.Recurrence)
+	}
+	return nil
+}
+
+func (tc *TodoRecurrenceContext) ThereShouldBePendingTodos(count int) error {
+	todos, err := tc.listPendingTodos()
+	if err != nil {
+		return err
+	}
+	if len(todos) != count {
+		return fmt.Errorf("expected %d pending todos, got %d", count, len(todos))
+	}
+	return nil
+}
+
+func (tc *TodoRecurrenceContext) ThePendingTodoShouldBeDueRecurringWith(title, dueDate, recurrence string) error {
+	todo, err := tc.pendingTodo()
+	if err != nil {
+		return err
+	}
+	expectedDueDate, err := time.Parse(time.RFC3339, dueDate)
+	if err != nil {
+		return err
+	}
+	if todo.Title != title {
+		return fmt.Errorf("expected title %q, got %q", title, todo.Title)
+	}
+	if todo.DueDate == nil || !todo.DueDate.Equal(expectedDueDate) {
+		return fmt.Errorf("expected due date %s, got %v", dueDate, todo.DueDate)
+	}
+	if todo.Recurrence != recurrence {
+		return fmt.Errorf("expected recurrence %q, got %q", recurrence, todo.Recurrence)
+	}
+	return nil
+}
+
+func (tc *TodoRecurrenceContext) TheResponseShouldContainErrorMessage(message string) error {
+	errResp, err := helpers.ParseErrorResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if errResp.Message != message {
+		return fmt.Errorf("expected error message '%s', got '%s'", message, errResp.Message)
+	}
+	return nil
+}
+
+func (tc *TodoRecurrenceContext) listPendingTodos() ([]helpers.TodoResponse, error) {
+	rec, err := tc.UseHTTPClient().ListTodosWithQuery(url.Values{"status": {"pending"}})
+	if err != nil {
+		return nil, err
+	}
+	return helpers.ParseTodoListResponse(rec)
+}
+
+// pendingTodo returns the only pending todo, the occurrence spawned by the last completion.
+func (tc *TodoRecurrenceContext) pendingTodo() (helpers.TodoResponse, error) {
+	todos, err := tc.listPendingTodos()
+	if err != nil {
+		return helpers.TodoResponse{}, err
+	}
+	if len(todos) != 1 {
+		titles := make([]string, len(todos))
+		for i, todo := range todos {
+			titles[i] = todo.Title
+		}
+		return helpers.TodoResponse{}, fmt.Errorf("expected one pending todo, got %q", strings.Join(titles, ", "))
+	}
+	return todos[0], nil
+}
+
+func (tc *TodoRecurrenceContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabase)
+	ctx.Step(`^I create a todo "([^"]*)" due "([^"]*)" recurring with "([^"]*)"$`, tc.ICreateATodoDueRecurringWith)
+	ctx.Step(`^I have created a todo "([^"]*)" due "([^"]*)" recurring with "([^"]*)"$`, tc.IHaveCreatedATodoDueRecurringWith)
+	ctx.Step(`^I complete the todo$`, tc.ICompleteTheTodo)
+	ctx.Step(`^I complete the next occurrence$`, tc.ICompleteTheNextOccurrence)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the todo should recur with "([^"]*)"$`, tc.TheTodoShouldRecurWith)
+	ctx.Step(`^there should be (\d+) pending todos?$`, tc.ThereShouldBePendingTodos)
+	ctx.Step(`^the pending todo should be "([^"]*)" due "([^"]*)" recurring with "([^"]*)"$`,
+		tc.ThePendingTodoShouldBeDueRecurringWith)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
@@ -0,0 +1,97 @@
+package steps
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoSearchContext struct {
+	BaseTestContext
+}
+
+func (tc *TodoSearchContext) IHaveCreatedATodoWith(title, desc string) error {
+	if _, err := tc.CreateTodoForTest(title, desc, ""); err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	return nil
+}
+
+func (tc *TodoSearchContext) IHaveCreatedACompletedTodoWith(title, desc string) error {
+	id, err := tc.CreateTodoForTest(title, desc, "")
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	if _, err := tc.UseHTTPClient().CompleteTodo(id); err != nil {
+		return fmt.Errorf("failed to complete todo: %v", err)
+	}
+	return nil
+}
+
+func (tc *TodoSearchContext) ISearchTodosFor(query string) error {
+	return tc.search(url.Values{"q": {query}})
+}
+
+func (tc *TodoSearchContext) ISearchTodosForWithSetTo(query, param, value string) error {
+	return tc.search(url.Values{"q": {query}, param: {value}})
+}
+
+func (tc *TodoSearchContext) search(query url.Values) error {
+	rec, err := tc.UseHTTPClient().SearchTodos(query)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoSearchContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *TodoSearchContext) TheResultsShouldBe(titles string) error {
+	todos, err := helpers.ParseTodoListResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	actual := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		actual = append(actual, todo.Title)
+	}
+	if strings.Join(actual, ", ") != titles {
+		return fmt.Errorf("expected results %q, got %q", titles, strings.Join(actual, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoSearchContext) TheResponseShouldContainNoResults() error {
+	return tc.TheResultsShouldBe("")
+}
+
+func (tc *TodoSearchContext) TheResponseShouldContainErrorMessage(message string) error {
+	var errResp map[string]string
+	if err := json.Unmarshal(tc.Response.Body.Bytes(), &errResp); err != nil {
+		return fmt.Errorf("failed to parse error response: %v", err)
+	}
+	if errResp["message"] != message {
+		return fmt.Errorf("expected error message '%s', got '%s'", message, errResp["message"])
+	}
+	return nil
+}
+
+func (tc *TodoSearchContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabase)
+	ctx.Step(`^I have created a todo with title "([^"]*)" and description "([^"]*)"$`, tc.IHaveCreatedATodoWith)
+	ctx.Step(`^I have created a completed todo with title "([^"]*)" and description "([^"]*)"$`, tc.IHaveCreatedACompletedTodoWith)
+	ctx.Step(`^I search todos for "([^"]*)"$`, tc.ISearchTodosFor)
+	ctx.Step(`^I search todos for "([^"]*)" with "([^"]*)" set to "([^"]*)"$`, tc.ISearchTodosForWithSetTo)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the results should be "([^"]*)"$`, tc.TheResultsShouldBe)
+	ctx.Step(`^the response should contain no results$`, tc.TheResponseShouldContainNoResults)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
@@ -0,0 +1,244 @@
+package steps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/cucumber/godog"
+	"github.com/gorilla/websocket"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+// socketMessage is a message received on the todo socket.
+type socketMessage struct {
+	Type    string                `json:"type"`
+	ID      string                `json:"id"`
+	TodoIDs []string              `json:"todo_ids"`
+	Todo    *helpers.TodoResponse `json:"todo"`
+	Error   *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+	Event *struct {
+		Type   string `json:"type"`
+		TodoID string `json:"todo_id"`
+	} `json:"event"`
+}
+
+type TodoSocketContext struct {
+	BaseTestContext
+	CreatedTodoIDs map[string]string
+	server         *httptest.Server
+	conn           *websocket.Conn
+	messages       chan socketMessage
+	// received are the messages read from the socket and not checked yet
+	received []socketMessage
+}
+
+func (tc *TodoSocketContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	tc.close()
+	tc.server = httptest.NewServer(tc.EchoApp)
+	return tc.ResetDatabase()
+}
+
+// close closes the socket and stops the server.
+func (tc *TodoSocketContext) close() {
+	if tc.conn != nil {
+		_ = tc.conn.Close()
+		tc.conn = nil
+	}
+	if tc.server != nil {
+		tc.server.Close()
+		tc.server = nil
+	}
+}
+
+// IOpenTheTodoSocket opens the socket and reads its messages in the background.
+func (tc *TodoSocketContext) IOpenTheTodoSocket() error {
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(tc.server.URL, "http")+"/ws", nil)
+	if err != nil {
+		return err
+	}
+	tc.conn = conn
+	tc.messages = make(chan socketMessage, 100)
+	tc.received = nil
+	go func(messages chan<- socketMessage) {
+		for {
+			var message socketMessage
+			if err := conn.ReadJSON(&message); err != nil {
+				return
+			}
+			messages <- message
+		}
+	}(tc.messages)
+	return nil
+}
+
+func (tc *TodoSocketContext) send(message map[string]interface{}) error {
+	if tc.conn == nil {
+		return fmt.Errorf("expected the todo socket to be open")
+	}
+	return tc.conn.WriteJSON(message)
+}
+
+func (tc *TodoSocketContext) ISendTheMessageCreatingTheTodo(id, title string) error {
+	return tc.send(map[string]interface{}{"id": id, "type": "create", "todo": map[string]interface{}{"title": title}})
+}
+
+func (tc *TodoSocketContext) ISendTheMessageCompletingTheTodo(id, title string) error {
+	return tc.send(map[string]interface{}{"id": id, "type": "complete", "todo_id": tc.todoID(title)})
+}
+
+func (tc *TodoSocketContext) ISendTheMessageSubscribingToTheTodo(id, title string) error {
+	return tc.send(map[string]interface{}{"id": id, "type": "subscribe", "todo_ids": []string{tc.todoID(title)}})
+}
+
+// todoID is the ID of the todo created with the title, or the title itself when there is none.
+func (tc *TodoSocketContext) todoID(title string) string {
+	if id, ok := tc.CreatedTodoIDs[title]; ok {
+		return id
+	}
+	return title
+}
+
+func (tc *TodoSocketContext) IHaveCreatedATodo(title string) error {
+	rec, err := tc.UseHTTPClient().CreateTodo(map[string]interface{}{"title": title})
+	if err != nil {
+		return err
+	}
+	if err := validateResponseHeaders(rec, helpers.StatusCreated); err != nil {
+		return err
+	}
+	todo, err := helpers.ParseTodoResponse(rec)
+	if err != nil {
+		return err
+	}
+	tc.CreatedTodoIDs[title] = todo.ID
+	return nil
+}
+
+func (tc *TodoSocketContext) IHaveCompletedTheTodo(title string) error {
+	rec, err := tc.UseHTTPClient().CompleteTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusOK)
+}
+
+// next waits for the first message matching, removing it from the messages received. The answers
+// and the events of a change can come in any order, so the other messages are kept for later steps.
+func (tc *TodoSocketContext) next(description string, matches func(socketMessage) bool) (socketMessage, error) {
+	timeout := time.After(streamTimeout)
+	for i := 0; ; i++ {
+		for ; i < len(tc.received); i++ {
+			if matches(tc.received[i]) {
+				message := tc.received[i]
+				tc.received = append(tc.received[:i], tc.received[i+1:]...)
+				return message, nil
+			}
+		}
+		select {
+		case message := <-tc.messages:
+			tc.received = append(tc.received, message)
+			i--
+		case <-timeout:
+			received, _ := json.Marshal(tc.received)
+			return socketMessage{}, fmt.Errorf("expected %s after %s, got %s", description, streamTimeout, received)
+		}
+	}
+}
+
+// answer waits for the answer to the message with the id.
+func (tc *TodoSocketContext) answer(id string) (socketMessage, error) {
+	return tc.next(fmt.Sprintf("the answer to %q", id), func(message socketMessage) bool {
+		return message.ID == id && message.Type != "event"
+	})
+}
+
+func (tc *TodoSocketContext) TheSocketShouldAnswerWithTheTodoWithStatus(id, title, status string) error {
+	message, err := tc.answer(id)
+	if err != nil {
+		return err
+	}
+	if message.Type != "result" || message.Todo == nil {
+		return fmt.Errorf("expected a todo as the result of %q, got a %s message", id, message.Type)
+	}
+	if message.Todo.Title != title || message.Todo.Status != status {
+		return fmt.Errorf("expected the %s todo %q, got the %s todo %q", status, title, message.Todo.Status, message.Todo.Title)
+	}
+	tc.CreatedTodoIDs[title] = message.Todo.ID
+	return nil
+}
+
+func (tc *TodoSocketContext) TheSocketShouldAnswerWithTheSubscribedTodos(id, titles string) error {
+	message, err := tc.answer(id)
+	if err != nil {
+		return err
+	}
+	want := make([]string, 0)
+	for _, title := range splitList(titles) {
+		want = append(want, tc.todoID(title))
+	}
+	if message.Type != "result" || strings.Join(message.TodoIDs, ",") != strings.Join(want, ",") {
+		return fmt.Errorf("expected the subscribed todos %v as the result of %q, got %v", want, id, message.TodoIDs)
+	}
+	return nil
+}
+
+func (tc *TodoSocketContext) TheSocketShouldAnswerWithTheError(id, errorType string) error {
+	message, err := tc.answer(id)
+	if err != nil {
+		return err
+	}
+	if message.Type != "error" || message.Error == nil || message.Error.Type != errorType {
+		return fmt.Errorf("expected the error %q as the answer to %q, got a %s message", errorType, id, message.Type)
+	}
+	return nil
+}
+
+// TheSocketShouldPushTheEventsAboutTheTodo waits for the events pushed about the todo, checking
+// they are the expected ones in order and that no other todo had its events pushed.
+func (tc *TodoSocketContext) TheSocketShouldPushTheEventsAboutTheTodo(expected, title string) error {
+	var types []string
+	for range splitList(expected) {
+		message, err := tc.next(fmt.Sprintf("the events %q", expected), func(message socketMessage) bool {
+			return message.Type == "event"
+		})
+		if err != nil {
+			return err
+		}
+		if message.Event.TodoID != tc.CreatedTodoIDs[title] {
+			return fmt.Errorf("expected the events about the todo %q, got the %s event about %s",
+				title, message.Event.Type, message.Event.TodoID)
+		}
+		types = append(types, message.Event.Type)
+	}
+	if strings.Join(types, ",") != expected {
+		return fmt.Errorf("expected the events %q, got %q", expected, strings.Join(types, ","))
+	}
+	return nil
+}
+
+func (tc *TodoSocketContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.After(func(ctx context.Context, _ *godog.Scenario, err error) (context.Context, error) {
+		tc.close()
+		return ctx, err
+	})
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I open the todo socket$`, tc.IOpenTheTodoSocket)
+	ctx.Step(`^I have created a todo "([^"]*)"$`, tc.IHaveCreatedATodo)
+	ctx.Step(`^I have completed the todo "([^"]*)"$`, tc.IHaveCompletedTheTodo)
+	ctx.Step(`^I send the message "([^"]*)" creating the todo "([^"]*)"$`, tc.ISendTheMessageCreatingTheTodo)
+	ctx.Step(`^I send the message "([^"]*)" completing the todo "([^"]*)"$`, tc.ISendTheMessageCompletingTheTodo)
+	ctx.Step(`^I send the message "([^"]*)" subscribing to the todo "([^"]*)"$`, tc.ISendTheMessageSubscribingToTheTodo)
+	ctx.Step(`^the socket should answer "([^"]*)" with the todo "([^"]*)" with status "([^"]*)"$`, tc.TheSocketShouldAnswerWithTheTodoWithStatus)
+	ctx.Step(`^the socket should answer "([^"]*)" with the subscribed todos "([^"]*)"$`, tc.TheSocketShouldAnswerWithTheSubscribedTodos)
+	ctx.Step(`^the socket should answer "([^"]*)" with the error "([^"]*)"$`, tc.TheSocketShouldAnswerWithTheError)
+	ctx.Step(`^the socket should push the events "([^"]*)" about the todo "([^"]*)"$`, tc.TheSocketShouldPushTheEventsAboutTheTodo)
+}
@@ -0,0 +1,169 @@
+package steps
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoTagsContext struct {
+	BaseTestContext
+	CreatedTodoIDs map[string]string
+}
+
+func (tc *TodoTagsContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	return tc.ResetDatabase()
+}
+
+func (tc *TodoTagsContext) IHaveCreatedATodoTaggedWith(title, tags string) error {
+	id, err := tc.CreateTodoWithInput(map[string]interface{}{"title": title, "tags": splitList(tags)})
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	tc.CreatedTodoIDs[title] = id
+	return nil
+}
+
+func (tc *TodoTagsContext) ICreateATodoTaggedWith(title, tags string) error {
+	rec, err := tc.UseHTTPClient().CreateTodo(map[string]interface{}{"title": title, "tags": splitList(tags)})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoTagsContext) IRetagTheTodoWith(title, tags string) error {
+	rec, err := tc.UseHTTPClient().UpdateTodo(tc.CreatedTodoIDs[title],
+		map[string]interface{}{"title": title, "tags": splitList(tags)})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoTagsContext) IDeleteTheTodo(title string) error {
+	rec, err := tc.UseHTTPClient().DeleteTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoTagsContext) IRequestTodosTaggedWith(tags string) error {
+	return tc.listTodos(url.Values{"tag": {tags}})
+}
+
+func (tc *TodoTagsContext) IRequestTodosTaggedWithAllOf(tags string) error {
+	return tc.listTodos(url.Values{"tag": {tags}, "tag_mode": {"all"}})
+}
+
+func (tc *TodoTagsContext) IRequestTodosTaggedWithInMode(tags, mode string) error {
+	return tc.listTodos(url.Values{"tag": {tags}, "tag_mode": {mode}})
+}
+
+func (tc *TodoTagsContext) listTodos(query url.Values) error {
+	rec, err := tc.UseHTTPClient().ListTodosWithQuery(query)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoTagsContext) IRequestAllTags() error {
+	rec, err := tc.UseHTTPClient().ListTags()
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoTagsContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *TodoTagsContext) TheTodoShouldHaveTags(tags string) error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if strings.Join(todo.Tags, ", ") != tags {
+		return fmt.Errorf("expected tags %q, got %q", tags, strings.Join(todo.Tags, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoTagsContext) TheTodosShouldBe(titles string) error {
+	todos, err := helpers.ParseTodoListResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	actual := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		actual = append(actual, todo.Title)
+	}
+	if strings.Join(actual, ", ") != titles {
+		return fmt.Errorf("expected todos %q, got %q", titles, strings.Join(actual, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoTagsContext) TheTagsShouldBe(expected string) error {
+	tags, err := helpers.ParseTagListResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	actual := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		actual = append(actual, fmt.Sprintf("%s (%d)", tag.Name, tag.Count))
+	}
+	if strings.Join(actual, ", ") != expected {
+		return fmt.Errorf("expected tags %q, got %q", expected, strings.Join(actual, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoTagsContext) TheResponseShouldContainErrorMessage(message string) error {
+	errResp, err := helpers.ParseErrorResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if errResp.Message != message {
+		return fmt.Errorf("expected error message '%s', got '%s'", message, errResp.Message)
+	}
+	return nil
+}
+
+// splitList splits a comma-separated list of the feature files, an empty string being an empty list.
+func splitList(list string) []string {
+	if list == "" {
+		return []string{}
+	}
+	return strings.Split(list, ",")
+}
+
+func (tc *TodoTagsContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I have created a todo "([^"]*)" tagged with "([^"]*)"$`, tc.IHaveCreatedATodoTaggedWith)
+	ctx.Step(`^I create a todo "([^"]*)" tagged with "([^"]*)"$`, tc.ICreateATodoTaggedWith)
+	ctx.Step(`^I retag the todo "([^"]*)" with "([^"]*)"$`, tc.IRetagTheTodoWith)
+	ctx.Step(`^I delete the todo "([^"]*)"$`, tc.IDeleteTheTodo)
+	ctx.Step(`^I request todos tagged with "([^"]*)"$`, tc.IRequestTodosTaggedWith)
+	ctx.Step(`^I request todos tagged with all of "([^"]*)"$`, tc.IRequestTodosTaggedWithAllOf)
+	ctx.Step(`^I request todos tagged with "([^"]*)" in "([^"]*)" mode$`, tc.IRequestTodosTaggedWithInMode)
+	ctx.Step(`^I request all tags$`, tc.IRequestAllTags)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the todo should have tags "([^"]*)"$`, tc.TheTodoShouldHaveTags)
+	ctx.Step(`^the todos should be "([^"]*)"$`, tc.TheTodosShouldBe)
+	ctx.Step(`^the tags should be "([^"]*)"$`, tc.TheTagsShouldBe)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
@@ -0,0 +1,157 @@
+package steps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoTrashContext struct {
+	BaseTestContext
+	CreatedTodoIDs map[string]string
+	LastTodoID     string
+}
+
+func (tc *TodoTrashContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	return tc.ResetDatabase()
+}
+
+func (tc *TodoTrashContext) IHaveCreatedATodoTaggedWith(title, tags string) error {
+	id, err := tc.CreateTodoWithInput(map[string]interface{}{"title": title, "tags": splitList(tags)})
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	tc.CreatedTodoIDs[title] = id
+	return nil
+}
+
+func (tc *TodoTrashContext) IDeleteTheTodo(title string) error {
+	tc.LastTodoID = tc.CreatedTodoIDs[title]
+	rec, err := tc.UseHTTPClient().DeleteTodo(tc.LastTodoID)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoTrashContext) IHaveDeletedTheTodo(title string) error {
+	if err := tc.IDeleteTheTodo(title); err != nil {
+		return err
+	}
+	return validateResponseHeaders(tc.Response, helpers.StatusNoContent)
+}
+
+func (tc *TodoTrashContext) IPermanentlyDeleteTheTodo(title string) error {
+	tc.LastTodoID = tc.CreatedTodoIDs[title]
+	rec, err := tc.UseHTTPClient().DeleteTodoPermanently(tc.LastTodoID)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoTrashContext) IRestoreTheTodo(title string) error {
+	tc.LastTodoID = tc.CreatedTodoIDs[title]
+	rec, err := tc.UseHTTPClient().RestoreTodo(tc.LastTodoID)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoTrashContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *TodoTrashContext) GettingTheTodoShouldFailWithStatus(title string, status int) error {
+	rec, err := tc.UseHTTPClient().GetTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, status)
+}
+
+func (tc *TodoTrashContext) TheRestoredTodoShouldHaveTags(tags string) error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.DeletedAt != nil {
+		return fmt.Errorf("expected the restored todo to have no deleted_at, got %s", todo.DeletedAt)
+	}
+	if strings.Join(todo.Tags, ", ") != tags {
+		return fmt.Errorf("expected tags %q, got %q", tags, strings.Join(todo.Tags, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoTrashContext) TheTodosShouldBe(titles string) error {
+	rec, err := tc.UseHTTPClient().ListTodos()
+	if err != nil {
+		return err
+	}
+	return validateTodoTitles(rec, titles)
+}
+
+func (tc *TodoTrashContext) TheTrashShouldBe(titles string) error {
+	rec, err := tc.UseHTTPClient().ListTrash()
+	if err != nil {
+		return err
+	}
+	if err := validateTodoTitles(rec, titles); err != nil {
+		return fmt.Errorf("trash: %w", err)
+	}
+	todos, _ := helpers.ParseTodoListResponse(rec)
+	for _, todo := range todos {
+		if todo.DeletedAt == nil {
+			return fmt.Errorf("expected todo %q in the trash to have a deleted_at", todo.Title)
+		}
+	}
+	return nil
+}
+
+func (tc *TodoTrashContext) TheTagsShouldBe(expected string) error {
+	rec, err := tc.UseHTTPClient().ListTags()
+	if err != nil {
+		return err
+	}
+	tags, err := helpers.ParseTagListResponse(rec)
+	if err != nil {
+		return err
+	}
+	actual := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		actual = append(actual, fmt.Sprintf("%s (%d)", tag.Name, tag.Count))
+	}
+	if strings.Join(actual, ", ") != expected {
+		return fmt.Errorf("expected tags %q, got %q", expected, strings.Join(actual, ", "))
+	}
+	return nil
+}
+
+func (tc *TodoTrashContext) TheResponseShouldContainErrorMessageAndTheTodoID(message string) error {
+	return validateErrorResponse(tc.Response, helpers.StatusNotFound, message+" "+tc.LastTodoID)
+}
+
+func (tc *TodoTrashContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I have created a todo "([^"]*)" tagged with "([^"]*)"$`, tc.IHaveCreatedATodoTaggedWith)
+	ctx.Step(`^I have deleted the todo "([^"]*)"$`, tc.IHaveDeletedTheTodo)
+	ctx.Step(`^I delete the todo "([^"]*)"$`, tc.IDeleteTheTodo)
+	ctx.Step(`^I permanently delete the todo "([^"]*)"$`, tc.IPermanentlyDeleteTheTodo)
+	ctx.Step(`^I restore the todo "([^"]*)"$`, tc.IRestoreTheTodo)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^getting the todo "([^"]*)" should fail with status (\d+)$`, tc.GettingTheTodoShouldFailWithStatus)
+	ctx.Step(`^the restored todo should have tags "([^"]*)"$`, tc.TheRestoredTodoShouldHaveTags)
+	ctx.Step(`^the todos should be "([^"]*)"$`, tc.TheTodosShouldBe)
+	ctx.Step(`^the trash should be "([^"]*)"$`, tc.TheTrashShouldBe)
+	ctx.Step(`^the tags should be "([^"]*)"$`, tc.TheTagsShouldBe)
+	ctx.Step(`^the response should contain error message "([^"]*)" and the todo id$`, tc.TheResponseShouldContainErrorMessageAndTheTodoID)
+}
@@ -37,6 +37,22 @@ func (tc *TodoUpdateContext) IHaveATodoUpdateInput(title, desc, dueDate string)
 	return nil
 }
 
+func (tc *TodoUpdateContext) TheTodoUpdateInputHasPriority(priority string) error {
+	tc.UpdateInput["priority"] = priority
+	return nil
+}
+
+func (tc *TodoUpdateContext) TheUpdatedTodoShouldHavePriority(priority string) error {
+	var resp helpers.TodoResponse
+	if err := json.Unmarshal(tc.Response.Body.Bytes(), &resp); err != nil {
+		return err
+	}
+	if resp.Priority != priority {
+		return fmt.Errorf("expected priority %s, got %s", priority, resp.Priority)
+	}
+	return nil
+}
+
 func (tc *TodoUpdateContext) IUpdateTheTodoWithIDFromTheCreatedTodo() error {
 	return tc.IUpdateTheTodoWithID(tc.CreatedTodoID)
 }
@@ -131,6 +147,8 @@ func (tc *TodoUpdateContext) InitializeScenario(ctx *godog.ScenarioContext) {
 	ctx.Step(`^the database is reset$`, tc.ResetDatabase)
 	ctx.Step(`^I have created a todo with title "([^"]*)", description "([^"]*)" and due_date "([^"]*)"$`, tc.IHaveCreatedATodoForUpdate)
 	ctx.Step(`^I have a todo update input with title "([^"]*)", description "([^"]*)" and due_date "([^"]*)"$`, tc.IHaveATodoUpdateInput)
+	ctx.Step(`^the todo update input has priority "([^"]*)"$`, tc.TheTodoUpdateInputHasPriority)
+	ctx.Step(`^the updated todo should have priority "([^"]*)"$`, tc.TheUpdatedTodoShouldHavePriority)
 	ctx.Step(`^I update the todo with ID from the created todo$`, tc.IUpdateTheTodoWithIDFromTheCreatedTodo)
 	ctx.Step(`^I update the todo with ID "([^"]*)"$`, tc.IUpdateTheTodoWithID)
 	ctx.Step(`^I update the todo with ID "([^"]*)" with title "([^"]*)", description "([^"]*)" and due_date "([^"]*)"$`, tc.IUpdateTheTodoWithIDWithTitleDescAndDueDate)
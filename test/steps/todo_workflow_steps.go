@@ -0,0 +1,93 @@
+package steps
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+type TodoWorkflowContext struct {
+	BaseTestContext
+	CreatedTodoIDs map[string]string
+}
+
+func (tc *TodoWorkflowContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	return tc.ResetDatabase()
+}
+
+func (tc *TodoWorkflowContext) IHaveCreatedATodo(title string) error {
+	id, err := tc.CreateTodoWithInput(map[string]interface{}{"title": title})
+	if err != nil {
+		return fmt.Errorf("failed to create todo for test: %v", err)
+	}
+	tc.CreatedTodoIDs[title] = id
+	return nil
+}
+
+func (tc *TodoWorkflowContext) IMoveTheTodoTo(title, status string) error {
+	rec, err := tc.UseHTTPClient().TransitionTodo(tc.CreatedTodoIDs[title], status)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoWorkflowContext) IHaveMovedTheTodoTo(title, status string) error {
+	if err := tc.IMoveTheTodoTo(title, status); err != nil {
+		return err
+	}
+	return validateResponseHeaders(tc.Response, helpers.StatusOK)
+}
+
+func (tc *TodoWorkflowContext) ICompleteTheTodo(title string) error {
+	rec, err := tc.UseHTTPClient().CompleteTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *TodoWorkflowContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *TodoWorkflowContext) TheTodoShouldHaveStatus(status string) error {
+	todo, err := helpers.ParseTodoResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if todo.Status != status {
+		return fmt.Errorf("expected status %q, got %q", status, todo.Status)
+	}
+	return nil
+}
+
+func (tc *TodoWorkflowContext) TheTodosWithStatusShouldBe(status, titles string) error {
+	rec, err := tc.UseHTTPClient().ListTodosWithQuery(url.Values{"status": {status}})
+	if err != nil {
+		return err
+	}
+	return validateTodoTitles(rec, titles)
+}
+
+func (tc *TodoWorkflowContext) TheResponseShouldContainErrorMessage(message string) error {
+	return validateErrorResponse(tc.Response, tc.Response.Code, message)
+}
+
+func (tc *TodoWorkflowContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^I have created a todo "([^"]*)"$`, tc.IHaveCreatedATodo)
+	ctx.Step(`^I move the todo "([^"]*)" to "([^"]*)"$`, tc.IMoveTheTodoTo)
+	ctx.Step(`^I have moved the todo "([^"]*)" to "([^"]*)"$`, tc.IHaveMovedTheTodoTo)
+	ctx.Step(`^I complete the todo "([^"]*)"$`, tc.ICompleteTheTodo)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the todo should have status "([^"]*)"$`, tc.TheTodoShouldHaveStatus)
+	ctx.Step(`^the todos with status "([^"]*)" should be "([^"]*)"$`, tc.TheTodosWithStatusShouldBe)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+}
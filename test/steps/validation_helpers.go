@@ -37,6 +37,14 @@ func validateTodoResponse(response *httptest.ResponseRecorder, input map[string]
 		return fmt.Errorf("expected status 'pending', got '%s'", resp.Status)
 	}
 
+	expectedPriority := "none"
+	if priority, ok := input["priority"].(string); ok && priority != "" {
+		expectedPriority = priority
+	}
+	if resp.Priority != expectedPriority {
+		return fmt.Errorf("expected priority '%s', got '%s'", expectedPriority, resp.Priority)
+	}
+
 	return nil
 }
 
@@ -88,3 +96,18 @@ func validateErrorResponse(response *httptest.ResponseRecorder, expectedStatus i
 
 	return nil
 }
+
+func validateTodoTitles(response *httptest.ResponseRecorder, titles string) error {
+	todos, err := helpers.ParseTodoListResponse(response)
+	if err != nil {
+		return err
+	}
+	actual := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		actual = append(actual, todo.Title)
+	}
+	if strings.Join(actual, ", ") != titles {
+		return fmt.Errorf("expected todos %q, got %q", titles, strings.Join(actual, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+package steps
+
+import (
+	"context"
+	"crypto/hmac"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cucumber/godog"
+
+	"github.com/wellingtonlope/todo-api/internal/infra/webhook"
+	"github.com/wellingtonlope/todo-api/test/helpers"
+)
+
+// deliveryTimeout is how long the steps wait for the events delivered in the background.
+const deliveryTimeout = 2 * time.Second
+
+// receivedEvent is a delivery received by the webhook receiver.
+type receivedEvent struct {
+	event     string
+	signature string
+	body      []byte
+}
+
+// webhookReceiver is a local HTTP server standing for the webhooks, answering every delivery
+// with the same status.
+type webhookReceiver struct {
+	server *httptest.Server
+	mu     sync.Mutex
+	events []receivedEvent
+}
+
+func newWebhookReceiver(status int) *webhookReceiver {
+	receiver := &webhookReceiver{}
+	receiver.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receiver.mu.Lock()
+		receiver.events = append(receiver.events, receivedEvent{
+			event:     r.Header.Get(webhook.HeaderEvent),
+			signature: r.Header.Get(webhook.HeaderSignature),
+			body:      body,
+		})
+		receiver.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	return receiver
+}
+
+// received returns the deliveries received so far.
+func (r *webhookReceiver) received() []receivedEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedEvent(nil), r.events...)
+}
+
+type WebhookContext struct {
+	BaseTestContext
+	receiver       *webhookReceiver
+	CreatedTodoIDs map[string]string
+	WebhookID      string
+}
+
+func (tc *WebhookContext) ResetDatabaseAndContext() error {
+	tc.CreatedTodoIDs = map[string]string{}
+	tc.WebhookID = ""
+	tc.closeReceiver()
+	return tc.ResetDatabase()
+}
+
+func (tc *WebhookContext) closeReceiver() {
+	if tc.receiver != nil {
+		tc.receiver.server.Close()
+		tc.receiver = nil
+	}
+}
+
+func (tc *WebhookContext) AWebhookReceiverAnsweringWithStatus(status int) error {
+	tc.receiver = newWebhookReceiver(status)
+	return nil
+}
+
+func (tc *WebhookContext) ICreateAWebhookForWithTheSecret(events, secret string) error {
+	rec, err := tc.UseHTTPClient().CreateWebhook(map[string]interface{}{
+		"url":    tc.receiver.server.URL + "/hooks",
+		"secret": secret,
+		"events": splitList(events),
+	})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	if rec.Code == helpers.StatusCreated {
+		created, err := helpers.ParseWebhookResponse(rec)
+		if err != nil {
+			return err
+		}
+		tc.WebhookID = created.ID
+	}
+	return nil
+}
+
+func (tc *WebhookContext) IHaveCreatedAWebhookForWithTheSecret(events, secret string) error {
+	if err := tc.ICreateAWebhookForWithTheSecret(events, secret); err != nil {
+		return err
+	}
+	return validateResponseHeaders(tc.Response, helpers.StatusCreated)
+}
+
+func (tc *WebhookContext) IUpdateTheWebhookToReceive(events string) error {
+	rec, err := tc.UseHTTPClient().UpdateWebhook(tc.WebhookID, map[string]interface{}{
+		"url":    tc.receiver.server.URL + "/hooks",
+		"events": splitList(events),
+	})
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *WebhookContext) IRequestTheWebhook() error {
+	rec, err := tc.UseHTTPClient().GetWebhook(tc.WebhookID)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *WebhookContext) IDeleteTheWebhook() error {
+	rec, err := tc.UseHTTPClient().DeleteWebhook(tc.WebhookID)
+	if err != nil {
+		return err
+	}
+	tc.Response = rec
+	return nil
+}
+
+func (tc *WebhookContext) IHaveCreatedATodo(title string) error {
+	rec, err := tc.UseHTTPClient().CreateTodo(map[string]interface{}{"title": title})
+	if err != nil {
+		return err
+	}
+	if err := validateResponseHeaders(rec, helpers.StatusCreated); err != nil {
+		return err
+	}
+	todo, err := helpers.ParseTodoResponse(rec)
+	if err != nil {
+		return err
+	}
+	tc.CreatedTodoIDs[title] = todo.ID
+	return nil
+}
+
+func (tc *WebhookContext) IHaveCompletedTheTodo(title string) error {
+	rec, err := tc.UseHTTPClient().CompleteTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusOK)
+}
+
+func (tc *WebhookContext) IHaveDeletedTheTodo(title string) error {
+	rec, err := tc.UseHTTPClient().DeleteTodo(tc.CreatedTodoIDs[title])
+	if err != nil {
+		return err
+	}
+	return validateResponseHeaders(rec, helpers.StatusNoContent)
+}
+
+func (tc *WebhookContext) TheResponseShouldHaveStatus(status int) error {
+	return validateResponseHeaders(tc.Response, status)
+}
+
+func (tc *WebhookContext) TheResponseShouldContainErrorMessage(message string) error {
+	return validateErrorResponse(tc.Response, tc.Response.Code, message)
+}
+
+func (tc *WebhookContext) TheWebhookShouldReceiveWithoutItsSecret(events string) error {
+	webhook, err := helpers.ParseWebhookResponse(tc.Response)
+	if err != nil {
+		return err
+	}
+	if strings.Join(webhook.Events, ",") != events {
+		return fmt.Errorf("expected the webhook to receive %q, got %q", events, strings.Join(webhook.Events, ","))
+	}
+	if webhook.Secret != "" {
+		return fmt.Errorf("expected the webhook without its secret, got %q", webhook.Secret)
+	}
+	return nil
+}
+
+// TheReceiverShouldGetTheEvents waits for the events delivered in the background, and checks the
+// receiver got exactly them in order.
+func (tc *WebhookContext) TheReceiverShouldGetTheEvents(events string) error {
+	expected := splitList(events)
+	var received []receivedEvent
+	deadline := time.Now().Add(deliveryTimeout)
+	for {
+		received = tc.receiver.received()
+		if len(received) >= len(expected) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	got := make([]string, 0, len(received))
+	for _, event := range received {
+		got = append(got, event.event)
+	}
+	if strings.Join(got, ",") != events {
+		return fmt.Errorf("expected the receiver to get %q, got %q", events, strings.Join(got, ","))
+	}
+	return nil
+}
+
+func (tc *WebhookContext) EveryEventShouldBeSignedWithTheSecret(secret string) error {
+	for _, event := range tc.receiver.received() {
+		if !hmac.Equal([]byte(event.signature), []byte(webhook.Sign(secret, event.body))) {
+			return fmt.Errorf("expected the %s event signed with %q, got the signature %q", event.event, secret, event.signature)
+		}
+	}
+	return nil
+}
+
+// TheDeliveriesOfTheWebhookShouldBe waits for the first attempt of the deliveries made in the
+// background, and checks their statuses and attempts, the newest first.
+func (tc *WebhookContext) TheDeliveriesOfTheWebhookShouldBe(expected string) error {
+	var deliveries []helpers.WebhookDeliveryResponse
+	deadline := time.Now().Add(deliveryTimeout)
+	for {
+		rec, err := tc.UseHTTPClient().ListWebhookDeliveries(tc.WebhookID)
+		if err != nil {
+			return err
+		}
+		if err := validateResponseHeaders(rec, helpers.StatusOK); err != nil {
+			return err
+		}
+		if deliveries, err = helpers.ParseWebhookDeliveryListResponse(rec); err != nil {
+			return err
+		}
+		if allAttempted(deliveries) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	got := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		got = append(got, fmt.Sprintf("%s %s after %d attempt with status %d",
+			delivery.EventType, delivery.Status, delivery.Attempts, delivery.ResponseStatus))
+	}
+	if strings.Join(got, ", ") != expected {
+		return fmt.Errorf("expected the deliveries %q, got %q", expected, strings.Join(got, ", "))
+	}
+	return nil
+}
+
+func (tc *WebhookContext) TheNextAttemptOfTheDeliveryShouldBeScheduled() error {
+	rec, err := tc.UseHTTPClient().ListWebhookDeliveries(tc.WebhookID)
+	if err != nil {
+		return err
+	}
+	deliveries, err := helpers.ParseWebhookDeliveryListResponse(rec)
+	if err != nil {
+		return err
+	}
+	if len(deliveries) != 1 || deliveries[0].NextAttemptAt == nil {
+		return fmt.Errorf("expected a delivery with its next attempt scheduled, got %v", deliveries)
+	}
+	return nil
+}
+
+func allAttempted(deliveries []helpers.WebhookDeliveryResponse) bool {
+	if len(deliveries) == 0 {
+		return false
+	}
+	for _, delivery := range deliveries {
+		if delivery.Attempts == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (tc *WebhookContext) InitializeScenario(ctx *godog.ScenarioContext) {
+	ctx.After(func(ctx context.Context, _ *godog.Scenario, err error) (context.Context, error) {
+		tc.closeReceiver()
+		return ctx, err
+	})
+	ctx.Step(`^the database is reset$`, tc.ResetDatabaseAndContext)
+	ctx.Step(`^a webhook receiver answering with status (\d+)$`, tc.AWebhookReceiverAnsweringWithStatus)
+	ctx.Step(`^I create a webhook for "([^"]*)" with the secret "([^"]*)"$`, tc.ICreateAWebhookForWithTheSecret)
+	ctx.Step(`^I have created a webhook for "([^"]*)" with the secret "([^"]*)"$`, tc.IHaveCreatedAWebhookForWithTheSecret)
+	ctx.Step(`^I update the webhook to receive "([^"]*)"$`, tc.IUpdateTheWebhookToReceive)
+	ctx.Step(`^I request the webhook$`, tc.IRequestTheWebhook)
+	ctx.Step(`^I delete the webhook$`, tc.IDeleteTheWebhook)
+	ctx.Step(`^I have created a todo "([^"]*)"$`, tc.IHaveCreatedATodo)
+	ctx.Step(`^I have completed the todo "([^"]*)"$`, tc.IHaveCompletedTheTodo)
+	ctx.Step(`^I have deleted the todo "([^"]*)"$`, tc.IHaveDeletedTheTodo)
+	ctx.Step(`^the response should have status (\d+)$`, tc.TheResponseShouldHaveStatus)
+	ctx.Step(`^the response should contain error message "([^"]*)"$`, tc.TheResponseShouldContainErrorMessage)
+	ctx.Step(`^the response should contain error message '([^']*)'$`, tc.TheResponseShouldContainErrorMessage)
+	ctx.Step(`^the webhook should receive "([^"]*)" without its secret$`, tc.TheWebhookShouldReceiveWithoutItsSecret)
+	ctx.Step(`^the receiver should get the events "([^"]*)"$`, tc.TheReceiverShouldGetTheEvents)
+	ctx.Step(`^every event should be signed with the secret "([^"]*)"$`, tc.EveryEventShouldBeSignedWithTheSecret)
+	ctx.Step(`^the deliveries of the webhook should be "([^"]*)"$`, tc.TheDeliveriesOfTheWebhookShouldBe)
+	ctx.Step(`^the next attempt of the delivery should be scheduled$`, tc.TheNextAttemptOfTheDeliveryShouldBeScheduled)
+}
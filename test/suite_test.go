@@ -9,11 +9,14 @@ import (
 	"github.com/wellingtonlope/todo-api/internal/bootstrap"
 	"github.com/wellingtonlope/todo-api/test/steps"
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
 	"gorm.io/gorm"
 )
 
 type TestDependencies struct {
-	DB *gorm.DB
+	DB         *gorm.DB
+	GRPCServer *grpc.Server
 }
 
 // TestFactory handles test setup using FX bootstrap
@@ -37,6 +40,7 @@ func (tf *TestFactory) SetupBDDTest() (*TestDependencies, *echo.Echo) {
 	tf.app = fx.New(
 		bootstrap.TestFXOptions(),
 		fx.Populate(&deps.DB),
+		fx.Populate(&deps.GRPCServer),
 		fx.Populate(&tf.echoApp),
 	)
 
@@ -57,8 +61,10 @@ func (tf *TestFactory) Reset(deps *TestDependencies) {
 // Reset clears all data from the database
 func (td *TestDependencies) Reset() error {
 	// Simply clear the database - FX maintains all dependencies
-	if err := td.DB.Exec("DELETE FROM todos").Error; err != nil {
-		return err
+	for _, table := range []string{"todo_items", "todo_tags", "tags", "todos", "projects"} {
+		if err := td.DB.Exec("DELETE FROM " + table).Error; err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -189,3 +195,259 @@ func TestTodoMarkPendingBDD(t *testing.T) {
 
 	runBDDTest(t, app, deps.DB, []string{"features/todo_mark_pending.feature"}, tc.InitializeScenario)
 }
+
+func TestTodoSearchBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoSearchContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_search.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoTagsBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoTagsContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_tags.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoChecklistBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoChecklistContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_checklist.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoRecurrenceBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoRecurrenceContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_recurrence.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoProjectsBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoProjectsContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_projects.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoConcurrencyBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoConcurrencyContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_concurrency.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoPatchBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoPatchContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_patch.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoBulkBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoBulkContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_bulk.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoTrashBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoTrashContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_trash.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoArchiveBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoArchiveContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_archive.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoWorkflowBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoWorkflowContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_workflow.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoHistoryBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoHistoryContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_history.feature"}, tc.InitializeScenario)
+}
+
+func TestAuditLogBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.AuditLogContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/audit_log.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoEventsBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoEventsContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_events.feature"}, tc.InitializeScenario)
+}
+
+func TestWebhooksBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.WebhookContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/webhooks.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoSocketBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoSocketContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_socket.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoGRPCBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+	listener := bufconn.Listen(1 << 20)
+	go func() { _ = deps.GRPCServer.Serve(listener) }()
+	defer deps.GRPCServer.Stop()
+
+	tc := &steps.TodoGRPCContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+		Listener: listener,
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_grpc.feature"}, tc.InitializeScenario)
+}
+
+func TestTodoGraphQLBDD(t *testing.T) {
+	factory := NewTestFactory(t)
+	deps, app := factory.SetupBDDTest()
+
+	tc := &steps.TodoGraphQLContext{
+		BaseTestContext: steps.BaseTestContext{
+			EchoApp: app,
+			DB:      deps.DB,
+		},
+	}
+
+	runBDDTest(t, app, deps.DB, []string{"features/todo_graphql.feature"}, tc.InitializeScenario)
+}